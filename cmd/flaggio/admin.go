@@ -16,9 +16,11 @@ import (
 	"github.com/go-redis/redis/v7"
 	"github.com/rs/cors"
 	"github.com/sirupsen/logrus"
+	"github.com/victorkt/flaggio/internal/auth"
 	mongo_repo "github.com/victorkt/flaggio/internal/repository/mongodb"
 	redis_repo "github.com/victorkt/flaggio/internal/repository/redis"
 	"github.com/victorkt/flaggio/internal/server/admin"
+	"github.com/victorkt/flaggio/internal/service"
 )
 
 func startAdmin(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry) error {
@@ -44,6 +46,10 @@ func startAdmin(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry) e
 	if err != nil {
 		return err
 	}
+	flagVersionRepo, err := mongo_repo.NewFlagVersionRepository(ctx, db)
+	if err != nil {
+		return err
+	}
 	segmentRepo, err := mongo_repo.NewSegmentRepository(ctx, db)
 	if err != nil {
 		return err
@@ -51,25 +57,98 @@ func startAdmin(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry) e
 	variantRepo := mongo_repo.NewVariantRepository(flagRepo.(*mongo_repo.FlagRepository))
 	ruleRepo := mongo_repo.NewRuleRepository(
 		flagRepo.(*mongo_repo.FlagRepository), segmentRepo.(*mongo_repo.SegmentRepository))
+	targetRepo, err := mongo_repo.NewTargetRepository(ctx, flagRepo.(*mongo_repo.FlagRepository))
+	if err != nil {
+		return err
+	}
+	environmentRepo, err := mongo_repo.NewEnvironmentRepository(ctx, db)
+	if err != nil {
+		return err
+	}
+	projectRepo, err := mongo_repo.NewProjectRepository(ctx, db)
+	if err != nil {
+		return err
+	}
+	apiKeyRepo, err := mongo_repo.NewAPIKeyRepository(ctx, db)
+	if err != nil {
+		return err
+	}
+	userRepo, err := mongo_repo.NewUserRepository(ctx, db)
+	if err != nil {
+		return err
+	}
+	sessionRepo, err := mongo_repo.NewSessionRepository(ctx, db)
+	if err != nil {
+		return err
+	}
+	auditLogRepo, err := mongo_repo.NewAuditLogRepository(ctx, db)
+	if err != nil {
+		return err
+	}
+	evaluationCountRepo, err := mongo_repo.NewEvaluationCountRepository(ctx, db)
+	if err != nil {
+		return err
+	}
+	scheduledChangeRepo, err := mongo_repo.NewScheduledChangeRepository(ctx, db)
+	if err != nil {
+		return err
+	}
+	rolloutPlanRepo, err := mongo_repo.NewRolloutPlanRepository(ctx, db)
+	if err != nil {
+		return err
+	}
 	if redisClient != nil {
 		flagRepo = redis_repo.NewFlagRepository(redisClient, flagRepo)
 		segmentRepo = redis_repo.NewSegmentRepository(redisClient, segmentRepo)
 		variantRepo = redis_repo.NewVariantRepository(redisClient, variantRepo, flagRepo)
 		ruleRepo = redis_repo.NewRuleRepository(redisClient, ruleRepo, flagRepo, segmentRepo)
+		targetRepo = redis_repo.NewTargetRepository(redisClient, targetRepo, flagRepo)
+		environmentRepo = redis_repo.NewEnvironmentRepository(redisClient, environmentRepo)
+		projectRepo = redis_repo.NewProjectRepository(redisClient, projectRepo)
+		apiKeyRepo = redis_repo.NewAPIKeyRepository(redisClient, apiKeyRepo)
+	}
+
+	// changes are applied through the same repositories as the
+	// admin API, so that caches get invalidated
+	if !cfg.noScheduler {
+		startScheduler(ctx, scheduledChangeRepo, rolloutPlanRepo, mongo_repo.NewLeaseRepository(db),
+			flagRepo, ruleRepo, auditLogRepo, logger, wg)
+	}
+
+	// setup authentication
+	authenticator, err := newAdminAuthenticator(ctx, userRepo, sessionRepo, logger)
+	if err != nil {
+		return err
 	}
 
 	// setup graphql resolver
 	resolver := &admin.Resolver{
-		FlagRepo:    flagRepo,
-		VariantRepo: variantRepo,
-		RuleRepo:    ruleRepo,
-		SegmentRepo: segmentRepo,
+		FlagRepo:            flagRepo,
+		FlagVersionRepo:     flagVersionRepo,
+		VariantRepo:         variantRepo,
+		RuleRepo:            ruleRepo,
+		SegmentRepo:         segmentRepo,
+		TargetRepo:          targetRepo,
+		EnvironmentRepo:     environmentRepo,
+		ProjectRepo:         projectRepo,
+		APIKeyRepo:          apiKeyRepo,
+		UserRepo:            userRepo,
+		AuditLogRepo:        auditLogRepo,
+		EvaluationCountRepo: evaluationCountRepo,
+		ReportService:       service.NewReportService(flagRepo, segmentRepo, projectRepo, evaluationCountRepo),
+		ScheduledChangeRepo: scheduledChangeRepo,
+		RolloutPlanRepo:     rolloutPlanRepo,
+		Authenticator:       authenticator,
 	}
 
 	// setup graphql server
 	gqlSrv := handler.New(
-		admin.NewExecutableSchema(admin.Config{Resolvers: resolver}),
+		admin.NewExecutableSchema(admin.Config{
+			Resolvers:  resolver,
+			Directives: admin.DirectiveRoot{HasRole: resolver.HasRole},
+		}),
 	)
+	gqlSrv.SetErrorPresenter(admin.ErrorPresenter)
 	gqlSrv.AddTransport(transport.POST{})
 	gqlSrv.Use(extension.Introspection{})
 
@@ -92,7 +171,11 @@ func startAdmin(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry) e
 			Debug:            cfg.corsDebug,
 		}).Handler,
 	)
-	router.Method("POST", "/query", gqlSrv)
+	if authenticator != nil {
+		router.With(auth.Middleware(authenticator)).Method("POST", "/query", gqlSrv)
+	} else {
+		router.Method("POST", "/query", gqlSrv)
+	}
 	if cfg.playgroundEnabled {
 		router.Get("/playground", playground.Handler("GraphQL playground", "/query"))
 	}
@@ -121,6 +204,8 @@ func startAdmin(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry) e
 		"listening":  cfg.adminAddr,
 		"playground": cfg.playgroundEnabled,
 		"admin_ui":   !cfg.noAdminUI,
+		"auth":       cfg.adminAuth,
+		"scheduler":  !cfg.noScheduler,
 	}).Info("admin server started")
 
 	// setup http server
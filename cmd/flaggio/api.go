@@ -2,8 +2,10 @@ package main
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
@@ -11,13 +13,21 @@ import (
 	"github.com/rs/cors"
 	"github.com/sirupsen/logrus"
 	"github.com/victorkt/clientip"
+	"github.com/victorkt/flaggio/internal/repository"
 	mongo_repo "github.com/victorkt/flaggio/internal/repository/mongodb"
 	redis_repo "github.com/victorkt/flaggio/internal/repository/redis"
 	"github.com/victorkt/flaggio/internal/server/api"
+	"github.com/victorkt/flaggio/internal/server/rpc"
 	"github.com/victorkt/flaggio/internal/service"
 	redis_svc "github.com/victorkt/flaggio/internal/service/redis"
+	"github.com/victorkt/flaggio/internal/stream"
+	"github.com/victorkt/flaggio/internal/tracking"
 )
 
+// changeFeedRetryInterval is how long to wait before listening to the
+// change feed again after it fails.
+const changeFeedRetryInterval = 5 * time.Second
+
 func startAPI(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry) error {
 	logger.Debug("starting api server ...")
 
@@ -45,16 +55,59 @@ func startAPI(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry) err
 	if err != nil {
 		return err
 	}
+	targetRepo, err := mongo_repo.NewTargetRepository(ctx, flagRepo.(*mongo_repo.FlagRepository))
+	if err != nil {
+		return err
+	}
+	environmentRepo, err := mongo_repo.NewEnvironmentRepository(ctx, db)
+	if err != nil {
+		return err
+	}
+	projectRepo, err := mongo_repo.NewProjectRepository(ctx, db)
+	if err != nil {
+		return err
+	}
+	apiKeyRepo, err := mongo_repo.NewAPIKeyRepository(ctx, db)
+	if err != nil {
+		return err
+	}
 	if redisClient != nil {
 		flagRepo = redis_repo.NewFlagRepository(redisClient, flagRepo)
 		segmentRepo = redis_repo.NewSegmentRepository(redisClient, segmentRepo)
+		environmentRepo = redis_repo.NewEnvironmentRepository(redisClient, environmentRepo)
+		projectRepo = redis_repo.NewProjectRepository(redisClient, projectRepo)
+		apiKeyRepo = redis_repo.NewAPIKeyRepository(redisClient, apiKeyRepo)
 	}
 
 	// setup services
-	flagService := service.NewFlagService(flagRepo, segmentRepo)
+	flagService := service.NewFlagService(flagRepo, segmentRepo, targetRepo, environmentRepo, projectRepo)
 	if redisClient != nil {
 		flagService = redis_svc.NewFlagService(redisClient, flagService)
 	}
+	if cfg.isEvaluationTrackingEnabled() {
+		// evaluations are recorded after caching, so cached ones are recorded too
+		recorder, err := newEvaluationRecorder(ctx, db, logger, wg)
+		if err != nil {
+			return err
+		}
+		flagService = tracking.NewFlagService(flagService, recorder)
+	}
+
+	// setup change streaming
+	var broker *stream.Broker
+	if !cfg.noAPIStream {
+		// changes are published to redis when caching is enabled,
+		// otherwise they are read from the database change streams
+		var changeFeed repository.ChangeFeed
+		if redisClient != nil {
+			changeFeed = redis_repo.NewChangeFeedRepository(redisClient)
+		} else {
+			changeFeed = mongo_repo.NewChangeFeedRepository(db)
+		}
+		broker = stream.NewBroker(changeFeed)
+		wg.Add(1)
+		go runBroker(ctx, broker, logger, wg)
+	}
 
 	// setup router
 	router := chi.NewRouter()
@@ -78,19 +131,68 @@ func startAPI(ctx context.Context, wg *sync.WaitGroup, logger *logrus.Entry) err
 	)
 
 	// setup API server
+	if cfg.noAPIAuth {
+		// disable authentication
+		apiKeyRepo = nil
+	}
 	apiSrv := api.NewServer(
 		router,
 		flagService,
+		apiKeyRepo,
+		projectRepo,
+		environmentRepo,
+		broker,
 	)
 
 	logger.WithFields(logrus.Fields{
 		"caching":   cfg.isCachingEnabled(),
 		"tracing":   cfg.isTracingEnabled(),
+		"auth":      !cfg.noAPIAuth,
+		"streaming": !cfg.noAPIStream,
+		"tracking":  cfg.isEvaluationTrackingEnabled(),
 		"listening": cfg.apiAddr,
 	}).Info("api server started")
 
 	// setup http server
 	srv := newHTTPServer(ctx, cfg.apiAddr, apiSrv, logger, wg)
 
-	return srv.ListenAndServe()
+	errs := make(chan error, 2)
+	if !cfg.noGRPC {
+		// setup gRPC server
+		lis, err := net.Listen("tcp", cfg.grpcAddr)
+		if err != nil {
+			return err
+		}
+		grpcSrv := rpc.NewServer(flagService, apiKeyRepo, projectRepo, environmentRepo, broker)
+		wg.Add(1)
+		go gracefulGRPCServerShutdown(ctx, grpcSrv, logger, wg)
+		logger.WithField("listening", cfg.grpcAddr).Info("grpc server started")
+		go func() {
+			errs <- grpcSrv.Serve(lis)
+		}()
+	}
+	go func() {
+		errs <- srv.ListenAndServe()
+	}()
+	return <-errs
+}
+
+// runBroker broadcasts changes until the context is cancelled, listening
+// to the change feed again whenever it fails. Once the context is cancelled,
+// the broker is closed so that open streams don't hold the server shutdown.
+func runBroker(ctx context.Context, broker *stream.Broker, logger *logrus.Entry, wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer broker.Close()
+	for {
+		err := broker.Run(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		logger.WithError(err).Errorf("change feed failed, retrying in %s", changeFeedRetryInterval)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(changeFeedRetryInterval):
+		}
+	}
 }
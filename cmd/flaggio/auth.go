@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/victorkt/flaggio/internal/auth"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+)
+
+const (
+	adminAuthNone  = "none"
+	adminAuthLocal = "local"
+	adminAuthOIDC  = "oidc"
+)
+
+// newAdminAuthenticator returns the authenticator for the configured admin
+// auth mode. It returns nil when authentication is disabled.
+func newAdminAuthenticator(ctx context.Context, userRepo repository.User, sessionRepo repository.Session,
+	logger *logrus.Entry) (auth.Authenticator, error) {
+	switch cfg.adminAuth {
+	case adminAuthNone:
+		logger.Warn("admin authentication is disabled")
+		return nil, nil
+	case adminAuthLocal:
+		if err := bootstrapAdminUser(ctx, userRepo, logger); err != nil {
+			return nil, err
+		}
+		return auth.NewLocal(userRepo, sessionRepo, cfg.sessionTTL), nil
+	case adminAuthOIDC:
+		defaultRole := flaggio.UserRole(cfg.oidcDefaultRole)
+		if defaultRole != "" && !defaultRole.IsValid() {
+			return nil, fmt.Errorf("invalid oidc default role: %s", cfg.oidcDefaultRole)
+		}
+		return auth.NewOIDC(ctx, auth.OIDCConfig{
+			Issuer:      cfg.oidcIssuer,
+			Audience:    cfg.oidcAudience,
+			RoleClaim:   cfg.oidcRoleClaim,
+			DefaultRole: defaultRole,
+		})
+	default:
+		return nil, fmt.Errorf("invalid admin auth: %s", cfg.adminAuth)
+	}
+}
+
+// bootstrapAdminUser creates the first admin user with the configured
+// credentials, when there are no users yet.
+func bootstrapAdminUser(ctx context.Context, userRepo repository.User, logger *logrus.Entry) error {
+	users, err := userRepo.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+	if len(users) > 0 {
+		return nil
+	}
+	if cfg.adminEmail == "" || cfg.adminPassword == "" {
+		logger.Warn("there are no admin users, set the admin email and password to create one")
+		return nil
+	}
+	hash, err := auth.HashPassword(cfg.adminPassword)
+	if err != nil {
+		return err
+	}
+	_, err = userRepo.Create(ctx, flaggio.NewUser{
+		Email: cfg.adminEmail,
+		Name:  "Admin",
+		Role:  flaggio.UserRoleAdmin,
+	}, hash)
+	if err != nil {
+		return err
+	}
+	logger.WithField("email", cfg.adminEmail).Info("created admin user")
+	return nil
+}
@@ -1,23 +1,41 @@
 package main
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
+	"github.com/victorkt/flaggio/internal/auth"
 )
 
 type config struct {
 	databaseURI, redisURI                  string
-	apiAddr, adminAddr, uiBuildPath        string
+	apiAddr, adminAddr, grpcAddr           string
+	uiBuildPath                            string
 	logFormatter, logLevel                 string
 	corsAllowedOrigins, corsAllowedHeaders cli.StringSlice
 	corsDebug, noAPI, noAdmin, noAdminUI   bool
+	noAPIAuth, noAPIStream, noGRPC         bool
 	playgroundEnabled                      bool
 	jaegerAgentHost                        string
+	adminAuth, adminEmail, adminPassword   string
+	sessionTTL                             time.Duration
+	oidcIssuer, oidcAudience               string
+	oidcRoleClaim, oidcDefaultRole         string
+	evaluationSinks                        cli.StringSlice
+	evaluationWebhookURL                   string
+	evaluationBufferSize                   int
+	noScheduler                            bool
+	schedulerInterval                      time.Duration
 }
 
 func (c *config) isCachingEnabled() bool {
 	return c.redisURI != ""
 }
 
+func (c *config) isEvaluationTrackingEnabled() bool {
+	return len(c.evaluationSinks.Value()) > 0
+}
+
 func (c *config) isTracingEnabled() bool {
 	return c.jaegerAgentHost != ""
 }
@@ -69,18 +87,100 @@ var flags = []cli.Flag{
 		EnvVars:     []string{"NO_API"},
 		Destination: &cfg.noAPI,
 	},
+	&cli.BoolFlag{
+		Name:        "no-api-auth",
+		Usage:       "Don't require API keys to use the API server",
+		EnvVars:     []string{"NO_API_AUTH"},
+		Destination: &cfg.noAPIAuth,
+	},
+	&cli.BoolFlag{
+		Name:        "no-api-stream",
+		Usage:       "Don't stream flag changes to clients. Without caching, streaming requires MongoDB to run as a replica set",
+		EnvVars:     []string{"NO_API_STREAM"},
+		Destination: &cfg.noAPIStream,
+	},
+	&cli.BoolFlag{
+		Name:        "no-grpc",
+		Usage:       "Don't start the gRPC API server",
+		EnvVars:     []string{"NO_GRPC"},
+		Destination: &cfg.noGRPC,
+	},
 	&cli.BoolFlag{
 		Name:        "no-admin",
 		Usage:       "Don't start the admin server",
 		EnvVars:     []string{"NO_ADMIN"},
 		Destination: &cfg.noAdmin,
 	},
+	&cli.StringFlag{
+		Name:        "admin-auth",
+		Usage:       "Sets the authentication mode for the admin. Valid values are: none, local, oidc",
+		EnvVars:     []string{"ADMIN_AUTH"},
+		Value:       adminAuthLocal,
+		Destination: &cfg.adminAuth,
+	},
+	&cli.StringFlag{
+		Name:        "admin-email",
+		Usage:       "Email of the admin user created when there are no local users",
+		EnvVars:     []string{"ADMIN_EMAIL"},
+		Destination: &cfg.adminEmail,
+	},
+	&cli.StringFlag{
+		Name:        "admin-password",
+		Usage:       "Password of the admin user created when there are no local users",
+		EnvVars:     []string{"ADMIN_PASSWORD"},
+		Destination: &cfg.adminPassword,
+	},
+	&cli.DurationFlag{
+		Name:        "session-ttl",
+		Usage:       "How long local user sessions last",
+		EnvVars:     []string{"SESSION_TTL"},
+		Value:       24 * time.Hour,
+		Destination: &cfg.sessionTTL,
+	},
+	&cli.StringFlag{
+		Name:        "oidc-issuer",
+		Usage:       "URL of the OpenID Connect provider used to authenticate admin users",
+		EnvVars:     []string{"OIDC_ISSUER"},
+		Destination: &cfg.oidcIssuer,
+	},
+	&cli.StringFlag{
+		Name:        "oidc-audience",
+		Usage:       "Expected audience of the OpenID Connect tokens, usually the client ID",
+		EnvVars:     []string{"OIDC_AUDIENCE"},
+		Destination: &cfg.oidcAudience,
+	},
+	&cli.StringFlag{
+		Name:        "oidc-role-claim",
+		Usage:       "Token claim that holds the admin user role",
+		EnvVars:     []string{"OIDC_ROLE_CLAIM"},
+		Value:       auth.DefaultRoleClaim,
+		Destination: &cfg.oidcRoleClaim,
+	},
+	&cli.StringFlag{
+		Name:        "oidc-default-role",
+		Usage:       "Role of users whose token has no role. Valid values are: VIEWER, EDITOR, ADMIN",
+		EnvVars:     []string{"OIDC_DEFAULT_ROLE"},
+		Destination: &cfg.oidcDefaultRole,
+	},
 	&cli.BoolFlag{
 		Name:        "no-admin-ui",
 		Usage:       "Don't start the admin UI",
 		EnvVars:     []string{"NO_ADMIN_UI"},
 		Destination: &cfg.noAdminUI,
 	},
+	&cli.BoolFlag{
+		Name:        "no-scheduler",
+		Usage:       "Don't apply scheduled flag changes from this admin process",
+		EnvVars:     []string{"NO_SCHEDULER"},
+		Destination: &cfg.noScheduler,
+	},
+	&cli.DurationFlag{
+		Name:        "scheduler-interval",
+		Usage:       "How often to check for scheduled flag changes that are due",
+		EnvVars:     []string{"SCHEDULER_INTERVAL"},
+		Value:       10 * time.Second,
+		Destination: &cfg.schedulerInterval,
+	},
 	&cli.BoolFlag{
 		Name:        "playground",
 		Usage:       "Enable graphql playground",
@@ -101,6 +201,32 @@ var flags = []cli.Flag{
 		Value:       ":8081",
 		Destination: &cfg.adminAddr,
 	},
+	&cli.StringFlag{
+		Name:        "grpc-addr",
+		Usage:       "Sets the bind address for the gRPC API",
+		EnvVars:     []string{"GRPC_ADDR"},
+		Value:       ":8082",
+		Destination: &cfg.grpcAddr,
+	},
+	&cli.StringSliceFlag{
+		Name:        "evaluation-sinks",
+		Usage:       "Where to send an event for every flag evaluation, separated by comma. Valid values are: mongodb, stdout, webhook",
+		EnvVars:     []string{"EVALUATION_SINKS"},
+		Destination: &cfg.evaluationSinks,
+	},
+	&cli.StringFlag{
+		Name:        "evaluation-webhook-url",
+		Usage:       "URL that receives batches of evaluation events when using the webhook sink",
+		EnvVars:     []string{"EVALUATION_WEBHOOK_URL"},
+		Destination: &cfg.evaluationWebhookURL,
+	},
+	&cli.IntFlag{
+		Name:        "evaluation-buffer-size",
+		Usage:       "How many evaluation events can wait to be sent before new ones are dropped",
+		EnvVars:     []string{"EVALUATION_BUFFER_SIZE"},
+		Value:       10000,
+		Destination: &cfg.evaluationBufferSize,
+	},
 	&cli.StringFlag{
 		Name:        "log-formatter",
 		Usage:       "Sets the log formatter for the application. Valid values are: text, json",
@@ -11,6 +11,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
 )
 
 func newHTTPServer(ctx context.Context, addr string, handler http.Handler, logger *logrus.Entry, wg *sync.WaitGroup) *http.Server {
@@ -98,3 +99,23 @@ func gracefulServerShutdown(ctx context.Context, srv *http.Server, logger *logru
 	}
 	wg.Done()
 }
+
+func gracefulGRPCServerShutdown(ctx context.Context, srv *grpc.Server, logger *logrus.Entry, wg *sync.WaitGroup) {
+	<-ctx.Done()
+	logger.Debug("shutting down grpc server")
+
+	// streams are only closed by the clients, so they
+	// have to be interrupted if it takes too long
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		logger.Error("could not gracefully shutdown the grpc server")
+		srv.Stop()
+	}
+	wg.Done()
+}
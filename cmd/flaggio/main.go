@@ -38,6 +38,7 @@ func main() { // nolint:gocyclo // dependencies
 		Description: ApplicationDescription,
 		Version:     ApplicationVersion,
 		Flags:       flags,
+		Commands:    []*cli.Command{staleReportCommand},
 		Action: func(_ *cli.Context) error {
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
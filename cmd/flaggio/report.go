@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	mongo_repo "github.com/victorkt/flaggio/internal/repository/mongodb"
+	"github.com/victorkt/flaggio/internal/service"
+)
+
+const (
+	reportFormatText = "text"
+	reportFormatJSON = "json"
+)
+
+var staleReportCommand = &cli.Command{
+	Name:  "stale-report",
+	Usage: "List flags and segments that are likely not needed anymore",
+	Description: "Lists flags that serve the same variant to all users and haven't changed in the given number " +
+		"of days, flags that weren't evaluated in the given number of days and segments that no flag references. " +
+		"Evaluations are only known when the API records them in mongodb (see --evaluation-sinks).",
+	Flags: []cli.Flag{
+		&cli.IntFlag{
+			Name:  "days",
+			Usage: "Number of days without changes or evaluations for a flag to be reported",
+			Value: 30,
+		},
+		&cli.StringFlag{
+			Name:  "project",
+			Usage: "Key of the project to report on. When empty, only flags and segments that don't belong to a project are reported",
+		},
+		&cli.StringFlag{
+			Name:  "format",
+			Usage: "Output format. Valid values are: text, json",
+			Value: reportFormatText,
+		},
+	},
+	Action: func(c *cli.Context) error {
+		days := c.Int("days")
+		if days < 1 {
+			return fmt.Errorf("days must be at least 1")
+		}
+		format := c.String("format")
+		if format != reportFormatText && format != reportFormatJSON {
+			return fmt.Errorf("invalid format: %s", format)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		var wg sync.WaitGroup
+		defer wg.Wait()
+		defer cancel()
+
+		logger := logrus.New()
+		logger.SetLevel(logrus.WarnLevel)
+		report, err := staleReport(ctx, c.String("project"), days, logrus.NewEntry(logger), &wg)
+		if err != nil {
+			return err
+		}
+		if format == reportFormatJSON {
+			return writeStaleReportJSON(os.Stdout, report)
+		}
+		return writeStaleReportText(os.Stdout, report, days)
+	},
+}
+
+func staleReport(ctx context.Context, projectKey string, days int, logger *logrus.Entry, wg *sync.WaitGroup) (*flaggio.StaleReport, error) {
+	// connect to mongo
+	db, err := newMongoDatabase(ctx, cfg.databaseURI, logger, wg)
+	if err != nil {
+		return nil, err
+	}
+
+	// setup repositories
+	flagRepo, err := mongo_repo.NewFlagRepository(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	segmentRepo, err := mongo_repo.NewSegmentRepository(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	projectRepo, err := mongo_repo.NewProjectRepository(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	evaluationCountRepo, err := mongo_repo.NewEvaluationCountRepository(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	var projectID *string
+	if projectKey != "" {
+		prj, err := projectRepo.FindByKey(ctx, projectKey)
+		if err != nil {
+			return nil, err
+		}
+		projectID = &prj.ID
+	}
+	reportService := service.NewReportService(flagRepo, segmentRepo, projectRepo, evaluationCountRepo)
+	return reportService.Stale(ctx, projectID, time.Now().AddDate(0, 0, -days))
+}
+
+type staleFlagOutput struct {
+	ID            string    `json:"id"`
+	Key           string    `json:"key"`
+	Name          string    `json:"name"`
+	LastChangedAt time.Time `json:"lastChangedAt"`
+}
+
+type staleSegmentOutput struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func staleFlagsOutput(flgs []*flaggio.Flag) []staleFlagOutput {
+	out := make([]staleFlagOutput, len(flgs))
+	for idx, flg := range flgs {
+		out[idx] = staleFlagOutput{ID: flg.ID, Key: flg.Key, Name: flg.Name, LastChangedAt: flg.LastChangedAt()}
+	}
+	return out
+}
+
+func writeStaleReportJSON(w io.Writer, report *flaggio.StaleReport) error {
+	sgmnts := make([]staleSegmentOutput, len(report.UnusedSegments))
+	for idx, sgmnt := range report.UnusedSegments {
+		sgmnts[idx] = staleSegmentOutput{ID: sgmnt.ID, Name: sgmnt.Name}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		RolledOutFlags   []staleFlagOutput    `json:"rolledOutFlags"`
+		UnevaluatedFlags []staleFlagOutput    `json:"unevaluatedFlags"`
+		UnusedSegments   []staleSegmentOutput `json:"unusedSegments"`
+	}{
+		RolledOutFlags:   staleFlagsOutput(report.RolledOutFlags),
+		UnevaluatedFlags: staleFlagsOutput(report.UnevaluatedFlags),
+		UnusedSegments:   sgmnts,
+	})
+}
+
+func writeStaleReportText(w io.Writer, report *flaggio.StaleReport, days int) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	writeFlags := func(title string, flgs []*flaggio.Flag) {
+		fmt.Fprintf(tw, "%s (%d)\n", title, len(flgs))
+		if len(flgs) > 0 {
+			fmt.Fprintln(tw, "KEY\tNAME\tLAST CHANGED")
+		}
+		for _, flg := range flgs {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", flg.Key, flg.Name, flg.LastChangedAt().Format(time.RFC3339))
+		}
+		fmt.Fprintln(tw)
+	}
+	writeFlags(fmt.Sprintf("Flags rolled out and unchanged for %d days", days), report.RolledOutFlags)
+	writeFlags(fmt.Sprintf("Flags not evaluated in %d days", days), report.UnevaluatedFlags)
+	fmt.Fprintf(tw, "Segments not referenced by any flag (%d)\n", len(report.UnusedSegments))
+	if len(report.UnusedSegments) > 0 {
+		fmt.Fprintln(tw, "ID\tNAME")
+	}
+	for _, sgmnt := range report.UnusedSegments {
+		fmt.Fprintf(tw, "%s\t%s\n", sgmnt.ID, sgmnt.Name)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/victorkt/flaggio/internal/repository"
+	"github.com/victorkt/flaggio/internal/scheduler"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// startScheduler applies the scheduled flag changes and rollout plan steps
+// that are due until the context is cancelled. Only one admin process applies
+// changes at a time.
+func startScheduler(ctx context.Context, changesRepo repository.ScheduledChange, plansRepo repository.RolloutPlan,
+	leasesRepo repository.Lease, flagRepo repository.Flag, ruleRepo repository.Rule, auditLogRepo repository.AuditLog,
+	logger *logrus.Entry, wg *sync.WaitGroup) {
+	// the holder must be unique per process, even for processes
+	// running in the same host
+	hostname, _ := os.Hostname()
+	holder := fmt.Sprintf("%s-%s", hostname, primitive.NewObjectID().Hex())
+	schdlr := scheduler.NewScheduler(changesRepo, plansRepo, leasesRepo, flagRepo, ruleRepo, auditLogRepo,
+		holder, cfg.schedulerInterval)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		schdlr.Run(ctx, func(err error) {
+			logger.WithError(err).Error("failed to apply scheduled changes")
+		})
+	}()
+}
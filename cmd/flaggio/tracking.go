@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	mongo_repo "github.com/victorkt/flaggio/internal/repository/mongodb"
+	"github.com/victorkt/flaggio/internal/tracking"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	evaluationSinkMongoDB = "mongodb"
+	evaluationSinkStdout  = "stdout"
+	evaluationSinkWebhook = "webhook"
+)
+
+const (
+	// evaluationBatchSize is the maximum number of evaluation events
+	// written to the sinks at once.
+	evaluationBatchSize = 1000
+	// evaluationFlushInterval is how often evaluation events are written
+	// to the sinks when there are not enough of them to fill a batch.
+	evaluationFlushInterval = 5 * time.Second
+)
+
+// newEvaluationRecorder returns a recorder that sends evaluation events to
+// the configured sinks, running until the context is cancelled.
+func newEvaluationRecorder(ctx context.Context, db *mongo.Database, logger *logrus.Entry, wg *sync.WaitGroup) (*tracking.Recorder, error) {
+	var sinks []tracking.Sink
+	for _, name := range cfg.evaluationSinks.Value() {
+		switch name {
+		case evaluationSinkMongoDB:
+			sink, err := mongo_repo.NewEvaluationCountRepository(ctx, db)
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, sink)
+		case evaluationSinkStdout:
+			sinks = append(sinks, tracking.NewJSONSink(os.Stdout))
+		case evaluationSinkWebhook:
+			if cfg.evaluationWebhookURL == "" {
+				return nil, fmt.Errorf("the webhook evaluation sink requires a webhook url")
+			}
+			client := &http.Client{Timeout: 10 * time.Second}
+			sinks = append(sinks, tracking.NewWebhookSink(cfg.evaluationWebhookURL, client))
+		default:
+			return nil, fmt.Errorf("invalid evaluation sink: %s", name)
+		}
+	}
+	recorder := tracking.NewRecorder(tracking.MultiSink(sinks...),
+		cfg.evaluationBufferSize, evaluationBatchSize, evaluationFlushInterval)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		recorder.Run(ctx, func(err error, lost int) {
+			logger.WithError(err).WithField("lost", lost).Error("failed to write evaluation events")
+		})
+		if dropped := recorder.Dropped(); dropped > 0 {
+			logger.WithField("dropped", dropped).Warn("evaluation events were dropped as the sinks couldn't keep up")
+		}
+	}()
+	return recorder, nil
+}
@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	internalerrors "github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+)
+
+// APIKeys authenticates the API keys sent to the evaluation APIs.
+type APIKeys struct {
+	apiKeys      repository.APIKey
+	projects     repository.Project
+	environments repository.Environment
+}
+
+// NewAPIKeys returns a new authenticator for the API keys stored in the
+// API key repository.
+func NewAPIKeys(apiKeys repository.APIKey, projects repository.Project, environments repository.Environment) *APIKeys {
+	return &APIKeys{
+		apiKeys:      apiKeys,
+		projects:     projects,
+		environments: environments,
+	}
+}
+
+// Credentials holds the details of the API key used to authenticate a
+// request. Project and environment are the keys of the project and the
+// environment the API key is restricted to, if any.
+type Credentials struct {
+	Kind        flaggio.APIKeyKind
+	Project     string
+	Environment string
+}
+
+// Authenticate returns the credentials of an API key. Missing, unknown and
+// revoked keys are rejected, as well as keys restricted to a project or an
+// environment that was deleted.
+func (a *APIKeys) Authenticate(ctx context.Context, key string) (*Credentials, error) {
+	if key == "" {
+		return nil, internalerrors.ErrMissingAPIKey
+	}
+	apiKey, err := a.apiKeys.FindByHash(ctx, flaggio.HashAPIKey(key))
+	if errors.Is(err, internalerrors.ErrNotFound) {
+		return nil, internalerrors.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+	if apiKey.IsRevoked() {
+		return nil, internalerrors.ErrInvalidAPIKey
+	}
+
+	creds := &Credentials{Kind: apiKey.Kind}
+	if apiKey.ProjectID != nil {
+		prj, err := a.projects.FindByID(ctx, *apiKey.ProjectID)
+		if errors.Is(err, internalerrors.ErrNotFound) {
+			// the project was deleted, so the key can't be used anymore
+			return nil, internalerrors.ErrInvalidAPIKey
+		}
+		if err != nil {
+			return nil, err
+		}
+		creds.Project = prj.Key
+	}
+	if apiKey.EnvironmentID != nil {
+		env, err := a.environments.FindByID(ctx, *apiKey.EnvironmentID)
+		if errors.Is(err, internalerrors.ErrNotFound) {
+			// the environment was deleted, so the key can't be used anymore
+			return nil, internalerrors.ErrInvalidAPIKey
+		}
+		if err != nil {
+			return nil, err
+		}
+		creds.Environment = env.Key
+	}
+	return creds, nil
+}
+
+// Scope returns the keys of the project and environment of a request, as
+// given in the request and by the API key. Keys restricted to a project or
+// an environment can't be used to access flags in other ones. Nil
+// credentials, used when authentication is disabled, don't restrict the
+// request.
+func (c *Credentials) Scope(project, environment string) (string, string, error) {
+	if c == nil {
+		return project, environment, nil
+	}
+	if c.Project != "" {
+		if project != "" && project != c.Project {
+			return "", "", internalerrors.Forbidden("API key is restricted to another project")
+		}
+		project = c.Project
+	}
+	if c.Environment != "" {
+		if environment != "" && environment != c.Environment {
+			return "", "", internalerrors.Forbidden("API key is restricted to another environment")
+		}
+		environment = c.Environment
+	}
+	return project, environment, nil
+}
+
+// IsClient returns whether the credentials are of a client key. Client keys
+// are public, so the flag configuration can't be exposed to them.
+func (c *Credentials) IsClient() bool {
+	return c != nil && c.Kind == flaggio.APIKeyKindClient
+}
+
+type credentialsCtxKey struct{}
+
+// NewCredentialsContext returns a copy of the context that holds the
+// credentials of the API key used to authenticate a request.
+func NewCredentialsContext(ctx context.Context, creds *Credentials) context.Context {
+	return context.WithValue(ctx, credentialsCtxKey{}, creds)
+}
+
+// CredentialsFromContext returns the credentials of the API key used to
+// authenticate a request, if any.
+func CredentialsFromContext(ctx context.Context) *Credentials {
+	creds, _ := ctx.Value(credentialsCtxKey{}).(*Credentials)
+	return creds
+}
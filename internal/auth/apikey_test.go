@@ -0,0 +1,167 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/auth"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	repository_mock "github.com/victorkt/flaggio/internal/repository/mocks"
+)
+
+func TestAPIKeys_Authenticate(t *testing.T) {
+	prjID, envID := "p1", "e1"
+	revokedAt := time.Now()
+
+	tests := []struct {
+		name          string
+		key           string
+		prepare       func(apiKeys *repository_mock.MockAPIKey, projects *repository_mock.MockProject, environments *repository_mock.MockEnvironment)
+		expectedCreds *auth.Credentials
+		expectedError error
+	}{
+		{
+			name:          "rejects missing key",
+			key:           "",
+			prepare:       func(*repository_mock.MockAPIKey, *repository_mock.MockProject, *repository_mock.MockEnvironment) {},
+			expectedError: errors.ErrMissingAPIKey,
+		},
+		{
+			name: "rejects unknown key",
+			key:  "srv-unknown",
+			prepare: func(apiKeys *repository_mock.MockAPIKey, _ *repository_mock.MockProject, _ *repository_mock.MockEnvironment) {
+				apiKeys.EXPECT().FindByHash(gomock.Any(), flaggio.HashAPIKey("srv-unknown")).Return(nil, errors.NotFound("API key"))
+			},
+			expectedError: errors.ErrInvalidAPIKey,
+		},
+		{
+			name: "rejects revoked key",
+			key:  "srv-revoked",
+			prepare: func(apiKeys *repository_mock.MockAPIKey, _ *repository_mock.MockProject, _ *repository_mock.MockEnvironment) {
+				apiKeys.EXPECT().FindByHash(gomock.Any(), flaggio.HashAPIKey("srv-revoked")).
+					Return(&flaggio.APIKey{ID: "k1", Kind: flaggio.APIKeyKindServer, RevokedAt: &revokedAt}, nil)
+			},
+			expectedError: errors.ErrInvalidAPIKey,
+		},
+		{
+			name: "rejects key whose project was deleted",
+			key:  "srv-orphan",
+			prepare: func(apiKeys *repository_mock.MockAPIKey, projects *repository_mock.MockProject, _ *repository_mock.MockEnvironment) {
+				apiKeys.EXPECT().FindByHash(gomock.Any(), flaggio.HashAPIKey("srv-orphan")).
+					Return(&flaggio.APIKey{ID: "k1", Kind: flaggio.APIKeyKindServer, ProjectID: &prjID}, nil)
+				projects.EXPECT().FindByID(gomock.Any(), prjID).Return(nil, errors.NotFound("project"))
+			},
+			expectedError: errors.ErrInvalidAPIKey,
+		},
+		{
+			name: "rejects key whose environment was deleted",
+			key:  "srv-orphan",
+			prepare: func(apiKeys *repository_mock.MockAPIKey, _ *repository_mock.MockProject, environments *repository_mock.MockEnvironment) {
+				apiKeys.EXPECT().FindByHash(gomock.Any(), flaggio.HashAPIKey("srv-orphan")).
+					Return(&flaggio.APIKey{ID: "k1", Kind: flaggio.APIKeyKindServer, EnvironmentID: &envID}, nil)
+				environments.EXPECT().FindByID(gomock.Any(), envID).Return(nil, errors.NotFound("environment"))
+			},
+			expectedError: errors.ErrInvalidAPIKey,
+		},
+		{
+			name: "returns the restrictions of the key",
+			key:  "cli-valid",
+			prepare: func(apiKeys *repository_mock.MockAPIKey, projects *repository_mock.MockProject, environments *repository_mock.MockEnvironment) {
+				apiKeys.EXPECT().FindByHash(gomock.Any(), flaggio.HashAPIKey("cli-valid")).
+					Return(&flaggio.APIKey{ID: "k1", Kind: flaggio.APIKeyKindClient, ProjectID: &prjID, EnvironmentID: &envID}, nil)
+				projects.EXPECT().FindByID(gomock.Any(), prjID).Return(&flaggio.Project{ID: prjID, Key: "shop"}, nil)
+				environments.EXPECT().FindByID(gomock.Any(), envID).Return(&flaggio.Environment{ID: envID, Key: "prod"}, nil)
+			},
+			expectedCreds: &auth.Credentials{Kind: flaggio.APIKeyKindClient, Project: "shop", Environment: "prod"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			apiKeys := repository_mock.NewMockAPIKey(mockCtrl)
+			projects := repository_mock.NewMockProject(mockCtrl)
+			environments := repository_mock.NewMockEnvironment(mockCtrl)
+			tt.prepare(apiKeys, projects, environments)
+
+			creds, err := auth.NewAPIKeys(apiKeys, projects, environments).Authenticate(context.Background(), tt.key)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedCreds, creds)
+		})
+	}
+}
+
+func TestCredentials_Scope(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                string
+		creds               *auth.Credentials
+		project             string
+		environment         string
+		expectedProject     string
+		expectedEnvironment string
+		expectedError       error
+	}{
+		{
+			name:                "doesn't restrict requests without credentials",
+			project:             "shop",
+			environment:         "prod",
+			expectedProject:     "shop",
+			expectedEnvironment: "prod",
+		},
+		{
+			name:                "scopes requests to the key restrictions",
+			creds:               &auth.Credentials{Project: "shop", Environment: "prod"},
+			expectedProject:     "shop",
+			expectedEnvironment: "prod",
+		},
+		{
+			name:                "accepts the project and environment of the key",
+			creds:               &auth.Credentials{Project: "shop", Environment: "prod"},
+			project:             "shop",
+			environment:         "prod",
+			expectedProject:     "shop",
+			expectedEnvironment: "prod",
+		},
+		{
+			name:          "rejects another project",
+			creds:         &auth.Credentials{Project: "shop"},
+			project:       "blog",
+			expectedError: errors.Forbidden("API key is restricted to another project"),
+		},
+		{
+			name:          "rejects another environment",
+			creds:         &auth.Credentials{Environment: "prod"},
+			environment:   "dev",
+			expectedError: errors.Forbidden("API key is restricted to another environment"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			prj, env, err := tt.creds.Scope(tt.project, tt.environment)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedProject, prj)
+			assert.Equal(t, tt.expectedEnvironment, env)
+		})
+	}
+}
+
+func TestCredentialsFromContext(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, auth.CredentialsFromContext(context.Background()))
+	assert.False(t, auth.CredentialsFromContext(context.Background()).IsClient())
+
+	creds := &auth.Credentials{Kind: flaggio.APIKeyKindClient}
+	ctx := auth.NewCredentialsContext(context.Background(), creds)
+	assert.Same(t, creds, auth.CredentialsFromContext(ctx))
+	assert.True(t, auth.CredentialsFromContext(ctx).IsClient())
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	internalerrors "github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// Authenticator validates the bearer tokens sent to the admin API and
+// returns the user they belong to. Tokens that are not valid must result
+// in an errors.ErrUnauthenticated error.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*flaggio.User, error)
+}
+
+// PasswordAuthenticator is an Authenticator that can exchange the
+// credentials of a user for a token.
+type PasswordAuthenticator interface {
+	Authenticator
+	// Login returns a new token for the user with the given credentials.
+	Login(ctx context.Context, email, password string) (*flaggio.LoginResult, error)
+	// Logout invalidates the given token.
+	Logout(ctx context.Context, token string) error
+}
+
+type userCtxKey struct{}
+type tokenCtxKey struct{}
+
+// NewContext returns a copy of the context that holds the authenticated
+// user and the token used to authenticate them.
+func NewContext(ctx context.Context, usr *flaggio.User, token string) context.Context {
+	ctx = context.WithValue(ctx, userCtxKey{}, usr)
+	return context.WithValue(ctx, tokenCtxKey{}, token)
+}
+
+// UserFromContext returns the authenticated user, if any.
+func UserFromContext(ctx context.Context) *flaggio.User {
+	usr, _ := ctx.Value(userCtxKey{}).(*flaggio.User)
+	return usr
+}
+
+// TokenFromContext returns the token used to authenticate the user, if any.
+func TokenFromContext(ctx context.Context) string {
+	token, _ := ctx.Value(tokenCtxKey{}).(string)
+	return token
+}
+
+// Middleware authenticates the bearer token sent in the Authorization
+// header, if any, and stores the user in the request context. Requests
+// without a valid token are let through unauthenticated, so that it's up
+// to the handlers to decide what is allowed for them.
+func Middleware(authenticator Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if token == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			usr, err := authenticator.Authenticate(r.Context(), token)
+			if errors.Is(err, internalerrors.ErrUnauthenticated) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if err != nil {
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), usr, token)))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	internalerrors "github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var _ PasswordAuthenticator = (*Local)(nil)
+
+const minPasswordLength = 8
+
+// Local authenticates users stored in the user repository. Users log in
+// with their email and password in exchange for a session token, which
+// is valid until it expires or the user logs out.
+type Local struct {
+	users      repository.User
+	sessions   repository.Session
+	sessionTTL time.Duration
+}
+
+// NewLocal returns a new authenticator for local users. Sessions expire
+// after the given TTL.
+func NewLocal(users repository.User, sessions repository.Session, sessionTTL time.Duration) *Local {
+	return &Local{
+		users:      users,
+		sessions:   sessions,
+		sessionTTL: sessionTTL,
+	}
+}
+
+// Authenticate returns the user that owns the session token.
+func (l *Local) Authenticate(ctx context.Context, token string) (*flaggio.User, error) {
+	sess, err := l.sessions.FindByTokenHash(ctx, flaggio.HashSessionToken(token))
+	if errors.Is(err, internalerrors.ErrNotFound) {
+		return nil, internalerrors.ErrUnauthenticated
+	}
+	if err != nil {
+		return nil, err
+	}
+	usr, err := l.users.FindByID(ctx, sess.UserID)
+	if errors.Is(err, internalerrors.ErrNotFound) {
+		// the user was deleted
+		return nil, internalerrors.ErrUnauthenticated
+	}
+	if err != nil {
+		return nil, err
+	}
+	return usr, nil
+}
+
+// Login checks the user credentials and starts a new session.
+func (l *Local) Login(ctx context.Context, email, password string) (*flaggio.LoginResult, error) {
+	usr, err := l.users.FindByEmail(ctx, email)
+	if errors.Is(err, internalerrors.ErrNotFound) {
+		return nil, internalerrors.ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(usr.PasswordHash), []byte(password)); err != nil {
+		return nil, internalerrors.ErrInvalidCredentials
+	}
+	token, err := flaggio.GenerateSessionToken()
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().Add(l.sessionTTL)
+	if _, err := l.sessions.Create(ctx, usr.ID, flaggio.HashSessionToken(token), expiresAt); err != nil {
+		return nil, err
+	}
+	return &flaggio.LoginResult{
+		Token:     token,
+		ExpiresAt: expiresAt,
+		User:      usr,
+	}, nil
+}
+
+// Logout ends the session that the token belongs to.
+func (l *Local) Logout(ctx context.Context, token string) error {
+	err := l.sessions.Delete(ctx, flaggio.HashSessionToken(token))
+	if errors.Is(err, internalerrors.ErrNotFound) {
+		// the session already expired
+		return nil
+	}
+	return err
+}
+
+// HashPassword returns the hash that is stored for the given password.
+func HashPassword(password string) (string, error) {
+	if len(password) < minPasswordLength {
+		return "", internalerrors.BadRequest(
+			fmt.Sprintf("password must have at least %d characters", minPasswordLength))
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
@@ -0,0 +1,165 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/auth"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	repository_mock "github.com/victorkt/flaggio/internal/repository/mocks"
+)
+
+func TestLocal_Login(t *testing.T) {
+	hash, err := auth.HashPassword("s3cr3t-pwd")
+	assert.NoError(t, err)
+	usr := &flaggio.User{ID: "1", Email: "admin@example.com", Role: flaggio.UserRoleAdmin, PasswordHash: hash}
+
+	tests := []struct {
+		name          string
+		password      string
+		prepare       func(users *repository_mock.MockUser, sessions *repository_mock.MockSession)
+		expectedError error
+	}{
+		{
+			name:     "creates a session when credentials are valid",
+			password: "s3cr3t-pwd",
+			prepare: func(users *repository_mock.MockUser, sessions *repository_mock.MockSession) {
+				users.EXPECT().FindByEmail(gomock.Any(), "admin@example.com").Return(usr, nil)
+				sessions.EXPECT().Create(gomock.Any(), "1", gomock.Any(), gomock.Any()).Return("s1", nil)
+			},
+		},
+		{
+			name:     "rejects wrong password",
+			password: "wrong-pwd",
+			prepare: func(users *repository_mock.MockUser, sessions *repository_mock.MockSession) {
+				users.EXPECT().FindByEmail(gomock.Any(), "admin@example.com").Return(usr, nil)
+			},
+			expectedError: errors.ErrInvalidCredentials,
+		},
+		{
+			name:     "rejects unknown user",
+			password: "s3cr3t-pwd",
+			prepare: func(users *repository_mock.MockUser, sessions *repository_mock.MockSession) {
+				users.EXPECT().FindByEmail(gomock.Any(), "admin@example.com").Return(nil, errors.NotFound("user"))
+			},
+			expectedError: errors.ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			users := repository_mock.NewMockUser(mockCtrl)
+			sessions := repository_mock.NewMockSession(mockCtrl)
+			tt.prepare(users, sessions)
+
+			res, err := auth.NewLocal(users, sessions, time.Hour).Login(context.Background(), "admin@example.com", tt.password)
+			assert.Equal(t, tt.expectedError, err)
+			if tt.expectedError == nil {
+				assert.NotEmpty(t, res.Token)
+				assert.Same(t, usr, res.User)
+				assert.WithinDuration(t, time.Now().Add(time.Hour), res.ExpiresAt, time.Minute)
+			}
+		})
+	}
+}
+
+func TestLocal_Authenticate(t *testing.T) {
+	usr := &flaggio.User{ID: "1", Role: flaggio.UserRoleViewer}
+	sess := &flaggio.Session{ID: "s1", UserID: "1"}
+
+	tests := []struct {
+		name          string
+		prepare       func(users *repository_mock.MockUser, sessions *repository_mock.MockSession)
+		expectedUser  *flaggio.User
+		expectedError error
+	}{
+		{
+			name: "returns the session user",
+			prepare: func(users *repository_mock.MockUser, sessions *repository_mock.MockSession) {
+				sessions.EXPECT().FindByTokenHash(gomock.Any(), flaggio.HashSessionToken("token")).Return(sess, nil)
+				users.EXPECT().FindByID(gomock.Any(), "1").Return(usr, nil)
+			},
+			expectedUser: usr,
+		},
+		{
+			name: "rejects unknown or expired session",
+			prepare: func(users *repository_mock.MockUser, sessions *repository_mock.MockSession) {
+				sessions.EXPECT().FindByTokenHash(gomock.Any(), gomock.Any()).Return(nil, errors.NotFound("session"))
+			},
+			expectedError: errors.ErrUnauthenticated,
+		},
+		{
+			name: "rejects session of deleted user",
+			prepare: func(users *repository_mock.MockUser, sessions *repository_mock.MockSession) {
+				sessions.EXPECT().FindByTokenHash(gomock.Any(), gomock.Any()).Return(sess, nil)
+				users.EXPECT().FindByID(gomock.Any(), "1").Return(nil, errors.NotFound("user"))
+			},
+			expectedError: errors.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			users := repository_mock.NewMockUser(mockCtrl)
+			sessions := repository_mock.NewMockSession(mockCtrl)
+			tt.prepare(users, sessions)
+
+			res, err := auth.NewLocal(users, sessions, time.Hour).Authenticate(context.Background(), "token")
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedUser, res)
+		})
+	}
+}
+
+func TestHashPassword(t *testing.T) {
+	t.Parallel()
+	_, err := auth.HashPassword("short")
+	assert.Error(t, err)
+
+	hash, err := auth.HashPassword("long-enough")
+	assert.NoError(t, err)
+	assert.NotContains(t, hash, "long-enough")
+}
+
+func TestMiddleware(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	users := repository_mock.NewMockUser(mockCtrl)
+	sessions := repository_mock.NewMockSession(mockCtrl)
+	usr := &flaggio.User{ID: "1", Role: flaggio.UserRoleViewer}
+	sessions.EXPECT().FindByTokenHash(gomock.Any(), flaggio.HashSessionToken("valid")).
+		Return(&flaggio.Session{UserID: "1"}, nil)
+	users.EXPECT().FindByID(gomock.Any(), "1").Return(usr, nil)
+	sessions.EXPECT().FindByTokenHash(gomock.Any(), flaggio.HashSessionToken("invalid")).
+		Return(nil, errors.NotFound("session"))
+
+	var gotUser *flaggio.User
+	handler := auth.Middleware(auth.NewLocal(users, sessions, time.Hour))(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotUser = auth.UserFromContext(r.Context())
+		}))
+
+	for token, expected := range map[string]*flaggio.User{"": nil, "valid": usr, "invalid": nil} {
+		gotUser = nil
+		req := httptest.NewRequest(http.MethodPost, "/query", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, expected, gotUser, "token %q", token)
+	}
+}
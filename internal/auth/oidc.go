@@ -0,0 +1,284 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	internalerrors "github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+var _ Authenticator = (*OIDC)(nil)
+
+const (
+	// DefaultRoleClaim is the token claim that holds the user role when
+	// no other claim is configured.
+	DefaultRoleClaim = "flaggio_role"
+
+	// clockSkew is the tolerance used when validating token timestamps.
+	clockSkew = time.Minute
+	// minKeysRefreshInterval limits how often the provider keys are fetched
+	// when a token is signed by an unknown key.
+	minKeysRefreshInterval = time.Minute
+)
+
+// OIDCConfig holds the settings used to validate tokens issued by an
+// OpenID Connect provider.
+type OIDCConfig struct {
+	// Issuer is the URL of the provider. Its discovery document is used
+	// to find the keys that sign the tokens.
+	Issuer string
+	// Audience is the expected audience of the tokens, usually the client ID.
+	Audience string
+	// RoleClaim is the claim that holds the user role, either as a string
+	// or as a list of strings. Defaults to DefaultRoleClaim.
+	RoleClaim string
+	// DefaultRole is given to users whose token has no known role.
+	DefaultRole flaggio.UserRole
+	// Client is used to fetch the discovery document and the keys.
+	// Defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// OIDC authenticates users with JWT bearer tokens (RS256) issued by an
+// OpenID Connect provider. Users are not stored, their details and role
+// are read from the token claims.
+type OIDC struct {
+	cfg     OIDCConfig
+	jwksURI string
+
+	mu        sync.RWMutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+// NewOIDC returns a new authenticator for tokens issued by the configured
+// provider. The provider discovery document and keys are fetched right away.
+func NewOIDC(ctx context.Context, cfg OIDCConfig) (*OIDC, error) {
+	if cfg.RoleClaim == "" {
+		cfg.RoleClaim = DefaultRoleClaim
+	}
+	if cfg.Client == nil {
+		cfg.Client = http.DefaultClient
+	}
+	cfg.Issuer = strings.TrimSuffix(cfg.Issuer, "/")
+
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := getJSON(ctx, cfg.Client, cfg.Issuer+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, fmt.Errorf("fetching oidc discovery document: %w", err)
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != cfg.Issuer {
+		return nil, fmt.Errorf("oidc issuer mismatch: expected %s, got %s", cfg.Issuer, discovery.Issuer)
+	}
+	o := &OIDC{cfg: cfg, jwksURI: discovery.JWKSURI}
+	if err := o.refreshKeys(ctx); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// Authenticate validates the token signature and claims, and returns the
+// user described by them.
+func (o *OIDC) Authenticate(ctx context.Context, token string) (*flaggio.User, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, internalerrors.Unauthenticated("malformed token")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, internalerrors.Unauthenticated("malformed token header")
+	}
+	if header.Alg != "RS256" {
+		return nil, internalerrors.Unauthenticated("unsupported token algorithm")
+	}
+	key, err := o.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, internalerrors.Unauthenticated("malformed token signature")
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err != nil {
+		return nil, internalerrors.Unauthenticated("invalid token signature")
+	}
+
+	var claims map[string]interface{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, internalerrors.Unauthenticated("malformed token claims")
+	}
+	if err := o.validateClaims(claims); err != nil {
+		return nil, err
+	}
+	sub, _ := claims["sub"].(string)
+	email, _ := claims["email"].(string)
+	name, _ := claims["name"].(string)
+	return &flaggio.User{
+		ID:    sub,
+		Email: email,
+		Name:  name,
+		Role:  o.role(claims[o.cfg.RoleClaim]),
+	}, nil
+}
+
+func (o *OIDC) validateClaims(claims map[string]interface{}) error {
+	if iss, _ := claims["iss"].(string); strings.TrimSuffix(iss, "/") != o.cfg.Issuer {
+		return internalerrors.Unauthenticated("invalid token issuer")
+	}
+	if !hasAudience(claims["aud"], o.cfg.Audience) {
+		return internalerrors.Unauthenticated("invalid token audience")
+	}
+	if sub, _ := claims["sub"].(string); sub == "" {
+		return internalerrors.Unauthenticated("missing token subject")
+	}
+	now := time.Now()
+	exp, ok := claims["exp"].(float64)
+	if !ok || now.After(time.Unix(int64(exp), 0).Add(clockSkew)) {
+		return internalerrors.Unauthenticated("token is expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(clockSkew).Before(time.Unix(int64(nbf), 0)) {
+		return internalerrors.Unauthenticated("token is not valid yet")
+	}
+	return nil
+}
+
+// role returns the highest role found in the claim, or the default role
+// if there is none.
+func (o *OIDC) role(claim interface{}) flaggio.UserRole {
+	var values []interface{}
+	switch v := claim.(type) {
+	case string:
+		values = []interface{}{v}
+	case []interface{}:
+		values = v
+	}
+	var role flaggio.UserRole
+	for _, v := range values {
+		s, _ := v.(string)
+		r := flaggio.UserRole(strings.ToUpper(s))
+		if r.IsValid() && !role.Includes(r) {
+			role = r
+		}
+	}
+	if role == "" {
+		return o.cfg.DefaultRole
+	}
+	return role
+}
+
+// key returns the public key with the given ID. Keys are fetched again
+// when the ID is unknown, in case the provider rotated its keys.
+func (o *OIDC) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	o.mu.RLock()
+	key, ok := o.keys[kid]
+	stale := time.Since(o.fetchedAt) > minKeysRefreshInterval
+	o.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+	if stale {
+		if err := o.refreshKeys(ctx); err != nil {
+			return nil, err
+		}
+		o.mu.RLock()
+		key, ok = o.keys[kid]
+		o.mu.RUnlock()
+		if ok {
+			return key, nil
+		}
+	}
+	return nil, internalerrors.Unauthenticated("unknown token signing key")
+}
+
+func (o *OIDC) refreshKeys(ctx context.Context) error {
+	var jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := getJSON(ctx, o.cfg.Client, o.jwksURI, &jwks); err != nil {
+		return fmt.Errorf("fetching oidc keys: %w", err)
+	}
+	keys := make(map[string]*rsa.PublicKey, len(jwks.Keys))
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return fmt.Errorf("decoding oidc key %s: %w", k.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return fmt.Errorf("decoding oidc key %s: %w", k.Kid, err)
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	o.mu.Lock()
+	o.keys = keys
+	o.fetchedAt = time.Now()
+	o.mu.Unlock()
+	return nil
+}
+
+func hasAudience(claim interface{}, audience string) bool {
+	switch v := claim.(type) {
+	case string:
+		return v == audience
+	case []interface{}:
+		for _, aud := range v {
+			if aud == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func decodeSegment(seg string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	res, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d from %s", res.StatusCode, url)
+	}
+	return json.NewDecoder(res.Body).Decode(v)
+}
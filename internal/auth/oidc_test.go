@@ -0,0 +1,228 @@
+package auth_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	stderrors "errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/auth"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// testIssuer is a minimal OpenID Connect provider that serves its
+// discovery document and keys, and issues signed tokens.
+type testIssuer struct {
+	*httptest.Server
+	keys map[string]*rsa.PrivateKey
+}
+
+func newTestIssuer(t *testing.T, kids ...string) *testIssuer {
+	iss := &testIssuer{keys: map[string]*rsa.PrivateKey{}}
+	for _, kid := range kids {
+		iss.addKey(t, kid)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   iss.URL,
+			"jwks_uri": iss.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		var keys []map[string]string
+		for kid, key := range iss.keys {
+			keys = append(keys, map[string]string{
+				"kty": "RSA",
+				"use": "sig",
+				"kid": kid,
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+	})
+	iss.Server = httptest.NewServer(mux)
+	return iss
+}
+
+func (iss *testIssuer) addKey(t *testing.T, kid string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+	iss.keys[kid] = key
+}
+
+func (iss *testIssuer) sign(t *testing.T, kid string, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	enc := func(v interface{}) string {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("failed to encode token: %s", err)
+		}
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	payload := enc(map[string]string{"alg": "RS256", "typ": "JWT", "kid": kid}) + "." + enc(claims)
+	digest := sha256.Sum256([]byte(payload))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("failed to sign token: %s", err)
+	}
+	return payload + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestOIDC_Authenticate(t *testing.T) {
+	iss := newTestIssuer(t, "k1")
+	defer iss.Close()
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+
+	claims := func(mods map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{
+			"iss":          iss.URL,
+			"aud":          "flaggio",
+			"sub":          "user1",
+			"email":        "john@example.com",
+			"name":         "John",
+			"exp":          time.Now().Add(time.Hour).Unix(),
+			"flaggio_role": "editor",
+		}
+		for k, v := range mods {
+			if v == nil {
+				delete(c, k)
+				continue
+			}
+			c[k] = v
+		}
+		return c
+	}
+
+	tests := []struct {
+		name          string
+		token         func() string
+		expectedUser  *flaggio.User
+		expectedError error
+	}{
+		{
+			name:  "returns the user described by the token",
+			token: func() string { return iss.sign(t, "k1", iss.keys["k1"], claims(nil)) },
+			expectedUser: &flaggio.User{
+				ID: "user1", Email: "john@example.com", Name: "John", Role: flaggio.UserRoleEditor,
+			},
+		},
+		{
+			name: "uses the highest role from a list",
+			token: func() string {
+				return iss.sign(t, "k1", iss.keys["k1"], claims(map[string]interface{}{
+					"flaggio_role": []string{"viewer", "admin", "other"},
+					"aud":          []string{"other", "flaggio"},
+				}))
+			},
+			expectedUser: &flaggio.User{
+				ID: "user1", Email: "john@example.com", Name: "John", Role: flaggio.UserRoleAdmin,
+			},
+		},
+		{
+			name: "uses the default role when there is no role",
+			token: func() string {
+				return iss.sign(t, "k1", iss.keys["k1"], claims(map[string]interface{}{"flaggio_role": nil}))
+			},
+			expectedUser: &flaggio.User{
+				ID: "user1", Email: "john@example.com", Name: "John", Role: flaggio.UserRoleViewer,
+			},
+		},
+		{
+			name: "rejects expired token",
+			token: func() string {
+				return iss.sign(t, "k1", iss.keys["k1"], claims(map[string]interface{}{
+					"exp": time.Now().Add(-time.Hour).Unix(),
+				}))
+			},
+			expectedError: errors.ErrUnauthenticated,
+		},
+		{
+			name: "rejects token for another audience",
+			token: func() string {
+				return iss.sign(t, "k1", iss.keys["k1"], claims(map[string]interface{}{"aud": "other"}))
+			},
+			expectedError: errors.ErrUnauthenticated,
+		},
+		{
+			name: "rejects token from another issuer",
+			token: func() string {
+				return iss.sign(t, "k1", iss.keys["k1"], claims(map[string]interface{}{"iss": "http://evil"}))
+			},
+			expectedError: errors.ErrUnauthenticated,
+		},
+		{
+			name:          "rejects token with invalid signature",
+			token:         func() string { return iss.sign(t, "k1", otherKey, claims(nil)) },
+			expectedError: errors.ErrUnauthenticated,
+		},
+		{
+			name:          "rejects token signed by unknown key",
+			token:         func() string { return iss.sign(t, "k2", otherKey, claims(nil)) },
+			expectedError: errors.ErrUnauthenticated,
+		},
+		{
+			name: "rejects unsigned token",
+			token: func() string {
+				hdr := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+				b, _ := json.Marshal(claims(nil))
+				return hdr + "." + base64.RawURLEncoding.EncodeToString(b) + "."
+			},
+			expectedError: errors.ErrUnauthenticated,
+		},
+		{
+			name:          "rejects malformed token",
+			token:         func() string { return "not-a-token" },
+			expectedError: errors.ErrUnauthenticated,
+		},
+	}
+
+	oidc, err := auth.NewOIDC(context.Background(), auth.OIDCConfig{
+		Issuer:      iss.URL,
+		Audience:    "flaggio",
+		DefaultRole: flaggio.UserRoleViewer,
+	})
+	if err != nil {
+		t.Fatalf("failed to create authenticator: %s", err)
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			usr, err := oidc.Authenticate(context.Background(), tt.token())
+			if tt.expectedError != nil {
+				assert.True(t, stderrors.Is(err, tt.expectedError), "unexpected error: %v", err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedUser, usr)
+		})
+	}
+}
+
+func TestNewOIDC_IssuerMismatch(t *testing.T) {
+	iss := newTestIssuer(t, "k1")
+	defer iss.Close()
+
+	_, err := auth.NewOIDC(context.Background(), auth.OIDCConfig{
+		Issuer:   iss.URL + "/other",
+		Audience: "flaggio",
+	})
+	assert.Error(t, err)
+}
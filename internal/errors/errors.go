@@ -48,12 +48,33 @@ var (
 	ErrNoVariantToDistribute = Err{
 		msg:        "no variants to distribute, please check the rule configuration",
 		statusCode: http.StatusUnprocessableEntity, appCode: "NoVariantToDistribute"}
+	ErrMissingBucketingProperty = Err{
+		msg:        "property used for bucketing is missing from the user context",
+		statusCode: http.StatusUnprocessableEntity, appCode: "MissingBucketingProperty"}
 	ErrNotFound = Err{
 		msg:        "not found",
 		statusCode: http.StatusNotFound, appCode: "NotFound"}
+	ErrPrerequisiteCycle = Err{
+		msg:        "flag prerequisites form a cycle",
+		statusCode: http.StatusUnprocessableEntity, appCode: "PrerequisiteCycle"}
 	ErrNotImplemented = Err{
 		msg:        "not implemented",
 		statusCode: http.StatusNotImplemented, appCode: "NotImplemented"}
+	ErrMissingAPIKey = Err{
+		msg:        "missing API key",
+		statusCode: http.StatusUnauthorized, appCode: "MissingAPIKey"}
+	ErrInvalidAPIKey = Err{
+		msg:        "invalid or revoked API key",
+		statusCode: http.StatusUnauthorized, appCode: "InvalidAPIKey"}
+	ErrUnauthenticated = Err{
+		msg:        "authentication required",
+		statusCode: http.StatusUnauthorized, appCode: "Unauthenticated"}
+	ErrInvalidCredentials = Err{
+		msg:        "invalid email or password",
+		statusCode: http.StatusUnauthorized, appCode: "InvalidCredentials"}
+	ErrForbidden = Err{
+		msg:        "forbidden",
+		statusCode: http.StatusForbidden, appCode: "Forbidden"}
 )
 
 // NotFound returns an ErrNotFound error, for the given entity.
@@ -66,7 +87,23 @@ func BadRequest(message string) error {
 	return fmt.Errorf("%w: %s", ErrBadRequest, message)
 }
 
+// Unauthenticated returns an ErrUnauthenticated error, with an additional message.
+func Unauthenticated(message string) error {
+	return fmt.Errorf("%w: %s", ErrUnauthenticated, message)
+}
+
+// Forbidden returns an ErrForbidden error, with an additional message.
+func Forbidden(message string) error {
+	return fmt.Errorf("%w: %s", ErrForbidden, message)
+}
+
 // InvalidFlag returns an ErrInvalidFlag error, with an additional message.
 func InvalidFlag(message string) error {
 	return fmt.Errorf("%w: %s", ErrInvalidFlag, message)
 }
+
+// MissingBucketingProperty returns an ErrMissingBucketingProperty error, for the
+// given property.
+func MissingBucketingProperty(property string) error {
+	return fmt.Errorf("%w: %s", ErrMissingBucketingProperty, property)
+}
@@ -6,17 +6,50 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 )
 
 type Ruler interface {
 	IsRuler()
 }
 
+type AuditLogFilter struct {
+	EntityType *AuditEntityType `json:"entityType"`
+	EntityID   *string          `json:"entityId"`
+	ActorID    *string          `json:"actorId"`
+	Action     *AuditAction     `json:"action"`
+	From       *time.Time       `json:"from"`
+	To         *time.Time       `json:"to"`
+}
+
+type AuditLogResults struct {
+	Entries []*AuditEntry `json:"entries"`
+	Total   int           `json:"total"`
+}
+
+type CreatedAPIKey struct {
+	APIKey *APIKey `json:"apiKey"`
+	Key    string  `json:"key"`
+}
+
 type FlagResults struct {
 	Flags []*Flag `json:"flags"`
 	Total int     `json:"total"`
 }
 
+type LoginResult struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	User      *User     `json:"user"`
+}
+
+type NewAPIKey struct {
+	Name          string     `json:"name"`
+	Kind          APIKeyKind `json:"kind"`
+	ProjectID     *string    `json:"projectId"`
+	EnvironmentID *string    `json:"environmentId"`
+}
+
 type NewConstraint struct {
 	Property  string        `json:"property"`
 	Operation Operation     `json:"operation"`
@@ -28,18 +61,51 @@ type NewDistribution struct {
 	Percentage int    `json:"percentage"`
 }
 
+type NewEnvironment struct {
+	Key         string  `json:"key"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+}
+
 type NewFlag struct {
+	ProjectID   *string `json:"projectId"`
 	Key         string  `json:"key"`
 	Name        string  `json:"name"`
 	Description *string `json:"description"`
 }
 
+type NewFlagPrerequisite struct {
+	FlagID    string `json:"flagId"`
+	VariantID string `json:"variantId"`
+}
+
 type NewFlagRule struct {
 	Constraints   []*NewConstraint   `json:"constraints"`
 	Distributions []*NewDistribution `json:"distributions"`
+	BucketBy      *string            `json:"bucketBy"`
+}
+
+type NewProject struct {
+	Key         string  `json:"key"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+}
+
+type NewRolloutPlan struct {
+	FlagID        string            `json:"flagId"`
+	EnvironmentID *string           `json:"environmentId"`
+	RuleID        string            `json:"ruleId"`
+	VariantID     string            `json:"variantId"`
+	Steps         []*NewRolloutStep `json:"steps"`
+}
+
+type NewRolloutStep struct {
+	ExecuteAt  time.Time `json:"executeAt"`
+	Percentage int       `json:"percentage"`
 }
 
 type NewSegment struct {
+	ProjectID   *string `json:"projectId"`
 	Name        string  `json:"name"`
 	Description *string `json:"description"`
 }
@@ -48,15 +114,45 @@ type NewSegmentRule struct {
 	Constraints []*NewConstraint `json:"constraints"`
 }
 
+type NewUser struct {
+	Email    string   `json:"email"`
+	Name     string   `json:"name"`
+	Role     UserRole `json:"role"`
+	Password string   `json:"password"`
+}
+
 type NewVariant struct {
 	Description *string     `json:"description"`
 	Value       interface{} `json:"value"`
 }
 
+type RolloutPlanFilter struct {
+	FlagID *string            `json:"flagId"`
+	Status *RolloutPlanStatus `json:"status"`
+}
+
+type ScheduledChangeFilter struct {
+	FlagID *string                `json:"flagId"`
+	Status *ScheduledChangeStatus `json:"status"`
+}
+
+type UpdateEnvironment struct {
+	Key         *string `json:"key"`
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+}
+
 type UpdateFlag struct {
-	Key                   *string `json:"key"`
-	Name                  *string `json:"name"`
-	Description           *string `json:"description"`
+	Key                   *string                `json:"key"`
+	Name                  *string                `json:"name"`
+	Description           *string                `json:"description"`
+	Enabled               *bool                  `json:"enabled"`
+	DefaultVariantWhenOn  *string                `json:"defaultVariantWhenOn"`
+	DefaultVariantWhenOff *string                `json:"defaultVariantWhenOff"`
+	Prerequisites         []*NewFlagPrerequisite `json:"prerequisites"`
+}
+
+type UpdateFlagEnvironment struct {
 	Enabled               *bool   `json:"enabled"`
 	DefaultVariantWhenOn  *string `json:"defaultVariantWhenOn"`
 	DefaultVariantWhenOff *string `json:"defaultVariantWhenOff"`
@@ -65,6 +161,13 @@ type UpdateFlag struct {
 type UpdateFlagRule struct {
 	Constraints   []*NewConstraint   `json:"constraints"`
 	Distributions []*NewDistribution `json:"distributions"`
+	BucketBy      *string            `json:"bucketBy"`
+}
+
+type UpdateProject struct {
+	Key         *string `json:"key"`
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
 }
 
 type UpdateSegment struct {
@@ -76,11 +179,154 @@ type UpdateSegmentRule struct {
 	Constraints []*NewConstraint `json:"constraints"`
 }
 
+type UpdateUser struct {
+	Name     *string   `json:"name"`
+	Role     *UserRole `json:"role"`
+	Password *string   `json:"password"`
+}
+
 type UpdateVariant struct {
 	Description *string     `json:"description"`
 	Value       interface{} `json:"value"`
 }
 
+type APIKeyKind string
+
+const (
+	APIKeyKindServer APIKeyKind = "SERVER"
+	APIKeyKindClient APIKeyKind = "CLIENT"
+)
+
+var AllAPIKeyKind = []APIKeyKind{
+	APIKeyKindServer,
+	APIKeyKindClient,
+}
+
+func (e APIKeyKind) IsValid() bool {
+	switch e {
+	case APIKeyKindServer, APIKeyKindClient:
+		return true
+	}
+	return false
+}
+
+func (e APIKeyKind) String() string {
+	return string(e)
+}
+
+func (e *APIKeyKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = APIKeyKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ApiKeyKind", str)
+	}
+	return nil
+}
+
+func (e APIKeyKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type AuditAction string
+
+const (
+	AuditActionCreate AuditAction = "CREATE"
+	AuditActionUpdate AuditAction = "UPDATE"
+	AuditActionDelete AuditAction = "DELETE"
+)
+
+var AllAuditAction = []AuditAction{
+	AuditActionCreate,
+	AuditActionUpdate,
+	AuditActionDelete,
+}
+
+func (e AuditAction) IsValid() bool {
+	switch e {
+	case AuditActionCreate, AuditActionUpdate, AuditActionDelete:
+		return true
+	}
+	return false
+}
+
+func (e AuditAction) String() string {
+	return string(e)
+}
+
+func (e *AuditAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditAction", str)
+	}
+	return nil
+}
+
+func (e AuditAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type AuditEntityType string
+
+const (
+	AuditEntityTypeFlag            AuditEntityType = "FLAG"
+	AuditEntityTypeSegment         AuditEntityType = "SEGMENT"
+	AuditEntityTypeProject         AuditEntityType = "PROJECT"
+	AuditEntityTypeEnvironment     AuditEntityType = "ENVIRONMENT"
+	AuditEntityTypeAPIKey          AuditEntityType = "API_KEY"
+	AuditEntityTypeUser            AuditEntityType = "USER"
+	AuditEntityTypeScheduledChange AuditEntityType = "SCHEDULED_CHANGE"
+	AuditEntityTypeRolloutPlan     AuditEntityType = "ROLLOUT_PLAN"
+)
+
+var AllAuditEntityType = []AuditEntityType{
+	AuditEntityTypeFlag,
+	AuditEntityTypeSegment,
+	AuditEntityTypeProject,
+	AuditEntityTypeEnvironment,
+	AuditEntityTypeAPIKey,
+	AuditEntityTypeUser,
+	AuditEntityTypeScheduledChange,
+	AuditEntityTypeRolloutPlan,
+}
+
+func (e AuditEntityType) IsValid() bool {
+	switch e {
+	case AuditEntityTypeFlag, AuditEntityTypeSegment, AuditEntityTypeProject, AuditEntityTypeEnvironment, AuditEntityTypeAPIKey, AuditEntityTypeUser, AuditEntityTypeScheduledChange, AuditEntityTypeRolloutPlan:
+		return true
+	}
+	return false
+}
+
+func (e AuditEntityType) String() string {
+	return string(e)
+}
+
+func (e *AuditEntityType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditEntityType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditEntityType", str)
+	}
+	return nil
+}
+
+func (e AuditEntityType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Operation string
 
 const (
@@ -103,6 +349,13 @@ const (
 	OperationIsInSegment      Operation = "IS_IN_SEGMENT"
 	OperationIsntInSegment    Operation = "ISNT_IN_SEGMENT"
 	OperationIsInNetwork      Operation = "IS_IN_NETWORK"
+	OperationBefore           Operation = "BEFORE"
+	OperationAfter            Operation = "AFTER"
+	OperationBetween          Operation = "BETWEEN"
+	OperationSemverEqual      Operation = "SEMVER_EQUAL"
+	OperationSemverGreater    Operation = "SEMVER_GREATER"
+	OperationSemverLower      Operation = "SEMVER_LOWER"
+	OperationSemverInRange    Operation = "SEMVER_IN_RANGE"
 )
 
 var AllOperation = []Operation{
@@ -125,11 +378,18 @@ var AllOperation = []Operation{
 	OperationIsInSegment,
 	OperationIsntInSegment,
 	OperationIsInNetwork,
+	OperationBefore,
+	OperationAfter,
+	OperationBetween,
+	OperationSemverEqual,
+	OperationSemverGreater,
+	OperationSemverLower,
+	OperationSemverInRange,
 }
 
 func (e Operation) IsValid() bool {
 	switch e {
-	case OperationOneOf, OperationNotOneOf, OperationGreater, OperationGreaterOrEqual, OperationLower, OperationLowerOrEqual, OperationExists, OperationDoesntExist, OperationContains, OperationDoesntContain, OperationStartsWith, OperationDoesntStartWith, OperationEndsWith, OperationDoesntEndWith, OperationMatchesRegex, OperationDoesntMatchRegex, OperationIsInSegment, OperationIsntInSegment, OperationIsInNetwork:
+	case OperationOneOf, OperationNotOneOf, OperationGreater, OperationGreaterOrEqual, OperationLower, OperationLowerOrEqual, OperationExists, OperationDoesntExist, OperationContains, OperationDoesntContain, OperationStartsWith, OperationDoesntStartWith, OperationEndsWith, OperationDoesntEndWith, OperationMatchesRegex, OperationDoesntMatchRegex, OperationIsInSegment, OperationIsntInSegment, OperationIsInNetwork, OperationBefore, OperationAfter, OperationBetween, OperationSemverEqual, OperationSemverGreater, OperationSemverLower, OperationSemverInRange:
 		return true
 	}
 	return false
@@ -155,3 +415,226 @@ func (e *Operation) UnmarshalGQL(v interface{}) error {
 func (e Operation) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type RolloutPlanStatus string
+
+const (
+	RolloutPlanStatusActive    RolloutPlanStatus = "ACTIVE"
+	RolloutPlanStatusCompleted RolloutPlanStatus = "COMPLETED"
+	RolloutPlanStatusCancelled RolloutPlanStatus = "CANCELLED"
+	RolloutPlanStatusFailed    RolloutPlanStatus = "FAILED"
+)
+
+var AllRolloutPlanStatus = []RolloutPlanStatus{
+	RolloutPlanStatusActive,
+	RolloutPlanStatusCompleted,
+	RolloutPlanStatusCancelled,
+	RolloutPlanStatusFailed,
+}
+
+func (e RolloutPlanStatus) IsValid() bool {
+	switch e {
+	case RolloutPlanStatusActive, RolloutPlanStatusCompleted, RolloutPlanStatusCancelled, RolloutPlanStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e RolloutPlanStatus) String() string {
+	return string(e)
+}
+
+func (e *RolloutPlanStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RolloutPlanStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RolloutPlanStatus", str)
+	}
+	return nil
+}
+
+func (e RolloutPlanStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ScheduledChangeStatus string
+
+const (
+	ScheduledChangeStatusPending   ScheduledChangeStatus = "PENDING"
+	ScheduledChangeStatusRunning   ScheduledChangeStatus = "RUNNING"
+	ScheduledChangeStatusApplied   ScheduledChangeStatus = "APPLIED"
+	ScheduledChangeStatusFailed    ScheduledChangeStatus = "FAILED"
+	ScheduledChangeStatusCancelled ScheduledChangeStatus = "CANCELLED"
+)
+
+var AllScheduledChangeStatus = []ScheduledChangeStatus{
+	ScheduledChangeStatusPending,
+	ScheduledChangeStatusRunning,
+	ScheduledChangeStatusApplied,
+	ScheduledChangeStatusFailed,
+	ScheduledChangeStatusCancelled,
+}
+
+func (e ScheduledChangeStatus) IsValid() bool {
+	switch e {
+	case ScheduledChangeStatusPending, ScheduledChangeStatusRunning, ScheduledChangeStatusApplied, ScheduledChangeStatusFailed, ScheduledChangeStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func (e ScheduledChangeStatus) String() string {
+	return string(e)
+}
+
+func (e *ScheduledChangeStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ScheduledChangeStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ScheduledChangeStatus", str)
+	}
+	return nil
+}
+
+func (e ScheduledChangeStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ScheduledChangeType string
+
+const (
+	ScheduledChangeTypeUpdateFlag            ScheduledChangeType = "UPDATE_FLAG"
+	ScheduledChangeTypeUpdateFlagEnvironment ScheduledChangeType = "UPDATE_FLAG_ENVIRONMENT"
+	ScheduledChangeTypeCreateFlagRule        ScheduledChangeType = "CREATE_FLAG_RULE"
+	ScheduledChangeTypeUpdateFlagRule        ScheduledChangeType = "UPDATE_FLAG_RULE"
+	ScheduledChangeTypeDeleteFlagRule        ScheduledChangeType = "DELETE_FLAG_RULE"
+)
+
+var AllScheduledChangeType = []ScheduledChangeType{
+	ScheduledChangeTypeUpdateFlag,
+	ScheduledChangeTypeUpdateFlagEnvironment,
+	ScheduledChangeTypeCreateFlagRule,
+	ScheduledChangeTypeUpdateFlagRule,
+	ScheduledChangeTypeDeleteFlagRule,
+}
+
+func (e ScheduledChangeType) IsValid() bool {
+	switch e {
+	case ScheduledChangeTypeUpdateFlag, ScheduledChangeTypeUpdateFlagEnvironment, ScheduledChangeTypeCreateFlagRule, ScheduledChangeTypeUpdateFlagRule, ScheduledChangeTypeDeleteFlagRule:
+		return true
+	}
+	return false
+}
+
+func (e ScheduledChangeType) String() string {
+	return string(e)
+}
+
+func (e *ScheduledChangeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ScheduledChangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ScheduledChangeType", str)
+	}
+	return nil
+}
+
+func (e ScheduledChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type UsageGranularity string
+
+const (
+	UsageGranularityHour UsageGranularity = "HOUR"
+	UsageGranularityDay  UsageGranularity = "DAY"
+)
+
+var AllUsageGranularity = []UsageGranularity{
+	UsageGranularityHour,
+	UsageGranularityDay,
+}
+
+func (e UsageGranularity) IsValid() bool {
+	switch e {
+	case UsageGranularityHour, UsageGranularityDay:
+		return true
+	}
+	return false
+}
+
+func (e UsageGranularity) String() string {
+	return string(e)
+}
+
+func (e *UsageGranularity) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UsageGranularity(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UsageGranularity", str)
+	}
+	return nil
+}
+
+func (e UsageGranularity) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type UserRole string
+
+const (
+	UserRoleViewer UserRole = "VIEWER"
+	UserRoleEditor UserRole = "EDITOR"
+	UserRoleAdmin  UserRole = "ADMIN"
+)
+
+var AllUserRole = []UserRole{
+	UserRoleViewer,
+	UserRoleEditor,
+	UserRoleAdmin,
+}
+
+func (e UserRole) IsValid() bool {
+	switch e {
+	case UserRoleViewer, UserRoleEditor, UserRoleAdmin:
+		return true
+	}
+	return false
+}
+
+func (e UserRole) String() string {
+	return string(e)
+}
+
+func (e *UserRole) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UserRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UserRole", str)
+	}
+	return nil
+}
+
+func (e UserRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
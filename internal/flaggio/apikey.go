@@ -0,0 +1,53 @@
+package flaggio
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+var _ Identifier = (*APIKey)(nil)
+
+// APIKey is used by SDKs to authenticate against the evaluation API. Only
+// the hash of the key is stored. Keys can be restricted to a project and
+// an environment, in which case all requests made with the key evaluate
+// flags in them. Client keys are meant to be embedded in public clients,
+// like browsers and mobile apps, so they can't be used for debugging.
+type APIKey struct {
+	ID            string
+	Name          string
+	Kind          APIKeyKind
+	Hash          string `json:"-"`
+	ProjectID     *string
+	EnvironmentID *string
+	CreatedAt     time.Time
+	RevokedAt     *time.Time
+}
+
+// GetID returns the API key ID.
+func (k *APIKey) GetID() string {
+	return k.ID
+}
+
+// IsRevoked returns whether the API key was revoked.
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// GenerateAPIKey returns a new random key of the given kind. Keys are
+// prefixed with their kind, so that they can be told apart.
+func GenerateAPIKey(kind APIKeyKind) (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return strings.ToLower(string(kind)) + "-" + hex.EncodeToString(b), nil
+}
+
+// HashAPIKey returns the hash that is stored for the given key.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
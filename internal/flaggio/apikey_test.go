@@ -0,0 +1,49 @@
+package flaggio_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+func TestAPIKey_GetID(t *testing.T) {
+	t.Parallel()
+	key := flaggio.APIKey{ID: "123456"}
+	assert.Equal(t, "123456", key.GetID())
+}
+
+func TestAPIKey_IsRevoked(t *testing.T) {
+	t.Parallel()
+	key := flaggio.APIKey{ID: "123456"}
+	assert.False(t, key.IsRevoked())
+
+	now := time.Now()
+	key.RevokedAt = &now
+	assert.True(t, key.IsRevoked())
+}
+
+func TestGenerateAPIKey(t *testing.T) {
+	t.Parallel()
+	srvKey, err := flaggio.GenerateAPIKey(flaggio.APIKeyKindServer)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(srvKey, "server-"))
+
+	cliKey, err := flaggio.GenerateAPIKey(flaggio.APIKeyKindClient)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(cliKey, "client-"))
+
+	otherKey, err := flaggio.GenerateAPIKey(flaggio.APIKeyKindServer)
+	assert.NoError(t, err)
+	assert.NotEqual(t, srvKey, otherKey)
+}
+
+func TestHashAPIKey(t *testing.T) {
+	t.Parallel()
+	hash := flaggio.HashAPIKey("server-abc")
+	assert.Equal(t, hash, flaggio.HashAPIKey("server-abc"))
+	assert.NotEqual(t, hash, flaggio.HashAPIKey("server-abd"))
+	assert.NotContains(t, hash, "abc")
+}
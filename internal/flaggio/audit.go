@@ -0,0 +1,149 @@
+package flaggio
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// AuditEntry is a record of a change made through the admin API. Before
+// and After are JSON snapshots of the changed entity, nil when it didn't
+// exist. Operation is the name of the mutation used for the change.
+type AuditEntry struct {
+	ID         string
+	Actor      *AuditActor
+	EntityType AuditEntityType
+	EntityID   string
+	Action     AuditAction
+	Operation  string
+	Before     interface{}
+	After      interface{}
+	Changes    []*AuditChange
+	CreatedAt  time.Time
+}
+
+// AuditActor is the user that made a change. It's not set when
+// authentication is disabled.
+type AuditActor struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+// AuditChange is a value that changed in an entity. Path is the dot
+// separated path to the value in the entity snapshot.
+type AuditChange struct {
+	Path   string
+	Before interface{}
+	After  interface{}
+}
+
+// NewAuditEntry returns an entry for a change to an entity, with the
+// values that changed between before and after. Before and after are the
+// entity before and after the change, nil when it didn't exist.
+func NewAuditEntry(entityType AuditEntityType, entityID string, action AuditAction,
+	before, after interface{}) (AuditEntry, error) {
+	bSnapshot, err := Snapshot(before)
+	if err != nil {
+		return AuditEntry{}, err
+	}
+	aSnapshot, err := Snapshot(after)
+	if err != nil {
+		return AuditEntry{}, err
+	}
+	entry := AuditEntry{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Changes:    Diff(bSnapshot, aSnapshot),
+	}
+	// keep untyped nils, so that missing snapshots are stored as null
+	if bSnapshot != nil {
+		entry.Before = bSnapshot
+	}
+	if aSnapshot != nil {
+		entry.After = aSnapshot
+	}
+	return entry, nil
+}
+
+// Snapshot returns the JSON representation of the entity as a map, or
+// nil if there is no entity.
+func Snapshot(entity interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+	var snapshot map[string]interface{}
+	if err := json.Unmarshal(b, &snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// Diff returns the values that changed between two snapshots, sorted by
+// path. Lists of objects that have an ID are compared item by item, using
+// the ID in the path. Other lists are compared as a whole.
+func Diff(before, after map[string]interface{}) []*AuditChange {
+	changes := []*AuditChange{}
+	diffValues("", before, after, &changes)
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+func diffValues(path string, before, after interface{}, changes *[]*AuditChange) {
+	if reflect.DeepEqual(before, after) {
+		return
+	}
+	bMap, bIsMap := before.(map[string]interface{})
+	aMap, aIsMap := after.(map[string]interface{})
+	if bIsMap && aIsMap {
+		for k, v := range bMap {
+			diffValues(joinPath(path, k), v, aMap[k], changes)
+		}
+		for k, v := range aMap {
+			if _, ok := bMap[k]; !ok {
+				diffValues(joinPath(path, k), nil, v, changes)
+			}
+		}
+		return
+	}
+	bList, bOk := indexByID(before)
+	aList, aOk := indexByID(after)
+	if bOk && aOk {
+		diffValues(path, bList, aList, changes)
+		return
+	}
+	*changes = append(*changes, &AuditChange{Path: path, Before: before, After: after})
+}
+
+// indexByID turns a list of objects with an ID into a map of objects by ID.
+func indexByID(v interface{}) (map[string]interface{}, bool) {
+	list, ok := v.([]interface{})
+	if !ok {
+		return nil, v == nil
+	}
+	res := make(map[string]interface{}, len(list))
+	for _, item := range list {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		id, ok := obj["ID"].(string)
+		if !ok || id == "" {
+			return nil, false
+		}
+		res[id] = obj
+	}
+	return res, true
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,91 @@
+package flaggio_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+func TestSnapshot(t *testing.T) {
+	t.Parallel()
+	snapshot, err := flaggio.Snapshot(&flaggio.User{ID: "1", Email: "john@example.com", PasswordHash: "secret"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", snapshot["ID"])
+	assert.Equal(t, "john@example.com", snapshot["Email"])
+	assert.NotContains(t, snapshot, "PasswordHash")
+
+	var flg *flaggio.Flag
+	snapshot, err = flaggio.Snapshot(flg)
+	assert.NoError(t, err)
+	assert.Nil(t, snapshot)
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		before   map[string]interface{}
+		after    map[string]interface{}
+		expected []*flaggio.AuditChange
+	}{
+		{
+			name:     "returns no changes for equal snapshots",
+			before:   map[string]interface{}{"Name": "a", "Enabled": true},
+			after:    map[string]interface{}{"Name": "a", "Enabled": true},
+			expected: []*flaggio.AuditChange{},
+		},
+		{
+			name:   "returns changed, added and removed values",
+			before: map[string]interface{}{"Name": "a", "Enabled": true, "Description": "desc"},
+			after:  map[string]interface{}{"Name": "b", "Enabled": true, "Key": "k"},
+			expected: []*flaggio.AuditChange{
+				{Path: "Description", Before: "desc"},
+				{Path: "Key", After: "k"},
+				{Path: "Name", Before: "a", After: "b"},
+			},
+		},
+		{
+			name: "compares nested objects and lists of objects by ID",
+			before: map[string]interface{}{
+				"DefaultVariantWhenOn": map[string]interface{}{"ID": "1", "Value": true},
+				"Rules": []interface{}{
+					map[string]interface{}{"ID": "r1", "BucketBy": ""},
+					map[string]interface{}{"ID": "r2", "BucketBy": ""},
+				},
+				"Users": []interface{}{"a", "b"},
+			},
+			after: map[string]interface{}{
+				"DefaultVariantWhenOn": map[string]interface{}{"ID": "2", "Value": false},
+				"Rules": []interface{}{
+					map[string]interface{}{"ID": "r2", "BucketBy": "orgId"},
+				},
+				"Users": []interface{}{"a"},
+			},
+			expected: []*flaggio.AuditChange{
+				{Path: "DefaultVariantWhenOn.ID", Before: "1", After: "2"},
+				{Path: "DefaultVariantWhenOn.Value", Before: true, After: false},
+				{Path: "Rules.r1", Before: map[string]interface{}{"ID": "r1", "BucketBy": ""}},
+				{Path: "Rules.r2.BucketBy", Before: "", After: "orgId"},
+				{Path: "Users", Before: []interface{}{"a", "b"}, After: []interface{}{"a"}},
+			},
+		},
+		{
+			name:   "returns all values of created entities",
+			before: nil,
+			after:  map[string]interface{}{"Name": "a", "Rules": []interface{}{map[string]interface{}{"ID": "r1"}}},
+			expected: []*flaggio.AuditChange{
+				{Path: "Name", After: "a"},
+				{Path: "Rules.r1", After: map[string]interface{}{"ID": "r1"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, flaggio.Diff(tt.before, tt.after))
+		})
+	}
+}
@@ -6,10 +6,13 @@ import (
 )
 
 const (
-	namespace         = "flaggio"
-	flagNamespace     = "flag"
-	segmentNamespace  = "segment"
-	evaluateNamespace = "eval"
+	namespace            = "flaggio"
+	flagNamespace        = "flag"
+	segmentNamespace     = "segment"
+	evaluateNamespace    = "eval"
+	environmentNamespace = "env"
+	projectNamespace     = "project"
+	apiKeyNamespace      = "apikey"
 )
 
 func cacheKey(model string, parts ...string) string {
@@ -32,3 +35,15 @@ func SegmentCacheKey(parts ...string) string {
 func EvalCacheKey(parts ...string) string {
 	return cacheKey(evaluateNamespace, parts...)
 }
+
+func EnvironmentCacheKey(parts ...string) string {
+	return cacheKey(environmentNamespace, parts...)
+}
+
+func ProjectCacheKey(parts ...string) string {
+	return cacheKey(projectNamespace, parts...)
+}
+
+func APIKeyCacheKey(parts ...string) string {
+	return cacheKey(apiKeyNamespace, parts...)
+}
@@ -87,3 +87,84 @@ func TestEvalCacheKey(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentCacheKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		parts       []string
+		expectedKey string
+	}{
+		{
+			name:        "uses no parts",
+			parts:       []string{},
+			expectedKey: "flaggio:env",
+		},
+		{
+			name:        "uses all parts",
+			parts:       []string{"key", "production"},
+			expectedKey: "flaggio:env:key:production",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			key := flaggio.EnvironmentCacheKey(tt.parts...)
+			assert.Equal(t, tt.expectedKey, key)
+		})
+	}
+}
+
+func TestProjectCacheKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		parts       []string
+		expectedKey string
+	}{
+		{
+			name:        "uses no parts",
+			parts:       []string{},
+			expectedKey: "flaggio:project",
+		},
+		{
+			name:        "uses all parts",
+			parts:       []string{"key", "checkout"},
+			expectedKey: "flaggio:project:key:checkout",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			key := flaggio.ProjectCacheKey(tt.parts...)
+			assert.Equal(t, tt.expectedKey, key)
+		})
+	}
+}
+
+func TestAPIKeyCacheKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		parts       []string
+		expectedKey string
+	}{
+		{
+			name:        "uses no parts",
+			parts:       []string{},
+			expectedKey: "flaggio:apikey",
+		},
+		{
+			name:        "uses all parts",
+			parts:       []string{"hash", "abc"},
+			expectedKey: "flaggio:apikey:hash:abc",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			key := flaggio.APIKeyCacheKey(tt.parts...)
+			assert.Equal(t, tt.expectedKey, key)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package flaggio
+
+// ChangesChannel is the redis pub/sub channel where change events are published.
+const ChangesChannel = namespace + ":changes"
+
+// ChangeType is the type of entity a change event refers to.
+type ChangeType string
+
+const (
+	// ChangeTypeFlag is used for changes to flags, including their
+	// variants, rules and targets.
+	ChangeTypeFlag ChangeType = "flag"
+	// ChangeTypeSegment is used for changes to segments and their rules.
+	ChangeTypeSegment ChangeType = "segment"
+)
+
+// ChangeEvent notifies that a flag or a segment was created, updated or
+// deleted. Key is only set for flags. Key and ProjectID may be unknown
+// for deleted entities, in which case they are empty.
+type ChangeEvent struct {
+	Type      ChangeType `json:"type"`
+	ID        string     `json:"id"`
+	Key       string     `json:"key,omitempty"`
+	ProjectID *string    `json:"projectId,omitempty"`
+}
@@ -2,6 +2,7 @@ package flaggio
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/victorkt/flaggio/internal/errors"
 	"github.com/victorkt/flaggio/internal/operator"
@@ -24,7 +25,9 @@ type Constraint struct {
 
 // Validate will check if a property in the user context passes some operation based on
 // some configured valid values. Some operations don't need the property to be defined,
-// while some others don't required any valid values.
+// while some others don't required any valid values. OperationBetween is checked
+// against the current time when no property is defined. The property can be a path
+// to a nested value, like "user.plan" or "roles[0]".
 func (c Constraint) Validate(usrContext map[string]interface{}) (bool, error) {
 	operate, ok := operatorMap[c.Operation]
 	if !ok {
@@ -34,8 +37,13 @@ func (c Constraint) Validate(usrContext map[string]interface{}) (bool, error) {
 	switch c.Operation {
 	case OperationIsInSegment, OperationIsntInSegment:
 		return operate(usrContext, c.Values)
+	case OperationBetween:
+		if c.Property == "" {
+			return operate(time.Now(), c.Values)
+		}
+		return operate(Lookup(usrContext, c.Property), c.Values)
 	default:
-		return operate(usrContext[c.Property], c.Values)
+		return operate(Lookup(usrContext, c.Property), c.Values)
 	}
 }
 
@@ -60,6 +68,53 @@ func (c *Constraint) populateSegments(identifiers []Identifier) {
 	}
 }
 
+// clockWatch finds the next time at which constraints checked against the
+// current time can change their result.
+type clockWatch struct {
+	now     time.Time
+	next    time.Time
+	visited map[interface{}]bool
+}
+
+func (w *clockWatch) flag(f *Flag) {
+	if f == nil || w.visited[f] {
+		return
+	}
+	// prerequisites can reference each other in a cycle
+	w.visited[f] = true
+	for _, p := range f.Prerequisites {
+		w.flag(p.Flag)
+	}
+	for _, rl := range f.Rules {
+		w.constraints(rl.Constraints)
+	}
+}
+
+func (w *clockWatch) constraints(l []*Constraint) {
+	for _, c := range l {
+		switch c.Operation {
+		case OperationBetween:
+			if c.Property != "" {
+				continue
+			}
+			if t, ok := operator.NextTime(w.now, c.Values); ok && (w.next.IsZero() || t.Before(w.next)) {
+				w.next = t
+			}
+		case OperationIsInSegment, OperationIsntInSegment:
+			for _, v := range c.Values {
+				sgmnt, ok := v.(*Segment)
+				if !ok || w.visited[sgmnt] {
+					continue
+				}
+				w.visited[sgmnt] = true
+				for _, rl := range sgmnt.Rules {
+					w.constraints(rl.Constraints)
+				}
+			}
+		}
+	}
+}
+
 // ConstraintList is a slice of *Constraint.
 type ConstraintList []*Constraint
 
@@ -106,4 +161,11 @@ var operatorMap = map[Operation]Operator{
 	OperationIsInSegment:      operator.Validates,
 	OperationIsntInSegment:    operator.DoesntValidate,
 	OperationIsInNetwork:      operator.InNetwork,
+	OperationBefore:           operator.Before,
+	OperationAfter:            operator.After,
+	OperationBetween:          operator.Between,
+	OperationSemverEqual:      operator.SemverEqual,
+	OperationSemverGreater:    operator.SemverGreater,
+	OperationSemverLower:      operator.SemverLower,
+	OperationSemverInRange:    operator.SemverInRange,
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -54,6 +55,26 @@ func TestConstraint_Validate(t *testing.T) {
 			expectedUsrValue: map[string]interface{}{"key": 1},
 			expectedResult:   false,
 		},
+		{
+			name:             "passes the property as argument for Between operations",
+			cnstrnt:          Constraint{Property: "key", Operation: OperationBetween, Values: []interface{}{1, 2}},
+			usrContext:       map[string]interface{}{"key": 1},
+			operatorCalls:    1,
+			operatorResult:   true,
+			expectedUsrValue: 1,
+			expectedResult:   true,
+		},
+		{
+			name:    "passes the nested value as argument for property paths",
+			cnstrnt: Constraint{Property: "user.roles[1]", Operation: OperationOneOf, Values: []interface{}{"admin"}},
+			usrContext: map[string]interface{}{
+				"user": map[string]interface{}{"roles": []interface{}{"viewer", "admin"}},
+			},
+			operatorCalls:    1,
+			operatorResult:   true,
+			expectedUsrValue: "admin",
+			expectedResult:   true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -87,6 +108,36 @@ func TestConstraint_Validate(t *testing.T) {
 	}
 }
 
+func TestConstraint_ValidateBetweenServerTime(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name           string
+		values         []interface{}
+		expectedResult bool
+	}{
+		{
+			name:           "current time is between",
+			values:         []interface{}{now.Add(-time.Hour).Format(time.RFC3339), now.Add(time.Hour).Format(time.RFC3339)},
+			expectedResult: true,
+		},
+		{
+			name:           "current time is not between",
+			values:         []interface{}{now.Add(time.Hour).Format(time.RFC3339), now.Add(2 * time.Hour).Format(time.RFC3339)},
+			expectedResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cnstrnt := Constraint{Operation: OperationBetween, Values: tt.values}
+			res, err := cnstrnt.Validate(map[string]interface{}{"key": 1})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, res)
+		})
+	}
+}
+
 func TestConstraintList_Validate(t *testing.T) {
 	tests := []struct {
 		name           string
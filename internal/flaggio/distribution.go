@@ -1,14 +1,19 @@
 package flaggio
 
 import (
-	"math/rand"
-	"time"
+	"crypto/sha1" // nolint // only used for bucketing users
+	"encoding/binary"
+	"fmt"
 
 	"github.com/victorkt/flaggio/internal/errors"
 )
 
 var _ Evaluator = (*DistributionList)(nil)
 
+// DefaultBucketBy is the user context property used to bucket users
+// into distributions when no other property is configured on the rule.
+const DefaultBucketBy = "$userId"
+
 // Distribution represents a percentage chance for a variant to
 // be selected as the result value for the flag evaluation.
 type Distribution struct {
@@ -17,29 +22,64 @@ type Distribution struct {
 	Percentage int
 }
 
-// DistributionList is a slice of *Distribution.
-type DistributionList []*Distribution
+// DistributionList is a list of distributions that belong to a flag rule.
+// BucketBy is the user context property used to bucket users, defaulting
+// to DefaultBucketBy when empty. Salt is combined with the property value
+// when bucketing users, so that the same user can be bucketed differently
+// on each rule.
+type DistributionList struct {
+	Salt          string
+	BucketBy      string
+	Distributions []*Distribution
+}
 
-// Evaluate will select one of the distributions based on their percentage
-// chance and return it's value as answer.
+// Evaluate will select one of the distributions based on the bucket the user
+// falls into and return it's value as answer.
+// The property used for bucketing is resolved with Lookup, the same way as
+// the properties of constraints. If it's missing from the user context, an
+// error is returned.
 func (dl DistributionList) Evaluate(usrContext map[string]interface{}) (EvalResult, error) {
-	ref := dl.Distribute()
+	bucketBy := dl.BucketBy
+	if bucketBy == "" {
+		bucketBy = DefaultBucketBy
+	}
+	v := Lookup(usrContext, bucketBy)
+	if v == nil {
+		return EvalResult{}, errors.MissingBucketingProperty(bucketBy)
+	}
+	ref := dl.Distribute(fmt.Sprintf("%v", v))
 	if ref == nil || ref.ID == "" {
 		// configuration problem, return error
 		return EvalResult{}, errors.ErrNoVariantToDistribute
 	}
 	return EvalResult{
-		Answer: ref.Value,
+		Answer:    ref.Value,
+		VariantID: ref.ID,
 	}, nil
 }
 
-// Distribute selects a distribution randomly, respecting the configured probability.
-func (dl DistributionList) Distribute() *Variant {
-	r1 := rand.New(rand.NewSource(time.Now().UnixNano()))
-	num := 1 + r1.Intn(100) // random int between 1 and 100
+// isSplit returns whether users can be distributed to more than one variant.
+func (dl DistributionList) isSplit() bool {
+	var n int
+	for _, dstrbtn := range dl.Distributions {
+		if dstrbtn.Percentage > 0 {
+			n++
+		}
+	}
+	return n > 1
+}
+
+// Distribute selects a distribution for the given user value, respecting the
+// configured percentages. The same user value and salt will always result in
+// the same distribution being selected.
+func (dl DistributionList) Distribute(usrValue string) *Variant {
+	if len(dl.Distributions) == 0 {
+		return nil
+	}
+	num := Bucket(dl.Salt, usrValue)
 
 	var total int
-	for _, dstrbtn := range dl {
+	for _, dstrbtn := range dl.Distributions {
 		total += dstrbtn.Percentage
 		if num <= total {
 			return dstrbtn.Variant
@@ -47,5 +87,14 @@ func (dl DistributionList) Distribute() *Variant {
 	}
 
 	// fallback, should never happen
-	return dl[0].Variant
+	return dl.Distributions[0].Variant
+}
+
+// Bucket hashes the salt and the user value into a stable number
+// between 1 and 100.
+func Bucket(salt, usrValue string) int {
+	h := sha1.New() // nolint // we don't care about security for this
+	_, _ = h.Write([]byte(salt + "." + usrValue))
+	sum := h.Sum(nil)
+	return 1 + int(binary.BigEndian.Uint64(sum[:8])%100)
 }
@@ -1,12 +1,106 @@
 package flaggio_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/errors"
 	"github.com/victorkt/flaggio/internal/flaggio"
 )
 
+func TestDistributionList_Evaluate(t *testing.T) {
+	t.Parallel()
+	vrnt1 := &flaggio.Variant{ID: "1", Value: "a"}
+	vrnt2 := &flaggio.Variant{ID: "2", Value: "b"}
+
+	tests := []struct {
+		name           string
+		usrContext     map[string]interface{}
+		distributions  flaggio.DistributionList
+		expectedResult flaggio.EvalResult
+		expectedError  error
+	}{
+		{
+			name:       "returns the variant with 100% distribution",
+			usrContext: map[string]interface{}{"$userId": "user1"},
+			distributions: flaggio.DistributionList{
+				Salt: "1",
+				Distributions: []*flaggio.Distribution{
+					{Variant: vrnt1, Percentage: 0},
+					{Variant: vrnt2, Percentage: 100},
+				},
+			},
+			expectedResult: flaggio.EvalResult{Answer: "b", VariantID: "2"},
+		},
+		{
+			name:       "buckets users by the configured property",
+			usrContext: map[string]interface{}{"orgId": int64(10)},
+			distributions: flaggio.DistributionList{
+				Salt:          "1",
+				BucketBy:      "orgId",
+				Distributions: []*flaggio.Distribution{{Variant: vrnt1, Percentage: 100}},
+			},
+			expectedResult: flaggio.EvalResult{Answer: "a", VariantID: "1"},
+		},
+		{
+			name:       "buckets users by a nested property",
+			usrContext: map[string]interface{}{"account": map[string]interface{}{"id": "acc1"}},
+			distributions: flaggio.DistributionList{
+				Salt:          "1",
+				BucketBy:      "account.id",
+				Distributions: []*flaggio.Distribution{{Variant: vrnt1, Percentage: 100}},
+			},
+			expectedResult: flaggio.EvalResult{Answer: "a", VariantID: "1"},
+		},
+		{
+			name:       "returns error when the nested property is missing",
+			usrContext: map[string]interface{}{"account": map[string]interface{}{"name": "acme"}},
+			distributions: flaggio.DistributionList{
+				Salt:          "1",
+				BucketBy:      "account.id",
+				Distributions: []*flaggio.Distribution{{Variant: vrnt1, Percentage: 100}},
+			},
+			expectedError: errors.MissingBucketingProperty("account.id"),
+		},
+		{
+			name:       "returns error when there is no user ID",
+			usrContext: map[string]interface{}{},
+			distributions: flaggio.DistributionList{
+				Salt:          "1",
+				Distributions: []*flaggio.Distribution{{Variant: vrnt1, Percentage: 100}},
+			},
+			expectedError: errors.MissingBucketingProperty("$userId"),
+		},
+		{
+			name:       "returns error when the configured property is missing",
+			usrContext: map[string]interface{}{"$userId": "user1"},
+			distributions: flaggio.DistributionList{
+				Salt:          "1",
+				BucketBy:      "orgId",
+				Distributions: []*flaggio.Distribution{{Variant: vrnt1, Percentage: 100}},
+			},
+			expectedError: errors.MissingBucketingProperty("orgId"),
+		},
+		{
+			name:          "returns error when there are no distributions",
+			usrContext:    map[string]interface{}{"$userId": "user1"},
+			distributions: flaggio.DistributionList{Salt: "1"},
+			expectedError: errors.ErrNoVariantToDistribute,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			eval, err := tt.distributions.Evaluate(tt.usrContext)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedResult, eval)
+		})
+	}
+}
+
 func TestDistributionList_Distribute(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping testing in short mode")
@@ -15,15 +109,18 @@ func TestDistributionList_Distribute(t *testing.T) {
 	vrnt2 := &flaggio.Variant{ID: "2"}
 	vrnt3 := &flaggio.Variant{ID: "3"}
 	dstrbtn := flaggio.DistributionList{
-		{Variant: vrnt1, Percentage: 20},
-		{Variant: vrnt2, Percentage: 70},
-		{Variant: vrnt3, Percentage: 10},
+		Salt: "abc",
+		Distributions: []*flaggio.Distribution{
+			{Variant: vrnt1, Percentage: 20},
+			{Variant: vrnt2, Percentage: 70},
+			{Variant: vrnt3, Percentage: 10},
+		},
 	}
 
 	totalDistributions := 500000
 	var vrnt1Count, vrnt2Count, vrnt3Count int
 	for i := 0; i < totalDistributions; i++ {
-		vrnt := dstrbtn.Distribute()
+		vrnt := dstrbtn.Distribute(fmt.Sprintf("user%d", i))
 		switch vrnt {
 		case vrnt1:
 			vrnt1Count++
@@ -34,9 +131,75 @@ func TestDistributionList_Distribute(t *testing.T) {
 		}
 	}
 
-	assert.InDelta(t, dstrbtn[0].Percentage, float32(vrnt1Count)/float32(totalDistributions)*100, 0.2)
-	assert.InDelta(t, dstrbtn[1].Percentage, float32(vrnt2Count)/float32(totalDistributions)*100, 0.2)
-	assert.InDelta(t, dstrbtn[2].Percentage, float32(vrnt3Count)/float32(totalDistributions)*100, 0.2)
+	assert.InDelta(t, dstrbtn.Distributions[0].Percentage, float32(vrnt1Count)/float32(totalDistributions)*100, 0.2)
+	assert.InDelta(t, dstrbtn.Distributions[1].Percentage, float32(vrnt2Count)/float32(totalDistributions)*100, 0.2)
+	assert.InDelta(t, dstrbtn.Distributions[2].Percentage, float32(vrnt3Count)/float32(totalDistributions)*100, 0.2)
+}
+
+func TestDistributionList_DistributeIsSticky(t *testing.T) {
+	t.Parallel()
+	vrnt1 := &flaggio.Variant{ID: "1"}
+	vrnt2 := &flaggio.Variant{ID: "2"}
+	dstrbtn := flaggio.DistributionList{
+		Salt: "abc",
+		Distributions: []*flaggio.Distribution{
+			{Variant: vrnt1, Percentage: 50},
+			{Variant: vrnt2, Percentage: 50},
+		},
+	}
+
+	for i := 0; i < 1000; i++ {
+		usrID := fmt.Sprintf("user%d", i)
+		expected := dstrbtn.Distribute(usrID)
+		for j := 0; j < 10; j++ {
+			assert.Same(t, expected, dstrbtn.Distribute(usrID))
+		}
+	}
+}
+
+func TestDistributionList_DistributeKeepsUsersWhenPercentageGrows(t *testing.T) {
+	t.Parallel()
+	vrnt1 := &flaggio.Variant{ID: "1"}
+	vrnt2 := &flaggio.Variant{ID: "2"}
+	newDistributionList := func(percentage int) flaggio.DistributionList {
+		return flaggio.DistributionList{
+			Salt: "abc",
+			Distributions: []*flaggio.Distribution{
+				{Variant: vrnt1, Percentage: percentage},
+				{Variant: vrnt2, Percentage: 100 - percentage},
+			},
+		}
+	}
+
+	for i := 0; i < 1000; i++ {
+		usrID := fmt.Sprintf("user%d", i)
+		wasIn := false
+		for percentage := 0; percentage <= 100; percentage += 10 {
+			isIn := newDistributionList(percentage).Distribute(usrID) == vrnt1
+			if wasIn {
+				assert.True(t, isIn, "user %s left the rollout at %d%%", usrID, percentage)
+			}
+			wasIn = isIn
+		}
+		assert.True(t, wasIn)
+	}
+}
+
+func TestBucket(t *testing.T) {
+	t.Parallel()
+	var differentBuckets int
+	for i := 0; i < 1000; i++ {
+		usrID := fmt.Sprintf("user%d", i)
+		bucket := flaggio.Bucket("salt1", usrID)
+		assert.GreaterOrEqual(t, bucket, 1)
+		assert.LessOrEqual(t, bucket, 100)
+		assert.Equal(t, bucket, flaggio.Bucket("salt1", usrID))
+		if bucket != flaggio.Bucket("salt2", usrID) {
+			differentBuckets++
+		}
+	}
+	// users must be bucketed independently for different salts
+	assert.Greater(t, differentBuckets, 900)
 }
 
 func BenchmarkDistributionList_Distribute(b *testing.B) {
@@ -44,12 +207,15 @@ func BenchmarkDistributionList_Distribute(b *testing.B) {
 	vrnt2 := &flaggio.Variant{ID: "2"}
 	vrnt3 := &flaggio.Variant{ID: "3"}
 	dstrbtn := flaggio.DistributionList{
-		{Variant: vrnt1, Percentage: 20},
-		{Variant: vrnt2, Percentage: 70},
-		{Variant: vrnt3, Percentage: 10},
+		Salt: "abc",
+		Distributions: []*flaggio.Distribution{
+			{Variant: vrnt1, Percentage: 20},
+			{Variant: vrnt2, Percentage: 70},
+			{Variant: vrnt3, Percentage: 10},
+		},
 	}
 
 	for n := 0; n < b.N; n++ {
-		_ = dstrbtn.Distribute()
+		_ = dstrbtn.Distribute("user1")
 	}
 }
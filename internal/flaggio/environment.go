@@ -0,0 +1,35 @@
+package flaggio
+
+import (
+	"time"
+)
+
+var _ Identifier = (*Environment)(nil)
+
+// Environment is an isolated set of flag settings, like development, staging
+// or production. Flag keys, names and variants are shared among environments,
+// while the flag status, default variants and rules are set per environment.
+type Environment struct {
+	ID          string
+	Key         string
+	Name        string
+	Description *string
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+}
+
+// GetID returns the environment ID.
+func (e *Environment) GetID() string {
+	return e.ID
+}
+
+// FlagEnvironment holds the settings of a flag that are specific to an
+// environment. Default variants that are not set fall back to the default
+// variants of the flag.
+type FlagEnvironment struct {
+	EnvironmentID         string
+	Enabled               bool
+	Rules                 []*FlagRule
+	DefaultVariantWhenOn  *Variant
+	DefaultVariantWhenOff *Variant
+}
@@ -0,0 +1,95 @@
+package flaggio_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+func TestEnvironment_GetID(t *testing.T) {
+	t.Parallel()
+	env := flaggio.Environment{ID: "123456"}
+	assert.Equal(t, "123456", env.GetID())
+}
+
+func TestFlag_InEnvironment(t *testing.T) {
+	t.Parallel()
+	vrnt1 := &flaggio.Variant{ID: "1", Value: 1}
+	vrnt2 := &flaggio.Variant{ID: "2", Value: 2}
+	vrnt3 := &flaggio.Variant{ID: "3", Value: 3}
+	rl1 := &flaggio.FlagRule{Rule: flaggio.Rule{ID: "1"}}
+	rl2 := &flaggio.FlagRule{Rule: flaggio.Rule{ID: "2"}}
+	flg := &flaggio.Flag{
+		ID:                    "1",
+		Key:                   "flag",
+		Enabled:               true,
+		Variants:              []*flaggio.Variant{vrnt1, vrnt2, vrnt3},
+		Rules:                 []*flaggio.FlagRule{rl1},
+		DefaultVariantWhenOn:  vrnt1,
+		DefaultVariantWhenOff: vrnt2,
+		Environments: []*flaggio.FlagEnvironment{
+			{EnvironmentID: "dev", Enabled: true, Rules: []*flaggio.FlagRule{rl2}, DefaultVariantWhenOn: vrnt3},
+			{EnvironmentID: "prod", Enabled: false, DefaultVariantWhenOn: vrnt2, DefaultVariantWhenOff: vrnt3},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		environmentID string
+		expectedFlag  *flaggio.Flag
+	}{
+		{
+			name:          "uses the environment settings",
+			environmentID: "prod",
+			expectedFlag: &flaggio.Flag{
+				ID:                    "1",
+				Key:                   "flag",
+				Enabled:               false,
+				Variants:              flg.Variants,
+				DefaultVariantWhenOn:  vrnt2,
+				DefaultVariantWhenOff: vrnt3,
+				Environments:          flg.Environments,
+			},
+		},
+		{
+			name:          "falls back to the flag default variants",
+			environmentID: "dev",
+			expectedFlag: &flaggio.Flag{
+				ID:                    "1",
+				Key:                   "flag",
+				Enabled:               true,
+				Variants:              flg.Variants,
+				Rules:                 []*flaggio.FlagRule{rl2},
+				DefaultVariantWhenOn:  vrnt3,
+				DefaultVariantWhenOff: vrnt2,
+				Environments:          flg.Environments,
+			},
+		},
+		{
+			name:          "disables the flag when there are no settings for the environment",
+			environmentID: "staging",
+			expectedFlag: &flaggio.Flag{
+				ID:                    "1",
+				Key:                   "flag",
+				Enabled:               false,
+				Variants:              flg.Variants,
+				DefaultVariantWhenOn:  vrnt1,
+				DefaultVariantWhenOff: vrnt2,
+				Environments:          flg.Environments,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res := flg.InEnvironment(tt.environmentID)
+			assert.Equal(t, tt.expectedFlag, res)
+		})
+	}
+	// the original flag must be left untouched
+	assert.True(t, flg.Enabled)
+	assert.Equal(t, []*flaggio.FlagRule{rl1}, flg.Rules)
+}
@@ -2,29 +2,55 @@ package flaggio
 
 import (
 	"net/http"
+	"time"
+)
+
+// Reason explains why an evaluation returned its value. The reasons
+// follow the OpenFeature specification.
+type Reason string
+
+const (
+	// ReasonDisabled is used when the flag is disabled.
+	ReasonDisabled Reason = "DISABLED"
+	// ReasonTargetingMatch is used when the user was targeted individually,
+	// matched a rule that serves a single variant or failed a prerequisite.
+	ReasonTargetingMatch Reason = "TARGETING_MATCH"
+	// ReasonSplit is used when the user matched a rule that distributes
+	// users across more than one variant.
+	ReasonSplit Reason = "SPLIT"
+	// ReasonDefault is used when the flag is enabled but the user wasn't
+	// matched by any targets or rules.
+	ReasonDefault Reason = "DEFAULT"
+	// ReasonError is used when the flag couldn't be evaluated.
+	ReasonError Reason = "ERROR"
 )
 
 // Evaluation is the final result of a flag evaluation. It holds the
-// returned value associated with the key for the given user.
-// If an error occurred, value will be nil and the error property will
+// returned value associated with the key for the given user, along with
+// the ID of the variant it comes from, the reason it was chosen and the
+// version of the flag that was evaluated. If an error occurred, value will be nil and the error property will
 // contain the error message.
 // Optionally, a stack trace of the evaluation process can be attached
 // to the object.
 type Evaluation struct {
-	FlagKey    string        `json:"flagKey"`
-	Value      interface{}   `json:"value,omitempty"`
-	Error      string        `json:"error,omitempty"`
-	StackTrace []*StackTrace `json:"stackTrace,omitempty"`
+	FlagKey     string        `json:"flagKey"`
+	FlagVersion int           `json:"flagVersion,omitempty"`
+	Value       interface{}   `json:"value,omitempty"`
+	VariantID   string        `json:"variantId,omitempty"`
+	Reason      Reason        `json:"reason,omitempty"`
+	Error       string        `json:"error,omitempty"`
+	StackTrace  []*StackTrace `json:"stackTrace,omitempty"`
 }
 
 // StackTrace contains detailed information about the evaluation process.
 // Type is the type of the model object that evaluated the user context
 // ID holds the ID of the same object, if any. Answer is the evaluation
-// answer, if any.
+// answer, if any. Error is the error returned by the object, if any.
 type StackTrace struct {
 	Type   string      `json:"type"`
 	ID     *string     `json:"id"`
 	Answer interface{} `json:"answer"`
+	Error  string      `json:"error,omitempty"`
 }
 
 // EvaluationList is a slice of *Evaluation.
@@ -36,3 +62,17 @@ type EvaluationList []*Evaluation
 func (l EvaluationList) Render(w http.ResponseWriter, r *http.Request) error {
 	return nil
 }
+
+// EvaluationEvent records that a flag was evaluated for a user. Project and
+// Environment are the keys of the project and environment in which the flag
+// was evaluated, if any.
+type EvaluationEvent struct {
+	FlagKey     string    `json:"flagKey"`
+	FlagVersion int       `json:"flagVersion"`
+	VariantID   string    `json:"variantId,omitempty"`
+	Reason      Reason    `json:"reason"`
+	UserID      string    `json:"userId"`
+	Project     string    `json:"project,omitempty"`
+	Environment string    `json:"environment,omitempty"`
+	Timestamp   time.Time `json:"timestamp"`
+}
@@ -21,14 +21,22 @@ type Identifier interface {
 
 // EvalResult is the result generated by an Evaluator. It possibly contains
 // an answer and/or a list of the next Evaluators that should be called.
+// VariantID is the ID of the variant the answer comes from, if any.
 type EvalResult struct {
 	Answer    interface{}
+	VariantID string
 	Next      []Evaluator
 	evaluator Evaluator
 	previous  *EvalResult
+	// nested is the result of an evaluation started by the evaluator,
+	// like the evaluation of a prerequisite flag
+	nested *EvalResult
+	err    error
 }
 
-// Stack will generate a stack trace of the evaluation process.
+// Stack will generate a stack trace of the evaluation process. The stack
+// trace of nested evaluations comes right after the evaluator that
+// started them.
 func (r EvalResult) Stack() (stack []*StackTrace) {
 	prev := &r
 	for prev != nil {
@@ -38,18 +46,50 @@ func (r EvalResult) Stack() (stack []*StackTrace) {
 			v := ider.GetID()
 			id = &v
 		}
+		var errMsg string
+		if prev.err != nil {
+			errMsg = prev.err.Error()
+		}
 		stack = append(stack, &StackTrace{
 			Type: strings.Replace(
 				fmt.Sprintf("%T", prev.evaluator), "flaggio.", "", 1,
 			),
 			ID:     id,
 			Answer: prev.Answer,
+			Error:  errMsg,
 		})
+		if prev.nested != nil {
+			stack = append(stack, prev.nested.Stack()...)
+		}
 		prev = prev.previous
 	}
 	return
 }
 
+// Reason returns why the answer was chosen, based on the evaluator that
+// returned it.
+func (r EvalResult) Reason() Reason {
+	if r.err != nil {
+		return ReasonError
+	}
+	switch e := r.evaluator.(type) {
+	case *Flag:
+		if !e.Enabled {
+			return ReasonDisabled
+		}
+		return ReasonDefault
+	case FlagTarget, FlagPrerequisite:
+		// prerequisites are part of the flag targeting
+		return ReasonTargetingMatch
+	case DistributionList:
+		if e.isSplit() {
+			return ReasonSplit
+		}
+		return ReasonTargetingMatch
+	}
+	return ""
+}
+
 // Evaluate is the starting point for a chain of evaluations.
 func Evaluate(usrContext map[string]interface{}, root Evaluator) (EvalResult, error) {
 	return evaluate(usrContext, []Evaluator{root})
@@ -59,6 +99,9 @@ func Evaluate(usrContext map[string]interface{}, root Evaluator) (EvalResult, er
 // and expect an EvalResult. The evaluation process will continue until one of the
 // evaluators return an answer and no new evaluators to continue, or no more
 // evaluators are left in the chain, whichever happens first.
+// If any of the evaluators return an error, the evaluation process stops and
+// the error is returned along with the evaluator chain, so that a stack trace
+// can still be generated.
 func evaluate(usrContext map[string]interface{}, evaluators []Evaluator) (EvalResult, error) {
 	// the last evaluation result that had an answer
 	var lastWithResult EvalResult
@@ -73,7 +116,7 @@ func evaluate(usrContext map[string]interface{}, evaluators []Evaluator) (EvalRe
 		// call the evaluator and get the answer
 		res, err := evltr.Evaluate(usrContext)
 		if err != nil {
-			return EvalResult{}, err
+			return EvalResult{evaluator: evltr, previous: lastInChain, nested: res.nested, err: err}, err
 		}
 
 		// attach additional data so that we can generate a stack trace
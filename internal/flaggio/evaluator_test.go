@@ -1,6 +1,7 @@
 package flaggio_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -156,6 +157,30 @@ func TestEvaluate(t *testing.T) {
 				assert.Equal(t, []flaggio.Evaluator{eval2}, result.Next)
 			},
 		},
+		{
+			name: "return error and stack trace when an evaluator fails",
+			run: func(t *testing.T, mockCtrl *gomock.Controller) {
+				userContext := map[string]interface{}{"name": "John"}
+				eval1 := flaggio_mock.NewMockEvaluator(mockCtrl)
+				eval2 := flaggio_mock.NewMockEvaluator(mockCtrl)
+				eval3 := flaggio_mock.NewMockEvaluator(mockCtrl)
+				res1 := flaggio.EvalResult{Answer: 1, Next: []flaggio.Evaluator{eval2, eval3}}
+				evalErr := errors.New("failed")
+
+				eval1.EXPECT().Evaluate(userContext).Times(1).Return(res1, nil)
+				eval2.EXPECT().Evaluate(userContext).Times(1).Return(flaggio.EvalResult{}, evalErr)
+				eval3.EXPECT().Evaluate(userContext).Times(0)
+
+				result, err := flaggio.Evaluate(userContext, eval1)
+				assert.Equal(t, evalErr, err)
+				assert.Nil(t, result.Answer)
+				stack := result.Stack()
+				assert.Len(t, stack, 2)
+				assert.Equal(t, "failed", stack[0].Error)
+				assert.Equal(t, "", stack[1].Error)
+				assert.Equal(t, 1, stack[1].Answer)
+			},
+		},
 		{
 			name: "return nil answer when no evaluators have an answer",
 			run: func(t *testing.T, mockCtrl *gomock.Controller) {
@@ -189,3 +214,104 @@ func TestEvaluate(t *testing.T) {
 		})
 	}
 }
+
+func TestEvalResult_Reason(t *testing.T) {
+	t.Parallel()
+	vrnt1 := &flaggio.Variant{ID: "1", Value: "a"}
+	vrnt2 := &flaggio.Variant{ID: "2", Value: "b"}
+	newFlag := func(enabled bool) *flaggio.Flag {
+		return &flaggio.Flag{
+			ID:                    "flag",
+			Enabled:               enabled,
+			Variants:              []*flaggio.Variant{vrnt1, vrnt2},
+			DefaultVariantWhenOn:  vrnt1,
+			DefaultVariantWhenOff: vrnt2,
+		}
+	}
+	split := []*flaggio.Distribution{{ID: "d1", Variant: vrnt1, Percentage: 50}, {ID: "d2", Variant: vrnt2, Percentage: 50}}
+	splitVariant := flaggio.DistributionList{Salt: "r1", Distributions: split}.Distribute("user1")
+	tests := []struct {
+		name              string
+		flag              func() *flaggio.Flag
+		expectedVariantID string
+		expectedReason    flaggio.Reason
+	}{
+		{
+			name:              "disabled flag",
+			flag:              func() *flaggio.Flag { return newFlag(false) },
+			expectedVariantID: "2",
+			expectedReason:    flaggio.ReasonDisabled,
+		},
+		{
+			name:              "enabled flag without matches",
+			flag:              func() *flaggio.Flag { return newFlag(true) },
+			expectedVariantID: "1",
+			expectedReason:    flaggio.ReasonDefault,
+		},
+		{
+			name: "targeted user",
+			flag: func() *flaggio.Flag {
+				flg := newFlag(true)
+				flg.Targets = []*flaggio.FlagTarget{{FlagID: "flag", VariantID: "2", Users: []string{"user1"}}}
+				return flg
+			},
+			expectedVariantID: "2",
+			expectedReason:    flaggio.ReasonTargetingMatch,
+		},
+		{
+			name: "rule with a single variant",
+			flag: func() *flaggio.Flag {
+				flg := newFlag(true)
+				flg.Rules = []*flaggio.FlagRule{{
+					Rule:          flaggio.Rule{ID: "r1"},
+					Distributions: []*flaggio.Distribution{{ID: "d1", Variant: vrnt2, Percentage: 100}, {ID: "d2", Variant: vrnt1}},
+				}}
+				return flg
+			},
+			expectedVariantID: "2",
+			expectedReason:    flaggio.ReasonTargetingMatch,
+		},
+		{
+			name: "rule with a percentage rollout",
+			flag: func() *flaggio.Flag {
+				flg := newFlag(true)
+				flg.Rules = []*flaggio.FlagRule{{Rule: flaggio.Rule{ID: "r1"}, Distributions: split}}
+				return flg
+			},
+			expectedVariantID: splitVariant.ID,
+			expectedReason:    flaggio.ReasonSplit,
+		},
+		{
+			name: "failed prerequisite",
+			flag: func() *flaggio.Flag {
+				flg := newFlag(true)
+				flg.Prerequisites = []*flaggio.FlagPrerequisite{{FlagID: "other", VariantID: "1", Flag: &flaggio.Flag{
+					ID:                    "other",
+					DefaultVariantWhenOff: vrnt2,
+				}}}
+				return flg
+			},
+			expectedVariantID: "2",
+			expectedReason:    flaggio.ReasonTargetingMatch,
+		},
+		{
+			name: "evaluation error",
+			flag: func() *flaggio.Flag {
+				flg := newFlag(true)
+				flg.DefaultVariantWhenOn = nil
+				return flg
+			},
+			expectedReason: flaggio.ReasonError,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, _ := flaggio.Evaluate(map[string]interface{}{"$userId": "user1"}, tt.flag())
+			assert.Equal(t, tt.expectedVariantID, res.VariantID)
+			assert.Equal(t, tt.expectedReason, res.Reason())
+		})
+	}
+}
@@ -10,58 +10,134 @@ var _ Identifier = (*Flag)(nil)
 var _ Evaluator = (*Flag)(nil)
 
 // Flag holds all the information needed to evaluate a key to a value.
+// ProjectID is the ID of the project that owns the flag, if any.
 type Flag struct {
 	ID                    string
+	ProjectID             *string
 	Key                   string
 	Name                  string
 	Description           *string
 	Enabled               bool
 	Version               int
 	Variants              []*Variant
+	Prerequisites         []*FlagPrerequisite
+	Targets               []*FlagTarget
 	Rules                 []*FlagRule
 	DefaultVariantWhenOn  *Variant
 	DefaultVariantWhenOff *Variant
+	Environments          []*FlagEnvironment
 	CreatedAt             time.Time
 	UpdatedAt             *time.Time
 }
 
+// FlagVersion is an immutable copy of a flag, saved every time the flag
+// changes. Users targeted by the flag are not part of its versions.
+type FlagVersion struct {
+	FlagID    string
+	Version   int
+	Flag      *Flag
+	CreatedAt time.Time
+}
+
 // GetID returns the flag ID.
 func (f *Flag) GetID() string {
 	return f.ID
 }
 
 // Evaluate will return the default variant as answer based on the flag status (on or off).
-// If the flag is on, it will also return the list of rules to be evaluated.
+// If the flag is on, it will also return the list of prerequisites, targets and rules to be
+// evaluated.
 // If there is no default variant configured for the given flag enabled state, an error
-// is returned.
+// is returned. An error is also returned if the flag prerequisites form a cycle.
 func (f *Flag) Evaluate(usrContext map[string]interface{}) (EvalResult, error) {
-	var answer interface{}
+	var vrnt *Variant
 	var next []Evaluator
 	if f.Enabled {
-		vrnt := f.DefaultVariantWhenOn
+		vrnt = f.DefaultVariantWhenOn
 		if vrnt == nil {
 			return EvalResult{}, errors.ErrNoDefaultVariant
 		}
-		answer = vrnt.Value
+		if len(f.Prerequisites) > 0 && FlagPrerequisiteList(f.Prerequisites).HasCycle() {
+			return EvalResult{}, errors.ErrPrerequisiteCycle
+		}
+		for _, p := range f.Prerequisites {
+			// prerequisites fall back to the default variant when off
+			prereq := *p
+			prereq.fallback = f.DefaultVariantWhenOff
+			next = append(next, prereq)
+		}
+		for _, t := range f.Targets {
+			tgt := *t
+			tgt.variant = f.variant(t.VariantID)
+			next = append(next, tgt)
+		}
 		for _, rl := range f.Rules {
 			next = append(next, rl)
 		}
 	} else {
-		vrnt := f.DefaultVariantWhenOff
+		vrnt = f.DefaultVariantWhenOff
 		if vrnt == nil {
 			return EvalResult{}, errors.ErrNoDefaultVariant
 		}
-		answer = vrnt.Value
 	}
 	return EvalResult{
-		Answer: answer,
-		Next:   next,
+		Answer:    vrnt.Value,
+		VariantID: vrnt.ID,
+		Next:      next,
 	}, nil
 }
 
-// Populate will try to populate all references in the list of rules.
+// Populate will try to populate all references in the list of prerequisites and rules.
 func (f *Flag) Populate(identifiers []Identifier) {
+	for _, p := range f.Prerequisites {
+		p.Populate(identifiers)
+	}
 	for _, r := range f.Rules {
 		r.Populate(identifiers)
 	}
 }
+
+// NextClockChange returns the next time after now at which the evaluation of
+// the flag can change only because time passed, which happens with
+// OperationBetween constraints checked against the current time. The rules
+// of the segments and prerequisite flags referenced by the flag are checked
+// too, so the flag must be populated. It returns the zero time if the
+// evaluation doesn't depend on the current time.
+func (f *Flag) NextClockChange(now time.Time) time.Time {
+	w := &clockWatch{now: now, visited: make(map[interface{}]bool)}
+	w.flag(f)
+	return w.next
+}
+
+// InEnvironment returns a copy of the flag with the settings of the given
+// environment in place of the flag settings. A flag that has no settings
+// for the environment is disabled in it.
+func (f *Flag) InEnvironment(envID string) *Flag {
+	flg := *f
+	flg.Enabled = false
+	flg.Rules = nil
+	for _, env := range f.Environments {
+		if env.EnvironmentID != envID {
+			continue
+		}
+		flg.Enabled = env.Enabled
+		flg.Rules = env.Rules
+		if env.DefaultVariantWhenOn != nil {
+			flg.DefaultVariantWhenOn = env.DefaultVariantWhenOn
+		}
+		if env.DefaultVariantWhenOff != nil {
+			flg.DefaultVariantWhenOff = env.DefaultVariantWhenOff
+		}
+		break
+	}
+	return &flg
+}
+
+func (f *Flag) variant(id string) *Variant {
+	for _, vrnt := range f.Variants {
+		if vrnt.ID == id {
+			return vrnt
+		}
+	}
+	return nil
+}
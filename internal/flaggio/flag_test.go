@@ -2,6 +2,7 @@ package flaggio_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/victorkt/flaggio/internal/errors"
@@ -50,7 +51,7 @@ func TestFlag_Evaluate(t *testing.T) {
 				DefaultVariantWhenOn:  vrnt1,
 				DefaultVariantWhenOff: vrnt2,
 			},
-			expectedResult: flaggio.EvalResult{Answer: 2},
+			expectedResult: flaggio.EvalResult{Answer: 2, VariantID: "2"},
 		},
 		{
 			name: "returns default variant when on",
@@ -61,7 +62,7 @@ func TestFlag_Evaluate(t *testing.T) {
 				DefaultVariantWhenOn:  vrnt1,
 				DefaultVariantWhenOff: vrnt2,
 			},
-			expectedResult: flaggio.EvalResult{Answer: 1, Next: []flaggio.Evaluator{rl1}},
+			expectedResult: flaggio.EvalResult{Answer: 1, VariantID: "1", Next: []flaggio.Evaluator{rl1}},
 		},
 	}
 
@@ -76,3 +77,76 @@ func TestFlag_Evaluate(t *testing.T) {
 		})
 	}
 }
+
+func TestFlag_NextClockChange(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2020, 11, 27, 10, 0, 0, 0, time.UTC)
+	window := func(start, end string) *flaggio.Constraint {
+		return &flaggio.Constraint{Operation: flaggio.OperationBetween, Values: []interface{}{start, end}}
+	}
+	inSegment := func(sgmnt *flaggio.Segment) *flaggio.Constraint {
+		return &flaggio.Constraint{Operation: flaggio.OperationIsInSegment, Values: []interface{}{sgmnt}}
+	}
+	sgmnt := &flaggio.Segment{ID: "s1", Rules: []*flaggio.SegmentRule{
+		{Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{window("2020-11-27T12:00:00Z", "2020-11-28T00:00:00Z")}}},
+	}}
+	prereq := &flaggio.Flag{ID: "f2", Rules: []*flaggio.FlagRule{
+		{Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{window("2020-11-27T00:00:00Z", "2020-11-27T11:00:00Z")}}},
+	}}
+	// prerequisites that reference each other don't loop forever
+	prereq.Prerequisites = []*flaggio.FlagPrerequisite{{FlagID: "f2", Flag: prereq}}
+
+	tests := []struct {
+		name         string
+		flag         *flaggio.Flag
+		expectedTime time.Time
+	}{
+		{
+			name: "no constraints on the current time",
+			flag: &flaggio.Flag{Rules: []*flaggio.FlagRule{
+				{Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{
+					{Property: "createdAt", Operation: flaggio.OperationBetween, Values: []interface{}{"2020-11-27T00:00:00Z", "2020-11-28T00:00:00Z"}},
+				}}},
+			}},
+		},
+		{
+			name: "window on the current time",
+			flag: &flaggio.Flag{Rules: []*flaggio.FlagRule{
+				{Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{window("2020-11-27T00:00:00Z", "2020-11-28T00:00:00Z")}}},
+			}},
+			expectedTime: time.Date(2020, 11, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "window in a segment",
+			flag: &flaggio.Flag{Rules: []*flaggio.FlagRule{
+				{Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{inSegment(sgmnt)}}},
+			}},
+			expectedTime: time.Date(2020, 11, 27, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "earliest change of the flag and its prerequisites",
+			flag: &flaggio.Flag{
+				Prerequisites: []*flaggio.FlagPrerequisite{{FlagID: "f2", Flag: prereq}},
+				Rules: []*flaggio.FlagRule{
+					{Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{inSegment(sgmnt)}}},
+				},
+			},
+			expectedTime: time.Date(2020, 11, 27, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "windows already closed",
+			flag: &flaggio.Flag{Rules: []*flaggio.FlagRule{
+				{Rule: flaggio.Rule{Constraints: []*flaggio.Constraint{window("2020-11-26T00:00:00Z", "2020-11-27T00:00:00Z")}}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			next := tt.flag.NextClockChange(now)
+			assert.True(t, tt.expectedTime.Equal(next), "expected %s, got %s", tt.expectedTime, next)
+		})
+	}
+}
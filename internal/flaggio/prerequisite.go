@@ -0,0 +1,116 @@
+package flaggio
+
+import (
+	"github.com/victorkt/flaggio/internal/errors"
+)
+
+var _ Identifier = (*FlagPrerequisite)(nil)
+var _ Evaluator = (*FlagPrerequisite)(nil)
+
+// FlagPrerequisite is a requirement for a flag to be served: the flag
+// identified by FlagID must evaluate to the variant identified by VariantID.
+// Flag holds a reference to the prerequisite flag, once populated.
+type FlagPrerequisite struct {
+	FlagID    string
+	VariantID string
+	Flag      *Flag
+	fallback  *Variant
+}
+
+// GetID returns the ID of the prerequisite flag.
+func (p FlagPrerequisite) GetID() string {
+	return p.FlagID
+}
+
+// Evaluate will evaluate the prerequisite flag and check if it returns the
+// expected variant. If that is the case, no answer is returned, so that the
+// evaluation process continues. Otherwise, the default variant of the flag when
+// off is returned as answer, interrupting the evaluation process. The
+// evaluation of the prerequisite flag is kept, so that it's part of the
+// stack trace.
+func (p FlagPrerequisite) Evaluate(usrContext map[string]interface{}) (EvalResult, error) {
+	ok, nested, err := p.isSatisfied(usrContext)
+	if err != nil {
+		return EvalResult{nested: nested}, err
+	}
+	if ok {
+		return EvalResult{nested: nested}, nil
+	}
+	if p.fallback == nil {
+		return EvalResult{nested: nested}, errors.ErrNoDefaultVariant
+	}
+	return EvalResult{
+		Answer:    p.fallback.Value,
+		VariantID: p.fallback.ID,
+		nested:    nested,
+	}, nil
+}
+
+// Populate will try to find the prerequisite flag in the list of identifiers.
+func (p *FlagPrerequisite) Populate(identifiers []Identifier) {
+	for _, ider := range identifiers {
+		if flg, ok := ider.(*Flag); ok && flg.GetID() == p.FlagID {
+			p.Flag = flg
+			return
+		}
+	}
+}
+
+// isSatisfied checks if the prerequisite flag evaluates to the expected
+// variant. Variants are compared by ID, as different variants can have
+// the same value. The result of the prerequisite flag evaluation is
+// returned, if it was evaluated.
+func (p FlagPrerequisite) isSatisfied(usrContext map[string]interface{}) (bool, *EvalResult, error) {
+	if p.Flag == nil {
+		// the reference is invalid, most likely the prerequisite
+		// flag was deleted
+		return false, nil, nil
+	}
+	var expected *Variant
+	for _, vrnt := range p.Flag.Variants {
+		if vrnt.ID == p.VariantID {
+			expected = vrnt
+			break
+		}
+	}
+	if expected == nil {
+		// the variant was deleted from the prerequisite flag
+		return false, nil, nil
+	}
+	res, err := Evaluate(usrContext, p.Flag)
+	if err != nil {
+		return false, &res, err
+	}
+	return res.VariantID == expected.ID, &res, nil
+}
+
+// FlagPrerequisiteList is a slice of *FlagPrerequisite.
+type FlagPrerequisiteList []*FlagPrerequisite
+
+// HasCycle checks if any flag reachable through the list of prerequisites
+// has itself as a prerequisite, directly or indirectly. Only populated
+// prerequisites are checked.
+func (l FlagPrerequisiteList) HasCycle() bool {
+	visiting := map[*Flag]bool{}
+	visited := map[*Flag]bool{}
+	var visit func(prereqs FlagPrerequisiteList) bool
+	visit = func(prereqs FlagPrerequisiteList) bool {
+		for _, p := range prereqs {
+			flg := p.Flag
+			if flg == nil || visited[flg] {
+				continue
+			}
+			if visiting[flg] {
+				return true
+			}
+			visiting[flg] = true
+			if visit(flg.Prerequisites) {
+				return true
+			}
+			visiting[flg] = false
+			visited[flg] = true
+		}
+		return false
+	}
+	return visit(l)
+}
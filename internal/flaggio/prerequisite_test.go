@@ -0,0 +1,201 @@
+package flaggio_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+func TestFlagPrerequisite_GetID(t *testing.T) {
+	t.Parallel()
+	prereq := flaggio.FlagPrerequisite{FlagID: "123456"}
+	assert.Equal(t, "123456", prereq.GetID())
+}
+
+func TestFlagPrerequisite_Populate(t *testing.T) {
+	t.Parallel()
+	flg := &flaggio.Flag{ID: "1"}
+	sgmnt := &flaggio.Segment{ID: "2"}
+	prereq := &flaggio.FlagPrerequisite{FlagID: "1"}
+	prereq.Populate([]flaggio.Identifier{sgmnt, flg})
+	assert.Same(t, flg, prereq.Flag)
+
+	prereq = &flaggio.FlagPrerequisite{FlagID: "2"}
+	prereq.Populate([]flaggio.Identifier{sgmnt, flg})
+	assert.Nil(t, prereq.Flag)
+}
+
+func TestFlag_EvaluateWithPrerequisites(t *testing.T) {
+	t.Parallel()
+	newPrereqFlag := func() *flaggio.Flag {
+		on := &flaggio.Variant{ID: "on", Value: true}
+		off := &flaggio.Variant{ID: "off", Value: false}
+		return &flaggio.Flag{
+			ID:                    "prereq",
+			Enabled:               true,
+			Variants:              []*flaggio.Variant{on, off},
+			DefaultVariantWhenOn:  on,
+			DefaultVariantWhenOff: off,
+			Rules: []*flaggio.FlagRule{{
+				Rule: flaggio.Rule{ID: "1", Constraints: []*flaggio.Constraint{{
+					Property:  "name",
+					Operation: flaggio.OperationOneOf,
+					Values:    []interface{}{"Mary"},
+				}}},
+				Distributions: []*flaggio.Distribution{{ID: "1", Variant: off, Percentage: 100}},
+			}},
+		}
+	}
+	newFlag := func(prereqs ...*flaggio.FlagPrerequisite) *flaggio.Flag {
+		on := &flaggio.Variant{ID: "a", Value: "new"}
+		off := &flaggio.Variant{ID: "b", Value: "old"}
+		return &flaggio.Flag{
+			ID:                    "flag",
+			Enabled:               true,
+			Variants:              []*flaggio.Variant{on, off},
+			Prerequisites:         prereqs,
+			DefaultVariantWhenOn:  on,
+			DefaultVariantWhenOff: off,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		usrContext     map[string]interface{}
+		flag           func() *flaggio.Flag
+		expectedAnswer interface{}
+		expectedStack  []*flaggio.StackTrace
+		expectedError  error
+	}{
+		{
+			name:       "returns default variant when on if prerequisite is satisfied",
+			usrContext: map[string]interface{}{"$userId": "1", "name": "John"},
+			flag: func() *flaggio.Flag {
+				return newFlag(&flaggio.FlagPrerequisite{FlagID: "prereq", VariantID: "on", Flag: newPrereqFlag()})
+			},
+			expectedAnswer: "new",
+			expectedStack: []*flaggio.StackTrace{
+				{Type: "*Flag", ID: stringPtr("flag"), Answer: "new"},
+			},
+		},
+		{
+			name:       "returns default variant when off if prerequisite is not satisfied",
+			usrContext: map[string]interface{}{"$userId": "2", "name": "Mary"},
+			flag: func() *flaggio.Flag {
+				return newFlag(&flaggio.FlagPrerequisite{FlagID: "prereq", VariantID: "on", Flag: newPrereqFlag()})
+			},
+			expectedAnswer: "old",
+			expectedStack: []*flaggio.StackTrace{
+				{Type: "FlagPrerequisite", ID: stringPtr("prereq"), Answer: "old"},
+				{Type: "DistributionList", Answer: false},
+				{Type: "*FlagRule", ID: stringPtr("1")},
+				{Type: "*Flag", ID: stringPtr("prereq"), Answer: true},
+				{Type: "*Flag", ID: stringPtr("flag"), Answer: "new"},
+			},
+		},
+		{
+			name:       "compares prerequisite variants by id",
+			usrContext: map[string]interface{}{"$userId": "2", "name": "Mary"},
+			flag: func() *flaggio.Flag {
+				prereqFlg := newPrereqFlag()
+				// same value as the expected variant, but a different variant
+				alsoOn := &flaggio.Variant{ID: "alsoOn", Value: true}
+				prereqFlg.Variants = append(prereqFlg.Variants, alsoOn)
+				prereqFlg.Rules[0].Distributions[0].Variant = alsoOn
+				return newFlag(&flaggio.FlagPrerequisite{FlagID: "prereq", VariantID: "on", Flag: prereqFlg})
+			},
+			expectedAnswer: "old",
+			expectedStack: []*flaggio.StackTrace{
+				{Type: "FlagPrerequisite", ID: stringPtr("prereq"), Answer: "old"},
+				{Type: "DistributionList", Answer: true},
+				{Type: "*FlagRule", ID: stringPtr("1")},
+				{Type: "*Flag", ID: stringPtr("prereq"), Answer: true},
+				{Type: "*Flag", ID: stringPtr("flag"), Answer: "new"},
+			},
+		},
+		{
+			name:       "returns default variant when off if prerequisite flag is missing",
+			usrContext: map[string]interface{}{"$userId": "1", "name": "John"},
+			flag: func() *flaggio.Flag {
+				return newFlag(&flaggio.FlagPrerequisite{FlagID: "prereq", VariantID: "on"})
+			},
+			expectedAnswer: "old",
+			expectedStack: []*flaggio.StackTrace{
+				{Type: "FlagPrerequisite", ID: stringPtr("prereq"), Answer: "old"},
+				{Type: "*Flag", ID: stringPtr("flag"), Answer: "new"},
+			},
+		},
+		{
+			name:       "returns default variant when off if prerequisite variant is missing",
+			usrContext: map[string]interface{}{"$userId": "1", "name": "John"},
+			flag: func() *flaggio.Flag {
+				return newFlag(&flaggio.FlagPrerequisite{FlagID: "prereq", VariantID: "deleted", Flag: newPrereqFlag()})
+			},
+			expectedAnswer: "old",
+			expectedStack: []*flaggio.StackTrace{
+				{Type: "FlagPrerequisite", ID: stringPtr("prereq"), Answer: "old"},
+				{Type: "*Flag", ID: stringPtr("flag"), Answer: "new"},
+			},
+		},
+		{
+			name:       "returns error when prerequisites form a cycle",
+			usrContext: map[string]interface{}{"$userId": "1", "name": "John"},
+			flag: func() *flaggio.Flag {
+				prereqFlg := newPrereqFlag()
+				flg := newFlag(&flaggio.FlagPrerequisite{FlagID: "prereq", VariantID: "on", Flag: prereqFlg})
+				prereqFlg.Prerequisites = []*flaggio.FlagPrerequisite{{FlagID: "flag", VariantID: "a", Flag: flg}}
+				return flg
+			},
+			expectedStack: []*flaggio.StackTrace{
+				{Type: "*Flag", ID: stringPtr("flag"), Error: errors.ErrPrerequisiteCycle.Error()},
+			},
+			expectedError: errors.ErrPrerequisiteCycle,
+		},
+		{
+			name:       "returns error when flag is a prerequisite of itself",
+			usrContext: map[string]interface{}{"$userId": "1", "name": "John"},
+			flag: func() *flaggio.Flag {
+				flg := newFlag()
+				flg.Prerequisites = []*flaggio.FlagPrerequisite{{FlagID: "flag", VariantID: "a", Flag: flg}}
+				return flg
+			},
+			expectedStack: []*flaggio.StackTrace{
+				{Type: "*Flag", ID: stringPtr("flag"), Error: errors.ErrPrerequisiteCycle.Error()},
+			},
+			expectedError: errors.ErrPrerequisiteCycle,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := flaggio.Evaluate(tt.usrContext, tt.flag())
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedAnswer, res.Answer)
+			assert.Equal(t, tt.expectedStack, res.Stack())
+		})
+	}
+}
+
+func TestFlagPrerequisiteList_HasCycle(t *testing.T) {
+	t.Parallel()
+	flg1 := &flaggio.Flag{ID: "1"}
+	flg2 := &flaggio.Flag{ID: "2"}
+	flg3 := &flaggio.Flag{ID: "3"}
+	flg4 := &flaggio.Flag{ID: "4"}
+	flg1.Prerequisites = []*flaggio.FlagPrerequisite{{FlagID: "2", Flag: flg2}, {FlagID: "3", Flag: flg3}}
+	flg2.Prerequisites = []*flaggio.FlagPrerequisite{{FlagID: "3", Flag: flg3}}
+	flg3.Prerequisites = []*flaggio.FlagPrerequisite{{FlagID: "5"}}
+	assert.False(t, flaggio.FlagPrerequisiteList(flg1.Prerequisites).HasCycle())
+
+	flg3.Prerequisites = append(flg3.Prerequisites, &flaggio.FlagPrerequisite{FlagID: "4", Flag: flg4})
+	flg4.Prerequisites = []*flaggio.FlagPrerequisite{{FlagID: "1", Flag: flg1}}
+	assert.True(t, flaggio.FlagPrerequisiteList(flg1.Prerequisites).HasCycle())
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
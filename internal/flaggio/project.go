@@ -0,0 +1,23 @@
+package flaggio
+
+import (
+	"time"
+)
+
+var _ Identifier = (*Project)(nil)
+
+// Project groups flags and segments, usually the ones owned by a team or
+// used by an application. Flag keys are unique within a project.
+type Project struct {
+	ID          string
+	Key         string
+	Name        string
+	Description *string
+	CreatedAt   time.Time
+	UpdatedAt   *time.Time
+}
+
+// GetID returns the project ID.
+func (p *Project) GetID() string {
+	return p.ID
+}
@@ -0,0 +1,14 @@
+package flaggio_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+func TestProject_GetID(t *testing.T) {
+	t.Parallel()
+	prj := flaggio.Project{ID: "123456"}
+	assert.Equal(t, "123456", prj.GetID())
+}
@@ -0,0 +1,144 @@
+package flaggio
+
+import (
+	"time"
+)
+
+// StaleReport lists the flags and segments of a project that are likely
+// not needed anymore, so that they can be removed. RolledOutFlags are
+// flags that serve the same variant to all users and haven't changed
+// recently. UnevaluatedFlags are flags that weren't evaluated recently.
+// UnusedSegments are segments that no flag references.
+type StaleReport struct {
+	RolledOutFlags   []*Flag
+	UnevaluatedFlags []*Flag
+	UnusedSegments   []*Segment
+}
+
+// NewStaleReport returns a report on the given flags and segments. Flags
+// are only reported if they haven't changed since the given time. lastEvaluatedAt
+// has the time each flag was last evaluated, by flag key. Flags missing from it
+// were never evaluated.
+func NewStaleReport(flgs []*Flag, sgmnts []*Segment, lastEvaluatedAt map[string]time.Time, since time.Time) *StaleReport {
+	report := &StaleReport{
+		RolledOutFlags:   []*Flag{},
+		UnevaluatedFlags: []*Flag{},
+		UnusedSegments:   []*Segment{},
+	}
+	for _, flg := range flgs {
+		if flg.LastChangedAt().After(since) {
+			// too soon to tell
+			continue
+		}
+		if flg.IsRolledOut() {
+			report.RolledOutFlags = append(report.RolledOutFlags, flg)
+		}
+		if t, ok := lastEvaluatedAt[flg.Key]; !ok || t.Before(since) {
+			report.UnevaluatedFlags = append(report.UnevaluatedFlags, flg)
+		}
+	}
+	referenced := referencedSegmentIDs(flgs, sgmnts)
+	for _, sgmnt := range sgmnts {
+		if !referenced[sgmnt.ID] {
+			report.UnusedSegments = append(report.UnusedSegments, sgmnt)
+		}
+	}
+	return report
+}
+
+// LastChangedAt returns when the flag was last updated, or created if it
+// was never updated.
+func (f *Flag) LastChangedAt() time.Time {
+	if f.UpdatedAt != nil {
+		return *f.UpdatedAt
+	}
+	return f.CreatedAt
+}
+
+// IsRolledOut returns whether the flag serves the same variant to all users.
+// That's the case when the flag is enabled without prerequisites, and either
+// has no rules or a single rule without constraints that distributes all users
+// to one variant. Users targeted individually are not considered. If the flag
+// has settings for environments, it must be rolled out in all of them.
+func (f *Flag) IsRolledOut() bool {
+	if len(f.Prerequisites) > 0 || !isRolledOut(f.Enabled, f.Rules) {
+		return false
+	}
+	for _, env := range f.Environments {
+		if !isRolledOut(env.Enabled, env.Rules) {
+			return false
+		}
+	}
+	return true
+}
+
+func isRolledOut(enabled bool, rules []*FlagRule) bool {
+	if !enabled || len(rules) > 1 {
+		return false
+	}
+	if len(rules) == 0 {
+		return true
+	}
+	rl := rules[0]
+	return len(rl.Constraints) == 0 && len(rl.Distributions) == 1 && rl.Distributions[0].Percentage == 100
+}
+
+// referencedSegmentIDs returns the IDs of the segments referenced by the
+// flags, directly or through other segments.
+func referencedSegmentIDs(flgs []*Flag, sgmnts []*Segment) map[string]bool {
+	sgmntsByID := make(map[string]*Segment, len(sgmnts))
+	for _, sgmnt := range sgmnts {
+		sgmntsByID[sgmnt.ID] = sgmnt
+	}
+	referenced := make(map[string]bool)
+	var pending []string
+	addConstraints := func(cnstrnts []*Constraint) {
+		for _, id := range constraintSegmentIDs(cnstrnts) {
+			if !referenced[id] {
+				referenced[id] = true
+				pending = append(pending, id)
+			}
+		}
+	}
+	for _, flg := range flgs {
+		for _, rl := range flg.Rules {
+			addConstraints(rl.Constraints)
+		}
+		for _, env := range flg.Environments {
+			for _, rl := range env.Rules {
+				addConstraints(rl.Constraints)
+			}
+		}
+	}
+	// segments can reference other segments
+	for len(pending) > 0 {
+		id := pending[0]
+		pending = pending[1:]
+		if sgmnt, ok := sgmntsByID[id]; ok {
+			for _, rl := range sgmnt.Rules {
+				addConstraints(rl.Constraints)
+			}
+		}
+	}
+	return referenced
+}
+
+// constraintSegmentIDs returns the IDs of the segments referenced by the
+// constraints, whether they were populated or not.
+func constraintSegmentIDs(cnstrnts []*Constraint) []string {
+	var ids []string
+	for _, c := range cnstrnts {
+		if c.Operation != OperationIsInSegment && c.Operation != OperationIsntInSegment {
+			continue
+		}
+		for _, v := range c.Values {
+			switch v := v.(type) {
+			case string:
+				ids = append(ids, v)
+			case *Segment:
+				ids = append(ids, v.ID)
+			}
+		}
+	}
+	return ids
+}
@@ -0,0 +1,97 @@
+package flaggio_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+func TestFlag_IsRolledOut(t *testing.T) {
+	t.Parallel()
+	vrnt := &flaggio.Variant{ID: "v1"}
+	allUsers := &flaggio.FlagRule{Distributions: []*flaggio.Distribution{{Variant: vrnt, Percentage: 100}}}
+	split := &flaggio.FlagRule{Distributions: []*flaggio.Distribution{{Variant: vrnt, Percentage: 50}, {Percentage: 50}}}
+	constrained := &flaggio.FlagRule{
+		Rule:          flaggio.Rule{Constraints: []*flaggio.Constraint{{Property: "country", Operation: flaggio.OperationOneOf}}},
+		Distributions: []*flaggio.Distribution{{Variant: vrnt, Percentage: 100}},
+	}
+	tests := []struct {
+		name     string
+		flag     *flaggio.Flag
+		expected bool
+	}{
+		{name: "disabled flag", flag: &flaggio.Flag{Enabled: false}, expected: false},
+		{name: "enabled flag without rules", flag: &flaggio.Flag{Enabled: true}, expected: true},
+		{name: "rule serving all users", flag: &flaggio.Flag{Enabled: true, Rules: []*flaggio.FlagRule{allUsers}}, expected: true},
+		{name: "rule splitting users", flag: &flaggio.Flag{Enabled: true, Rules: []*flaggio.FlagRule{split}}, expected: false},
+		{name: "rule with constraints", flag: &flaggio.Flag{Enabled: true, Rules: []*flaggio.FlagRule{constrained}}, expected: false},
+		{name: "many rules", flag: &flaggio.Flag{Enabled: true, Rules: []*flaggio.FlagRule{allUsers, allUsers}}, expected: false},
+		{
+			name:     "flag with prerequisites",
+			flag:     &flaggio.Flag{Enabled: true, Prerequisites: []*flaggio.FlagPrerequisite{{FlagID: "2", VariantID: "v2"}}},
+			expected: false,
+		},
+		{
+			name: "rolled out in all environments",
+			flag: &flaggio.Flag{Enabled: true, Environments: []*flaggio.FlagEnvironment{
+				{EnvironmentID: "dev", Enabled: true},
+				{EnvironmentID: "prod", Enabled: true, Rules: []*flaggio.FlagRule{allUsers}},
+			}},
+			expected: true,
+		},
+		{
+			name: "disabled in an environment",
+			flag: &flaggio.Flag{Enabled: true, Environments: []*flaggio.FlagEnvironment{
+				{EnvironmentID: "dev", Enabled: true},
+				{EnvironmentID: "prod", Enabled: false},
+			}},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.flag.IsRolledOut())
+		})
+	}
+}
+
+func TestNewStaleReport(t *testing.T) {
+	t.Parallel()
+	since := time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+	before := since.Add(-time.Hour)
+	after := since.Add(time.Hour)
+	inSegment := func(ids ...interface{}) []*flaggio.Constraint {
+		return []*flaggio.Constraint{{Operation: flaggio.OperationIsInSegment, Values: ids}}
+	}
+
+	rolledOut := &flaggio.Flag{ID: "1", Key: "rolled-out", Enabled: true, CreatedAt: before}
+	unevaluated := &flaggio.Flag{ID: "2", Key: "unevaluated", Enabled: false, CreatedAt: before,
+		Rules: []*flaggio.FlagRule{{Rule: flaggio.Rule{Constraints: inSegment("s1")}}},
+	}
+	recent := &flaggio.Flag{ID: "3", Key: "recent", Enabled: true, CreatedAt: before, UpdatedAt: &after}
+	inUse := &flaggio.Flag{ID: "4", Key: "in-use", Enabled: false, CreatedAt: before,
+		Environments: []*flaggio.FlagEnvironment{
+			{EnvironmentID: "prod", Rules: []*flaggio.FlagRule{{Rule: flaggio.Rule{Constraints: inSegment(&flaggio.Segment{ID: "s2"})}}}},
+		},
+	}
+	s1 := &flaggio.Segment{ID: "s1"}
+	s2 := &flaggio.Segment{ID: "s2", Rules: []*flaggio.SegmentRule{{Rule: flaggio.Rule{Constraints: inSegment("s3")}}}}
+	s3 := &flaggio.Segment{ID: "s3"}
+	s4 := &flaggio.Segment{ID: "s4"}
+
+	report := flaggio.NewStaleReport(
+		[]*flaggio.Flag{rolledOut, unevaluated, recent, inUse},
+		[]*flaggio.Segment{s1, s2, s3, s4},
+		map[string]time.Time{"rolled-out": after, "unevaluated": before, "in-use": after},
+		since,
+	)
+	assert.Equal(t, &flaggio.StaleReport{
+		RolledOutFlags:   []*flaggio.Flag{rolledOut},
+		UnevaluatedFlags: []*flaggio.Flag{unevaluated},
+		UnusedSegments:   []*flaggio.Segment{s4},
+	}, report)
+}
@@ -0,0 +1,144 @@
+package flaggio
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/victorkt/flaggio/internal/errors"
+)
+
+// RolloutPlan gradually increases the percentage of users that get a variant
+// in a flag rule, following a list of steps. EnvironmentID is set when the
+// rule belongs to the flag settings of an environment. AppliedSteps is the
+// number of steps applied so far.
+type RolloutPlan struct {
+	ID            string
+	FlagID        string
+	EnvironmentID *string
+	RuleID        string
+	VariantID     string
+	Steps         []*RolloutStep
+	AppliedSteps  int
+	Status        RolloutPlanStatus
+	Error         *string
+	CreatedBy     *AuditActor
+	CreatedAt     time.Time
+	UpdatedAt     *time.Time
+}
+
+// RolloutStep is the percentage of users that get the variant of a rollout
+// plan from a given time on.
+type RolloutStep struct {
+	ExecuteAt  time.Time
+	Percentage int
+}
+
+// CurrentStep returns the last step applied, or nil if no steps were
+// applied yet.
+func (p *RolloutPlan) CurrentStep() *RolloutStep {
+	if p.AppliedSteps == 0 || p.AppliedSteps > len(p.Steps) {
+		return nil
+	}
+	return p.Steps[p.AppliedSteps-1]
+}
+
+// NextStep returns the next step to be applied, or nil if all steps were
+// applied.
+func (p *RolloutPlan) NextStep() *RolloutStep {
+	if p.AppliedSteps >= len(p.Steps) {
+		return nil
+	}
+	return p.Steps[p.AppliedSteps]
+}
+
+// DueStep returns the index of the last step that should be applied at a
+// given time, or -1 if there's no new step to apply. Steps that were missed
+// are skipped, as only the latest percentage matters.
+func (p *RolloutPlan) DueStep(now time.Time) int {
+	due := -1
+	for idx := p.AppliedSteps; idx < len(p.Steps); idx++ {
+		if p.Steps[idx].ExecuteAt.After(now) {
+			break
+		}
+		due = idx
+	}
+	return due
+}
+
+// Validate checks that the plan has everything needed to be applied. Steps
+// must be sorted by time, with increasing percentages.
+func (p *RolloutPlan) Validate() error {
+	if p.FlagID == "" || p.RuleID == "" || p.VariantID == "" {
+		return errors.BadRequest("flag, rule and variant ids are required")
+	}
+	if len(p.Steps) == 0 {
+		return errors.BadRequest("at least one step is required")
+	}
+	for idx, step := range p.Steps {
+		if step.Percentage < 1 || step.Percentage > 100 {
+			return errors.BadRequest(fmt.Sprintf("invalid percentage for step[%d]", idx))
+		}
+		if idx == 0 {
+			continue
+		}
+		prev := p.Steps[idx-1]
+		if !step.ExecuteAt.After(prev.ExecuteAt) {
+			return errors.BadRequest(fmt.Sprintf("step[%d] must be executed after the previous step", idx))
+		}
+		if step.Percentage <= prev.Percentage {
+			return errors.BadRequest(fmt.Sprintf("step[%d] must have a higher percentage than the previous step", idx))
+		}
+	}
+	return nil
+}
+
+// Rollout returns the changes to the rule that give a variant to a
+// percentage of users. The distribution for the variant is moved to the
+// top of the list, so that the users that already get the variant keep
+// getting it when the percentage grows. The remaining percentage is split
+// between the other distributions, proportionally to their current
+// percentages.
+func (r *FlagRule) Rollout(variantID string, percentage int) (UpdateFlagRule, error) {
+	var others []*Distribution
+	var total int
+	for _, dstrbtn := range r.Distributions {
+		if dstrbtn.Variant == nil || dstrbtn.Variant.ID == variantID {
+			continue
+		}
+		others = append(others, dstrbtn)
+		total += dstrbtn.Percentage
+	}
+	if len(others) == 0 && percentage < 100 {
+		return UpdateFlagRule{}, errors.BadRequest("rule needs a distribution for another variant")
+	}
+
+	dstrbtns := []*NewDistribution{{VariantID: variantID, Percentage: percentage}}
+	rest := 100 - percentage
+	remaining := rest
+	for _, dstrbtn := range others {
+		pct := 0
+		if total > 0 {
+			pct = rest * dstrbtn.Percentage / total
+		}
+		remaining -= pct
+		dstrbtns = append(dstrbtns, &NewDistribution{VariantID: dstrbtn.Variant.ID, Percentage: pct})
+	}
+	// the remainder of the division goes to the first distribution that
+	// had users, or to the first one if none had
+	if remaining > 0 {
+		idx := 1
+		for i, dstrbtn := range others {
+			if dstrbtn.Percentage > 0 {
+				idx = i + 1
+				break
+			}
+		}
+		dstrbtns[idx].Percentage += remaining
+	}
+
+	cnstrnts := make([]*NewConstraint, len(r.Constraints))
+	for idx, c := range r.Constraints {
+		cnstrnts[idx] = &NewConstraint{Property: c.Property, Operation: c.Operation, Values: c.Values}
+	}
+	return UpdateFlagRule{Constraints: cnstrnts, Distributions: dstrbtns}, nil
+}
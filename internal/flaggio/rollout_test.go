@@ -0,0 +1,221 @@
+package flaggio_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+func TestRolloutPlan_Steps(t *testing.T) {
+	t.Parallel()
+	day := time.Date(2020, 5, 4, 9, 0, 0, 0, time.UTC)
+	plan := &flaggio.RolloutPlan{
+		Steps: []*flaggio.RolloutStep{
+			{ExecuteAt: day, Percentage: 1},
+			{ExecuteAt: day.AddDate(0, 0, 1), Percentage: 10},
+			{ExecuteAt: day.AddDate(0, 0, 2), Percentage: 100},
+		},
+	}
+
+	assert.Nil(t, plan.CurrentStep())
+	assert.Equal(t, plan.Steps[0], plan.NextStep())
+	assert.Equal(t, -1, plan.DueStep(day.Add(-time.Second)))
+	assert.Equal(t, 0, plan.DueStep(day))
+	// missed steps are skipped
+	assert.Equal(t, 1, plan.DueStep(day.AddDate(0, 0, 1).Add(time.Hour)))
+
+	plan.AppliedSteps = 2
+	assert.Equal(t, plan.Steps[1], plan.CurrentStep())
+	assert.Equal(t, plan.Steps[2], plan.NextStep())
+	assert.Equal(t, -1, plan.DueStep(day.AddDate(0, 0, 1).Add(time.Hour)))
+	assert.Equal(t, 2, plan.DueStep(day.AddDate(0, 0, 3)))
+
+	plan.AppliedSteps = 3
+	assert.Equal(t, plan.Steps[2], plan.CurrentStep())
+	assert.Nil(t, plan.NextStep())
+	assert.Equal(t, -1, plan.DueStep(day.AddDate(0, 0, 3)))
+}
+
+func TestRolloutPlan_Validate(t *testing.T) {
+	t.Parallel()
+	day := time.Date(2020, 5, 4, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		steps         []*flaggio.RolloutStep
+		expectedError error
+	}{
+		{
+			name: "valid steps",
+			steps: []*flaggio.RolloutStep{
+				{ExecuteAt: day, Percentage: 1},
+				{ExecuteAt: day.AddDate(0, 0, 5), Percentage: 100},
+			},
+		},
+		{
+			name:          "no steps",
+			expectedError: errors.BadRequest("at least one step is required"),
+		},
+		{
+			name:          "invalid percentage",
+			steps:         []*flaggio.RolloutStep{{ExecuteAt: day, Percentage: 101}},
+			expectedError: errors.BadRequest("invalid percentage for step[0]"),
+		},
+		{
+			name: "steps out of order",
+			steps: []*flaggio.RolloutStep{
+				{ExecuteAt: day, Percentage: 1},
+				{ExecuteAt: day, Percentage: 10},
+			},
+			expectedError: errors.BadRequest("step[1] must be executed after the previous step"),
+		},
+		{
+			name: "decreasing percentage",
+			steps: []*flaggio.RolloutStep{
+				{ExecuteAt: day, Percentage: 10},
+				{ExecuteAt: day.AddDate(0, 0, 1), Percentage: 5},
+			},
+			expectedError: errors.BadRequest("step[1] must have a higher percentage than the previous step"),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			plan := &flaggio.RolloutPlan{FlagID: "f1", RuleID: "r1", VariantID: "v1", Steps: tt.steps}
+			assert.Equal(t, tt.expectedError, plan.Validate())
+		})
+	}
+}
+
+func TestFlagRule_Rollout(t *testing.T) {
+	t.Parallel()
+	vOn := &flaggio.Variant{ID: "on"}
+	vOff := &flaggio.Variant{ID: "off"}
+	vOther := &flaggio.Variant{ID: "other"}
+	cnstrnts := []*flaggio.Constraint{
+		{ID: "c1", Property: "country", Operation: flaggio.OperationOneOf, Values: []interface{}{"NZ"}},
+	}
+	tests := []struct {
+		name                  string
+		distributions         []*flaggio.Distribution
+		percentage            int
+		expectedDistributions []*flaggio.NewDistribution
+		expectedError         error
+	}{
+		{
+			name: "moves the variant to the top",
+			distributions: []*flaggio.Distribution{
+				{ID: "d1", Variant: vOff, Percentage: 100},
+				{ID: "d2", Variant: vOn, Percentage: 0},
+			},
+			percentage: 5,
+			expectedDistributions: []*flaggio.NewDistribution{
+				{VariantID: "on", Percentage: 5},
+				{VariantID: "off", Percentage: 95},
+			},
+		},
+		{
+			name: "adds the variant when it has no distribution",
+			distributions: []*flaggio.Distribution{
+				{ID: "d1", Variant: vOff, Percentage: 100},
+			},
+			percentage: 20,
+			expectedDistributions: []*flaggio.NewDistribution{
+				{VariantID: "on", Percentage: 20},
+				{VariantID: "off", Percentage: 80},
+			},
+		},
+		{
+			name: "splits the rest proportionally",
+			distributions: []*flaggio.Distribution{
+				{ID: "d1", Variant: vOn, Percentage: 10},
+				{ID: "d2", Variant: vOff, Percentage: 60},
+				{ID: "d3", Variant: vOther, Percentage: 30},
+			},
+			percentage: 20,
+			expectedDistributions: []*flaggio.NewDistribution{
+				{VariantID: "on", Percentage: 20},
+				{VariantID: "off", Percentage: 54},
+				{VariantID: "other", Percentage: 26},
+			},
+		},
+		{
+			name: "gives the rest to the first distribution when none had users",
+			distributions: []*flaggio.Distribution{
+				{ID: "d1", Variant: vOn, Percentage: 100},
+				{ID: "d2", Variant: vOff, Percentage: 0},
+				{ID: "d3", Variant: vOther, Percentage: 0},
+			},
+			percentage: 50,
+			expectedDistributions: []*flaggio.NewDistribution{
+				{VariantID: "on", Percentage: 50},
+				{VariantID: "off", Percentage: 50},
+				{VariantID: "other", Percentage: 0},
+			},
+		},
+		{
+			name: "fails without other variants",
+			distributions: []*flaggio.Distribution{
+				{ID: "d1", Variant: vOn, Percentage: 100},
+			},
+			percentage:    50,
+			expectedError: errors.BadRequest("rule needs a distribution for another variant"),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rl := &flaggio.FlagRule{
+				Rule:          flaggio.Rule{ID: "r1", Constraints: cnstrnts},
+				Distributions: tt.distributions,
+			}
+			input, err := rl.Rollout("on", tt.percentage)
+			assert.Equal(t, tt.expectedError, err)
+			if tt.expectedError != nil {
+				return
+			}
+			assert.Equal(t, tt.expectedDistributions, input.Distributions)
+			assert.Equal(t, []*flaggio.NewConstraint{
+				{Property: "country", Operation: flaggio.OperationOneOf, Values: []interface{}{"NZ"}},
+			}, input.Constraints)
+		})
+	}
+}
+
+func TestFlagRule_RolloutKeepsUsers(t *testing.T) {
+	t.Parallel()
+	variants := map[string]*flaggio.Variant{"on": {ID: "on"}, "off": {ID: "off"}}
+	rl := &flaggio.FlagRule{
+		Rule: flaggio.Rule{ID: "r1"},
+		Distributions: []*flaggio.Distribution{
+			{ID: "d1", Variant: variants["off"], Percentage: 100},
+		},
+	}
+	inRollout := map[string]bool{}
+	for _, pct := range []int{1, 5, 25, 50, 100} {
+		input, err := rl.Rollout("on", pct)
+		assert.NoError(t, err)
+		rl.Distributions = make([]*flaggio.Distribution, len(input.Distributions))
+		for idx, d := range input.Distributions {
+			rl.Distributions[idx] = &flaggio.Distribution{Variant: variants[d.VariantID], Percentage: d.Percentage}
+		}
+		dl := flaggio.DistributionList{Salt: rl.ID, Distributions: rl.Distributions}
+		for i := 0; i < 1000; i++ {
+			usr := fmt.Sprintf("user%d", i)
+			got := dl.Distribute(usr).ID == "on"
+			if inRollout[usr] {
+				assert.True(t, got, "user %s left the rollout at %d%%", usr, pct)
+			}
+			inRollout[usr] = got
+		}
+	}
+	// everyone gets the variant once the rollout is complete
+	for usr, got := range inRollout {
+		assert.True(t, got, "user %s is not in the rollout", usr)
+	}
+}
@@ -24,20 +24,28 @@ func (r *Rule) Populate(identifiers []Identifier) {
 }
 
 // FlagRule is a rule that also holds a list of distributions.
+// BucketBy is the user context property used to bucket users into
+// the distributions. If empty, DefaultBucketBy is used.
 type FlagRule struct {
 	Rule
+	BucketBy      string
 	Distributions []*Distribution
 }
 
 // Evaluate will check that all constraints in this rule validates to true. If that
-// is the case, it returns the list of distributions as next to be evaluated.
+// is the case, it returns the list of distributions as next to be evaluated, salted
+// with the rule ID.
 // If any of the constraints fail to pass, the rule returns an empty list of
 // next evaluators. In any case, no answer is returned from the evaluation.
 func (r FlagRule) Evaluate(usrContext map[string]interface{}) (EvalResult, error) {
 	var next []Evaluator
 	ok, err := ConstraintList(r.Constraints).Validate(usrContext)
 	if ok {
-		next = []Evaluator{DistributionList(r.Distributions)}
+		next = []Evaluator{DistributionList{
+			Salt:          r.ID,
+			BucketBy:      r.BucketBy,
+			Distributions: r.Distributions,
+		}}
 	}
 	return EvalResult{
 		Next: next,
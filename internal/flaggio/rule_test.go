@@ -61,7 +61,10 @@ func TestFlagRule_Evaluate(t *testing.T) {
 			},
 			expectedResult: flaggio.EvalResult{
 				Answer: nil,
-				Next:   []flaggio.Evaluator{flaggio.DistributionList([]*flaggio.Distribution{dstrbtn})},
+				Next: []flaggio.Evaluator{flaggio.DistributionList{
+					Salt:          "123-abc",
+					Distributions: []*flaggio.Distribution{dstrbtn},
+				}},
 			},
 		},
 	}
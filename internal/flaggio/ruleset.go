@@ -0,0 +1,63 @@
+package flaggio
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+)
+
+// RulesetSchemaVersion is the version of the ruleset document format. It
+// changes whenever the format changes in a way that older SDKs can't read.
+const RulesetSchemaVersion = 1
+
+// Ruleset holds everything needed to evaluate flags locally: all flags,
+// with their variants, rules, distributions and targets, and all segments.
+// Version identifies the content of the ruleset, so it only changes when
+// flags or segments change.
+type Ruleset struct {
+	SchemaVersion int        `json:"schemaVersion"`
+	Version       string     `json:"version"`
+	Flags         []*Flag    `json:"flags"`
+	Segments      []*Segment `json:"segments"`
+}
+
+// NewRuleset returns a new ruleset with the given flags and segments. The
+// flags and segments must not be populated, as references between them are
+// kept as IDs in the ruleset.
+func NewRuleset(flgs []*Flag, sgmnts []*Segment) (*Ruleset, error) {
+	rs := &Ruleset{
+		SchemaVersion: RulesetSchemaVersion,
+		Flags:         flgs,
+		Segments:      sgmnts,
+	}
+	b, err := json.Marshal(rs)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.Sum256(b)
+	rs.Version = hex.EncodeToString(h[:])
+	return rs, nil
+}
+
+// Populate resolves the references between the flags and segments of the
+// ruleset, so that its flags can be evaluated.
+func (rs *Ruleset) Populate() {
+	iders := make([]Identifier, 0, len(rs.Flags)+len(rs.Segments))
+	for _, sgmnt := range rs.Segments {
+		iders = append(iders, sgmnt)
+	}
+	for _, flg := range rs.Flags {
+		iders = append(iders, flg)
+	}
+	for _, flg := range rs.Flags {
+		flg.Populate(iders)
+	}
+}
+
+// Render can enrich the Ruleset before being returned to the user.
+// Currently it does nothing, but is needed to satisfy the
+// chi.Renderer interface.
+func (rs *Ruleset) Render(w http.ResponseWriter, r *http.Request) error {
+	return nil
+}
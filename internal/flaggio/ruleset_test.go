@@ -0,0 +1,78 @@
+package flaggio_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+func newTestRuleset() ([]*flaggio.Flag, []*flaggio.Segment) {
+	vrnt1 := &flaggio.Variant{ID: "v1", Value: "on"}
+	vrnt2 := &flaggio.Variant{ID: "v2", Value: "off"}
+	sgmnts := []*flaggio.Segment{{
+		ID: "s1",
+		Rules: []*flaggio.SegmentRule{{Rule: flaggio.Rule{ID: "sr1", Constraints: []*flaggio.Constraint{
+			{ID: "c1", Property: "country", Operation: flaggio.OperationOneOf, Values: []interface{}{"NZ"}},
+		}}}},
+	}}
+	flgs := []*flaggio.Flag{{
+		ID:       "f1",
+		Key:      "f1",
+		Enabled:  true,
+		Variants: []*flaggio.Variant{vrnt1, vrnt2},
+		Rules: []*flaggio.FlagRule{{
+			Rule: flaggio.Rule{ID: "fr1", Constraints: []*flaggio.Constraint{
+				{ID: "c2", Operation: flaggio.OperationIsInSegment, Values: []interface{}{"s1"}},
+			}},
+			Distributions: []*flaggio.Distribution{{ID: "d1", Variant: vrnt1, Percentage: 100}},
+		}},
+		DefaultVariantWhenOn:  vrnt2,
+		DefaultVariantWhenOff: vrnt2,
+	}}
+	return flgs, sgmnts
+}
+
+func TestNewRuleset(t *testing.T) {
+	t.Parallel()
+	flgs, sgmnts := newTestRuleset()
+	rs1, err := flaggio.NewRuleset(flgs, sgmnts)
+	assert.NoError(t, err)
+	assert.Equal(t, flaggio.RulesetSchemaVersion, rs1.SchemaVersion)
+	assert.NotEmpty(t, rs1.Version)
+
+	// same content, same version
+	flgs, sgmnts = newTestRuleset()
+	rs2, err := flaggio.NewRuleset(flgs, sgmnts)
+	assert.NoError(t, err)
+	assert.Equal(t, rs1.Version, rs2.Version)
+
+	// different content, different version
+	flgs, sgmnts = newTestRuleset()
+	flgs[0].Enabled = false
+	rs3, err := flaggio.NewRuleset(flgs, sgmnts)
+	assert.NoError(t, err)
+	assert.NotEqual(t, rs1.Version, rs3.Version)
+}
+
+func TestRuleset_Populate(t *testing.T) {
+	t.Parallel()
+	flgs, sgmnts := newTestRuleset()
+	rs, err := flaggio.NewRuleset(flgs, sgmnts)
+	assert.NoError(t, err)
+
+	// flags can be evaluated after the ruleset is decoded
+	b, err := json.Marshal(rs)
+	assert.NoError(t, err)
+	var decoded flaggio.Ruleset
+	assert.NoError(t, json.Unmarshal(b, &decoded))
+	decoded.Populate()
+
+	res, err := flaggio.Evaluate(map[string]interface{}{"$userId": "1", "country": "NZ"}, decoded.Flags[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "on", res.Answer)
+	res, err = flaggio.Evaluate(map[string]interface{}{"$userId": "1", "country": "AU"}, decoded.Flags[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "off", res.Answer)
+}
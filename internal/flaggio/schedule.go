@@ -0,0 +1,86 @@
+package flaggio
+
+import (
+	"time"
+
+	"github.com/victorkt/flaggio/internal/errors"
+)
+
+// ScheduledChange is a change to a flag that is applied at a later time.
+// Only the input that matches the Type of the change is set. EnvironmentID
+// is set for changes to an environment's flag settings or rules, RuleID
+// for changes to an existing rule.
+type ScheduledChange struct {
+	ID                    string
+	FlagID                string
+	Type                  ScheduledChangeType
+	EnvironmentID         *string
+	RuleID                *string
+	UpdateFlag            *UpdateFlag
+	UpdateFlagEnvironment *UpdateFlagEnvironment
+	NewFlagRule           *NewFlagRule
+	UpdateFlagRule        *UpdateFlagRule
+	ExecuteAt             time.Time
+	Status                ScheduledChangeStatus
+	Error                 *string
+	CreatedBy             *AuditActor
+	CreatedAt             time.Time
+	ExecutedAt            *time.Time
+}
+
+// Input returns the input of the change, or nil if the change has no input.
+func (sc *ScheduledChange) Input() interface{} {
+	switch sc.Type {
+	case ScheduledChangeTypeUpdateFlag:
+		return sc.UpdateFlag
+	case ScheduledChangeTypeUpdateFlagEnvironment:
+		return sc.UpdateFlagEnvironment
+	case ScheduledChangeTypeCreateFlagRule:
+		return sc.NewFlagRule
+	case ScheduledChangeTypeUpdateFlagRule:
+		return sc.UpdateFlagRule
+	default:
+		return nil
+	}
+}
+
+// Validate checks that the change has everything needed to be applied.
+func (sc *ScheduledChange) Validate() error {
+	if !sc.Type.IsValid() {
+		return errors.BadRequest("invalid scheduled change type")
+	}
+	if sc.FlagID == "" {
+		return errors.BadRequest("flag id is required")
+	}
+	if sc.ExecuteAt.IsZero() {
+		return errors.BadRequest("execution time is required")
+	}
+	if sc.Type != ScheduledChangeTypeDeleteFlagRule && isNilInput(sc.Input()) {
+		return errors.BadRequest("input is required")
+	}
+	if sc.Type == ScheduledChangeTypeUpdateFlagEnvironment && sc.EnvironmentID == nil {
+		return errors.BadRequest("environment id is required")
+	}
+	if (sc.Type == ScheduledChangeTypeUpdateFlagRule || sc.Type == ScheduledChangeTypeDeleteFlagRule) &&
+		sc.RuleID == nil {
+		return errors.BadRequest("rule id is required")
+	}
+	return nil
+}
+
+// isNilInput reports whether the input of a change is missing. Inputs are
+// typed pointers, so a missing input is not equal to an untyped nil.
+func isNilInput(input interface{}) bool {
+	switch in := input.(type) {
+	case *UpdateFlag:
+		return in == nil
+	case *UpdateFlagEnvironment:
+		return in == nil
+	case *NewFlagRule:
+		return in == nil
+	case *UpdateFlagRule:
+		return in == nil
+	default:
+		return input == nil
+	}
+}
@@ -0,0 +1,84 @@
+package flaggio_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+func TestScheduledChange_Validate(t *testing.T) {
+	t.Parallel()
+	executeAt := time.Date(2020, 5, 4, 9, 0, 0, 0, time.UTC)
+	enabled := true
+	envID := "e1"
+	ruleID := "r1"
+	tests := []struct {
+		name          string
+		change        *flaggio.ScheduledChange
+		expectedError error
+	}{
+		{
+			name: "valid flag update",
+			change: &flaggio.ScheduledChange{
+				FlagID: "f1", Type: flaggio.ScheduledChangeTypeUpdateFlag, ExecuteAt: executeAt,
+				UpdateFlag: &flaggio.UpdateFlag{Enabled: &enabled},
+			},
+		},
+		{
+			name: "valid rule deletion",
+			change: &flaggio.ScheduledChange{
+				FlagID: "f1", Type: flaggio.ScheduledChangeTypeDeleteFlagRule, ExecuteAt: executeAt,
+				RuleID: &ruleID,
+			},
+		},
+		{
+			name: "invalid type",
+			change: &flaggio.ScheduledChange{
+				FlagID: "f1", Type: "DELETE_FLAG", ExecuteAt: executeAt,
+			},
+			expectedError: errors.BadRequest("invalid scheduled change type"),
+		},
+		{
+			name: "missing execution time",
+			change: &flaggio.ScheduledChange{
+				FlagID: "f1", Type: flaggio.ScheduledChangeTypeUpdateFlag,
+				UpdateFlag: &flaggio.UpdateFlag{Enabled: &enabled},
+			},
+			expectedError: errors.BadRequest("execution time is required"),
+		},
+		{
+			name: "missing input",
+			change: &flaggio.ScheduledChange{
+				FlagID: "f1", Type: flaggio.ScheduledChangeTypeCreateFlagRule, ExecuteAt: executeAt,
+				UpdateFlag: &flaggio.UpdateFlag{Enabled: &enabled},
+			},
+			expectedError: errors.BadRequest("input is required"),
+		},
+		{
+			name: "missing environment",
+			change: &flaggio.ScheduledChange{
+				FlagID: "f1", Type: flaggio.ScheduledChangeTypeUpdateFlagEnvironment, ExecuteAt: executeAt,
+				UpdateFlagEnvironment: &flaggio.UpdateFlagEnvironment{Enabled: &enabled},
+			},
+			expectedError: errors.BadRequest("environment id is required"),
+		},
+		{
+			name: "missing rule",
+			change: &flaggio.ScheduledChange{
+				FlagID: "f1", Type: flaggio.ScheduledChangeTypeUpdateFlagRule, ExecuteAt: executeAt,
+				EnvironmentID: &envID, UpdateFlagRule: &flaggio.UpdateFlagRule{},
+			},
+			expectedError: errors.BadRequest("rule id is required"),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expectedError, tt.change.Validate())
+		})
+	}
+}
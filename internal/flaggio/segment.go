@@ -13,6 +13,7 @@ var _ operator.Validator = (*Segment)(nil)
 // set of rules.
 type Segment struct {
 	ID          string
+	ProjectID   *string
 	Name        string
 	Description *string
 	Rules       []*SegmentRule
@@ -0,0 +1,41 @@
+package flaggio
+
+var _ Identifier = (*FlagTarget)(nil)
+var _ Evaluator = (*FlagTarget)(nil)
+
+// FlagTarget is a list of users that are always served the variant
+// identified by VariantID, regardless of the flag rules.
+type FlagTarget struct {
+	FlagID    string
+	VariantID string
+	Users     []string
+	variant   *Variant
+}
+
+// GetID returns the ID of the targeted variant.
+func (t FlagTarget) GetID() string {
+	return t.VariantID
+}
+
+// Evaluate will check if the user is in the list of targeted users. If that
+// is the case, the targeted variant is returned as answer. Otherwise, no
+// answer is returned, so that the evaluation process continues.
+func (t FlagTarget) Evaluate(usrContext map[string]interface{}) (EvalResult, error) {
+	if t.variant == nil {
+		// the variant was deleted from the flag
+		return EvalResult{}, nil
+	}
+	usrID, ok := usrContext[DefaultBucketBy].(string)
+	if !ok {
+		return EvalResult{}, nil
+	}
+	for _, u := range t.Users {
+		if u == usrID {
+			return EvalResult{
+				Answer:    t.variant.Value,
+				VariantID: t.variant.ID,
+			}, nil
+		}
+	}
+	return EvalResult{}, nil
+}
@@ -0,0 +1,127 @@
+package flaggio_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+func TestFlagTarget_GetID(t *testing.T) {
+	t.Parallel()
+	tgt := flaggio.FlagTarget{VariantID: "123456"}
+	assert.Equal(t, "123456", tgt.GetID())
+}
+
+func TestFlag_EvaluateWithTargets(t *testing.T) {
+	t.Parallel()
+	newFlag := func(enabled bool, tgts ...*flaggio.FlagTarget) *flaggio.Flag {
+		a := &flaggio.Variant{ID: "a", Value: "a"}
+		b := &flaggio.Variant{ID: "b", Value: "b"}
+		c := &flaggio.Variant{ID: "c", Value: "c"}
+		return &flaggio.Flag{
+			ID:                    "flag",
+			Enabled:               enabled,
+			Variants:              []*flaggio.Variant{a, b, c},
+			Targets:               tgts,
+			DefaultVariantWhenOn:  a,
+			DefaultVariantWhenOff: b,
+			Rules: []*flaggio.FlagRule{{
+				Rule: flaggio.Rule{ID: "1", Constraints: []*flaggio.Constraint{{
+					Property:  "name",
+					Operation: flaggio.OperationOneOf,
+					Values:    []interface{}{"Mary"},
+				}}},
+				Distributions: []*flaggio.Distribution{{ID: "1", Variant: b, Percentage: 100}},
+			}},
+		}
+	}
+	targets := []*flaggio.FlagTarget{
+		{FlagID: "flag", VariantID: "b", Users: []string{"1", "2"}},
+		{FlagID: "flag", VariantID: "c", Users: []string{"3"}},
+		{FlagID: "flag", VariantID: "deleted", Users: []string{"4"}},
+	}
+
+	tests := []struct {
+		name           string
+		usrContext     map[string]interface{}
+		flag           *flaggio.Flag
+		expectedAnswer interface{}
+		expectedStack  []*flaggio.StackTrace
+	}{
+		{
+			name:           "returns the targeted variant",
+			usrContext:     map[string]interface{}{"$userId": "3", "name": "John"},
+			flag:           newFlag(true, targets...),
+			expectedAnswer: "c",
+			expectedStack: []*flaggio.StackTrace{
+				{Type: "FlagTarget", ID: stringPtr("c"), Answer: "c"},
+				{Type: "FlagTarget", ID: stringPtr("b")},
+				{Type: "*Flag", ID: stringPtr("flag"), Answer: "a"},
+			},
+		},
+		{
+			name:           "targets take precedence over rules",
+			usrContext:     map[string]interface{}{"$userId": "3", "name": "Mary"},
+			flag:           newFlag(true, targets...),
+			expectedAnswer: "c",
+			expectedStack: []*flaggio.StackTrace{
+				{Type: "FlagTarget", ID: stringPtr("c"), Answer: "c"},
+				{Type: "FlagTarget", ID: stringPtr("b")},
+				{Type: "*Flag", ID: stringPtr("flag"), Answer: "a"},
+			},
+		},
+		{
+			name:           "evaluates rules when user is not targeted",
+			usrContext:     map[string]interface{}{"$userId": "5", "name": "Mary"},
+			flag:           newFlag(true, targets...),
+			expectedAnswer: "b",
+			expectedStack: []*flaggio.StackTrace{
+				{Type: "DistributionList", Answer: "b"},
+				{Type: "*FlagRule", ID: stringPtr("1")},
+				{Type: "FlagTarget", ID: stringPtr("deleted")},
+				{Type: "FlagTarget", ID: stringPtr("c")},
+				{Type: "FlagTarget", ID: stringPtr("b")},
+				{Type: "*Flag", ID: stringPtr("flag"), Answer: "a"},
+			},
+		},
+		{
+			name:           "ignores targets of deleted variants",
+			usrContext:     map[string]interface{}{"$userId": "4", "name": "John"},
+			flag:           newFlag(true, targets...),
+			expectedAnswer: "a",
+			expectedStack: []*flaggio.StackTrace{
+				{Type: "*Flag", ID: stringPtr("flag"), Answer: "a"},
+			},
+		},
+		{
+			name:           "ignores targets when user ID is missing",
+			usrContext:     map[string]interface{}{"name": "John"},
+			flag:           newFlag(true, targets...),
+			expectedAnswer: "a",
+			expectedStack: []*flaggio.StackTrace{
+				{Type: "*Flag", ID: stringPtr("flag"), Answer: "a"},
+			},
+		},
+		{
+			name:           "ignores targets when flag is disabled",
+			usrContext:     map[string]interface{}{"$userId": "3", "name": "John"},
+			flag:           newFlag(false, targets...),
+			expectedAnswer: "b",
+			expectedStack: []*flaggio.StackTrace{
+				{Type: "*Flag", ID: stringPtr("flag"), Answer: "b"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := flaggio.Evaluate(tt.usrContext, tt.flag)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedAnswer, res.Answer)
+			assert.Equal(t, tt.expectedStack, res.Stack())
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package flaggio
+
+import (
+	"time"
+)
+
+// FlagUsage is the number of times a flag was evaluated in a period of
+// time, starting at Time, broken down by variant.
+type FlagUsage struct {
+	Time     time.Time
+	Variants []*VariantUsage
+	Total    int
+}
+
+// VariantUsage is the number of times a flag evaluation returned a
+// variant. VariantID is nil for evaluations that failed.
+type VariantUsage struct {
+	VariantID *string
+	Count     int
+}
+
+// UsageFilter selects the evaluations of a flag in a project, and
+// optionally in an environment, from a time (inclusive) to another
+// (exclusive). Project and Environment are keys, as evaluations are
+// requested with keys. Evaluations are grouped by Granularity.
+type UsageFilter struct {
+	Project     *string
+	Environment *string
+	FlagKey     string
+	From        time.Time
+	To          time.Time
+	Granularity UsageGranularity
+}
+
+// Truncate returns the start of the period of time t belongs to, in UTC.
+func (g UsageGranularity) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	switch g {
+	case UsageGranularityDay:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	default:
+		return t.Truncate(time.Hour)
+	}
+}
@@ -0,0 +1,46 @@
+package flaggio_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+func TestUsageGranularity_Truncate(t *testing.T) {
+	t.Parallel()
+	brt := time.FixedZone("BRT", -3*60*60)
+	tests := []struct {
+		name         string
+		granularity  flaggio.UsageGranularity
+		time         time.Time
+		expectedTime time.Time
+	}{
+		{
+			name:         "truncates to the hour",
+			granularity:  flaggio.UsageGranularityHour,
+			time:         time.Date(2020, 5, 1, 10, 35, 12, 5, time.UTC),
+			expectedTime: time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "truncates to the day",
+			granularity:  flaggio.UsageGranularityDay,
+			time:         time.Date(2020, 5, 1, 10, 35, 12, 5, time.UTC),
+			expectedTime: time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "truncates to the day in UTC",
+			granularity:  flaggio.UsageGranularityDay,
+			time:         time.Date(2020, 5, 1, 22, 35, 12, 5, brt),
+			expectedTime: time.Date(2020, 5, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expectedTime, tt.granularity.Truncate(tt.time))
+		})
+	}
+}
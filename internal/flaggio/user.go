@@ -0,0 +1,68 @@
+package flaggio
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+var _ Identifier = (*User)(nil)
+
+// User is someone who can access the admin API. Users that authenticate
+// through an external identity provider are not stored, so only ID, Email,
+// Name and Role are set for them.
+type User struct {
+	ID           string
+	Email        string
+	Name         string
+	Role         UserRole
+	PasswordHash string `json:"-"`
+	CreatedAt    time.Time
+	UpdatedAt    *time.Time
+}
+
+// GetID returns the user ID.
+func (u *User) GetID() string {
+	return u.ID
+}
+
+var userRoleLevels = map[UserRole]int{
+	UserRoleViewer: 1,
+	UserRoleEditor: 2,
+	UserRoleAdmin:  3,
+}
+
+// Includes returns whether the role grants all the permissions of the
+// other role. Admins can do everything editors can, and editors can do
+// everything viewers can.
+func (r UserRole) Includes(other UserRole) bool {
+	lvl, ok := userRoleLevels[r]
+	return ok && lvl >= userRoleLevels[other]
+}
+
+// Session is created when a local user logs in. Only the hash of the
+// session token is stored.
+type Session struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// GenerateSessionToken returns a new random session token.
+func GenerateSessionToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// HashSessionToken returns the hash that is stored for the given
+// session token.
+func HashSessionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,47 @@
+package flaggio_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+func TestUser_GetID(t *testing.T) {
+	t.Parallel()
+	usr := flaggio.User{ID: "123456"}
+	assert.Equal(t, "123456", usr.GetID())
+}
+
+func TestUserRole_Includes(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		role     flaggio.UserRole
+		other    flaggio.UserRole
+		expected bool
+	}{
+		{role: flaggio.UserRoleAdmin, other: flaggio.UserRoleAdmin, expected: true},
+		{role: flaggio.UserRoleAdmin, other: flaggio.UserRoleEditor, expected: true},
+		{role: flaggio.UserRoleAdmin, other: flaggio.UserRoleViewer, expected: true},
+		{role: flaggio.UserRoleEditor, other: flaggio.UserRoleAdmin, expected: false},
+		{role: flaggio.UserRoleEditor, other: flaggio.UserRoleViewer, expected: true},
+		{role: flaggio.UserRoleViewer, other: flaggio.UserRoleEditor, expected: false},
+		{role: flaggio.UserRoleViewer, other: flaggio.UserRoleViewer, expected: true},
+		{role: "", other: flaggio.UserRoleViewer, expected: false},
+		{role: "OWNER", other: flaggio.UserRoleViewer, expected: false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, tt.role.Includes(tt.other), "%s includes %s", tt.role, tt.other)
+	}
+}
+
+func TestHashSessionToken(t *testing.T) {
+	t.Parallel()
+	token, err := flaggio.GenerateSessionToken()
+	assert.NoError(t, err)
+	other, err := flaggio.GenerateSessionToken()
+	assert.NoError(t, err)
+	assert.NotEqual(t, token, other)
+	assert.Equal(t, flaggio.HashSessionToken(token), flaggio.HashSessionToken(token))
+	assert.NotEqual(t, flaggio.HashSessionToken(token), flaggio.HashSessionToken(other))
+}
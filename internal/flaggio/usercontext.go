@@ -3,12 +3,14 @@ package flaggio
 import (
 	"encoding/json"
 	"strconv"
+	"strings"
 )
 
 var _ json.Unmarshaler = (*UserContext)(nil)
 
 // UserContext is a map of strings and one of:
-// int64, float64, bool, string
+// int64, float64, bool, string, []interface{} or map[string]interface{}.
+// Arrays and objects hold values of the same types.
 type UserContext map[string]interface{}
 
 // UnmarshalJSON unmarshals the bytes into UserContext
@@ -18,21 +20,104 @@ func (a UserContext) UnmarshalJSON(b []byte) error {
 		return err
 	}
 	for k, v := range data {
-		strV := string(v)
-		if n, err := strconv.ParseInt(strV, 10, 64); err == nil {
-			a[k] = n
-		} else if n, err := strconv.ParseFloat(strV, 64); err == nil {
-			a[k] = n
-		} else if b, err := strconv.ParseBool(strV); err == nil {
-			a[k] = b
-		} else {
-			// everything else is treated as a string, even null
-			// strings will still be quoted on the json.RawMessage so we
-			// try to unmarshall them. it will fail for objects and arrays
-			// in that case, ignore the error and return the raw string
-			_ = json.Unmarshal(v, &strV)
-			a[k] = strV
+		val, err := decodeValue(v)
+		if err != nil {
+			return err
 		}
+		a[k] = val
 	}
 	return nil
 }
+
+func decodeValue(v json.RawMessage) (interface{}, error) {
+	strV := strings.TrimSpace(string(v))
+	if strings.HasPrefix(strV, "{") {
+		data := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(v, &data); err != nil {
+			return nil, err
+		}
+		obj := make(map[string]interface{}, len(data))
+		for k, raw := range data {
+			val, err := decodeValue(raw)
+			if err != nil {
+				return nil, err
+			}
+			obj[k] = val
+		}
+		return obj, nil
+	}
+	if strings.HasPrefix(strV, "[") {
+		var data []json.RawMessage
+		if err := json.Unmarshal(v, &data); err != nil {
+			return nil, err
+		}
+		arr := make([]interface{}, len(data))
+		for idx, raw := range data {
+			val, err := decodeValue(raw)
+			if err != nil {
+				return nil, err
+			}
+			arr[idx] = val
+		}
+		return arr, nil
+	}
+	if n, err := strconv.ParseInt(strV, 10, 64); err == nil {
+		return n, nil
+	} else if n, err := strconv.ParseFloat(strV, 64); err == nil {
+		return n, nil
+	} else if b, err := strconv.ParseBool(strV); err == nil {
+		return b, nil
+	}
+	// everything else is treated as a string, even null
+	// strings will still be quoted on the json.RawMessage so we
+	// try to unmarshall them
+	_ = json.Unmarshal(v, &strV)
+	return strV, nil
+}
+
+// Lookup returns the value of a property from the user context. Properties
+// can be paths to values inside objects and arrays, like "user.plan" or
+// "roles[0]". A property that matches a key from the user context is never
+// treated as a path, so keys with dots or brackets still work. Nil is
+// returned when the path doesn't exist.
+func Lookup(usrContext map[string]interface{}, property string) interface{} {
+	if v, ok := usrContext[property]; ok {
+		return v
+	}
+	var current interface{} = usrContext
+	for _, key := range strings.Split(property, ".") {
+		// split the indexes from the key, as in "roles[0][1]"
+		var indexes []string
+		if idx := strings.Index(key, "["); idx >= 0 {
+			if !strings.HasSuffix(key, "]") {
+				return nil
+			}
+			indexes = strings.Split(key[idx+1:len(key)-1], "][")
+			key = key[:idx]
+		}
+		if key == "" && indexes == nil {
+			return nil
+		}
+		if key != "" {
+			obj, ok := current.(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			if current, ok = obj[key]; !ok {
+				return nil
+			}
+		}
+		for _, index := range indexes {
+			arr, ok := current.([]interface{})
+			if !ok {
+				return nil
+			}
+			n, err := strconv.Atoi(index)
+			if err != nil || n < 0 || n >= len(arr) {
+				return nil
+			}
+			current = arr[n]
+		}
+	}
+	return current
+}
@@ -18,7 +18,9 @@ func TestUserContext_UnmarshalJSON(t *testing.T) {
 		"bool": true,
 		"null": null,
 		"object": {},
-		"array": []
+		"array": [],
+		"user": {"plan": "pro", "seats": 10, "tags": ["beta", null]},
+		"roles": [{"name": "admin"}, 2.5, false]
 	}`)
 	uc := make(flaggio.UserContext)
 	err := json.Unmarshal(ucJSON, &uc)
@@ -28,6 +30,59 @@ func TestUserContext_UnmarshalJSON(t *testing.T) {
 	assert.Equal(t, float64(2.5), uc["float"])
 	assert.Equal(t, true, uc["bool"])
 	assert.Equal(t, "null", uc["null"])
-	assert.Equal(t, "{}", uc["object"])
-	assert.Equal(t, "[]", uc["array"])
+	assert.Equal(t, map[string]interface{}{}, uc["object"])
+	assert.Equal(t, []interface{}{}, uc["array"])
+	assert.Equal(t, map[string]interface{}{
+		"plan":  "pro",
+		"seats": int64(10),
+		"tags":  []interface{}{"beta", "null"},
+	}, uc["user"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "admin"},
+		float64(2.5),
+		false,
+	}, uc["roles"])
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+	uc := flaggio.UserContext{
+		"country":  "NZ",
+		"app.name": "flaggio",
+		"user": map[string]interface{}{
+			"plan":  "pro",
+			"teams": []interface{}{map[string]interface{}{"name": "core"}},
+		},
+		"roles":  []interface{}{"admin", "editor"},
+		"matrix": []interface{}{[]interface{}{int64(1), int64(2)}},
+	}
+	tests := []struct {
+		name          string
+		property      string
+		expectedValue interface{}
+	}{
+		{name: "top level key", property: "country", expectedValue: "NZ"},
+		{name: "key with dot", property: "app.name", expectedValue: "flaggio"},
+		{name: "nested key", property: "user.plan", expectedValue: "pro"},
+		{name: "array index", property: "roles[1]", expectedValue: "editor"},
+		{name: "nested array index", property: "user.teams[0].name", expectedValue: "core"},
+		{name: "multiple indexes", property: "matrix[0][1]", expectedValue: int64(2)},
+		{name: "whole object", property: "user.teams[0]", expectedValue: map[string]interface{}{"name": "core"}},
+		{name: "missing key", property: "user.seats", expectedValue: nil},
+		{name: "missing top level key", property: "device.os", expectedValue: nil},
+		{name: "key on scalar", property: "country.code", expectedValue: nil},
+		{name: "index out of range", property: "roles[2]", expectedValue: nil},
+		{name: "negative index", property: "roles[-1]", expectedValue: nil},
+		{name: "invalid index", property: "roles[first]", expectedValue: nil},
+		{name: "index on object", property: "user[0]", expectedValue: nil},
+		{name: "unclosed index", property: "roles[0", expectedValue: nil},
+		{name: "empty key", property: "user..plan", expectedValue: nil},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expectedValue, flaggio.Lookup(uc, tt.property))
+		})
+	}
 }
@@ -0,0 +1,128 @@
+package operator
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"time"
+)
+
+// Before operator will check if the time from the user context is before
+// all of the times configured on the flag. Times can be RFC3339 strings or
+// unix epochs in seconds.
+func Before(usrValue interface{}, validValues []interface{}) (bool, error) {
+	if usrValue == nil {
+		return false, nil
+	}
+	ut, err := toTime(usrValue)
+	if err != nil {
+		return false, err
+	}
+	for _, v := range validValues {
+		vt, err := toTime(v)
+		if err != nil {
+			return false, err
+		}
+		if !ut.Before(vt) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// After operator will check if the time from the user context is after
+// all of the times configured on the flag. Times can be RFC3339 strings or
+// unix epochs in seconds.
+func After(usrValue interface{}, validValues []interface{}) (bool, error) {
+	if usrValue == nil {
+		return false, nil
+	}
+	ut, err := toTime(usrValue)
+	if err != nil {
+		return false, err
+	}
+	for _, v := range validValues {
+		vt, err := toTime(v)
+		if err != nil {
+			return false, err
+		}
+		if !ut.After(vt) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Between operator will check if the time from the user context is between
+// the two times configured on the flag. The start time is inclusive and the
+// end time is exclusive. Times can be RFC3339 strings or unix epochs in
+// seconds.
+func Between(usrValue interface{}, validValues []interface{}) (bool, error) {
+	if len(validValues) != 2 {
+		return false, errors.New("between requires a start and an end time")
+	}
+	if usrValue == nil {
+		return false, nil
+	}
+	ut, err := toTime(usrValue)
+	if err != nil {
+		return false, err
+	}
+	start, err := toTime(validValues[0])
+	if err != nil {
+		return false, err
+	}
+	end, err := toTime(validValues[1])
+	if err != nil {
+		return false, err
+	}
+	return !ut.Before(start) && ut.Before(end), nil
+}
+
+// NextTime returns the earliest of the times configured on the flag that is
+// after now, or false if there is none. Invalid times are ignored, as the
+// operators fail with them at any time.
+func NextTime(now time.Time, validValues []interface{}) (time.Time, bool) {
+	var next time.Time
+	for _, v := range validValues {
+		vt, err := toTime(v)
+		if err != nil || !vt.After(now) {
+			continue
+		}
+		if next.IsZero() || vt.Before(next) {
+			next = vt
+		}
+	}
+	return next, !next.IsZero()
+}
+
+func toTime(t interface{}) (time.Time, error) {
+	switch v := t.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		tm, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, errors.New("invalid time: " + v)
+		}
+		return tm, nil
+	case float64:
+		sec, frac := math.Modf(v)
+		return time.Unix(int64(sec), int64(frac*float64(time.Second))), nil
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return time.Unix(n, 0), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return time.Time{}, errors.New("invalid time: " + v.String())
+		}
+		return toTime(f)
+	default:
+		n, err := toInt64(v)
+		if err != nil {
+			return time.Time{}, errors.New("not a time")
+		}
+		return time.Unix(n, 0), nil
+	}
+}
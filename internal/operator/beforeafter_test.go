@@ -0,0 +1,294 @@
+package operator_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/operator"
+)
+
+func TestBefore(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		usrContext     map[string]interface{}
+		property       string
+		values         []interface{}
+		expectedResult bool
+		expectedError  error
+	}{
+		{
+			name:           "rfc3339 before rfc3339",
+			usrContext:     map[string]interface{}{"signedUpAt": "2019-12-31T23:59:59Z"},
+			property:       "signedUpAt",
+			values:         []interface{}{"2020-01-01T00:00:00Z"},
+			expectedResult: true,
+		},
+		{
+			name:           "rfc3339 with offset before rfc3339",
+			usrContext:     map[string]interface{}{"signedUpAt": "2020-01-01T09:00:00+10:00"},
+			property:       "signedUpAt",
+			values:         []interface{}{"2020-01-01T00:00:00Z"},
+			expectedResult: true,
+		},
+		{
+			name:           "rfc3339 not before rfc3339",
+			usrContext:     map[string]interface{}{"signedUpAt": "2020-01-01T00:00:00Z"},
+			property:       "signedUpAt",
+			values:         []interface{}{"2020-01-01T00:00:00Z"},
+			expectedResult: false,
+		},
+		{
+			name:           "epoch before rfc3339",
+			usrContext:     map[string]interface{}{"signedUpAt": int64(1577836799)},
+			property:       "signedUpAt",
+			values:         []interface{}{"2020-01-01T00:00:00Z"},
+			expectedResult: true,
+		},
+		{
+			name:           "rfc3339 before epoch",
+			usrContext:     map[string]interface{}{"signedUpAt": "2019-12-31T23:59:59Z"},
+			property:       "signedUpAt",
+			values:         []interface{}{int32(1577836800)},
+			expectedResult: true,
+		},
+		{
+			name:           "float epoch before json number",
+			usrContext:     map[string]interface{}{"signedUpAt": float64(1577836799.5)},
+			property:       "signedUpAt",
+			values:         []interface{}{json.Number("1577836800")},
+			expectedResult: true,
+		},
+		{
+			name:           "before all from list",
+			usrContext:     map[string]interface{}{"signedUpAt": "2019-06-01T00:00:00Z"},
+			property:       "signedUpAt",
+			values:         []interface{}{"2020-01-01T00:00:00Z", "2019-07-01T00:00:00Z"},
+			expectedResult: true,
+		},
+		{
+			name:           "not before all from list",
+			usrContext:     map[string]interface{}{"signedUpAt": "2019-08-01T00:00:00Z"},
+			property:       "signedUpAt",
+			values:         []interface{}{"2020-01-01T00:00:00Z", "2019-07-01T00:00:00Z"},
+			expectedResult: false,
+		},
+		{
+			name:           "nil not before rfc3339",
+			usrContext:     map[string]interface{}{"signedUpAt": nil},
+			property:       "signedUpAt",
+			values:         []interface{}{"2020-01-01T00:00:00Z"},
+			expectedResult: false,
+		},
+		// ========================================================================
+		{
+			name:          "invalid user time",
+			usrContext:    map[string]interface{}{"signedUpAt": "yesterday"},
+			property:      "signedUpAt",
+			values:        []interface{}{"2020-01-01T00:00:00Z"},
+			expectedError: errors.New("invalid time: yesterday"),
+		},
+		{
+			name:          "invalid time type",
+			usrContext:    map[string]interface{}{"signedUpAt": true},
+			property:      "signedUpAt",
+			values:        []interface{}{"2020-01-01T00:00:00Z"},
+			expectedError: errors.New("not a time"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := operator.Before(tt.usrContext[tt.property], tt.values)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedResult, res)
+		})
+	}
+}
+
+func TestAfter(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		usrContext     map[string]interface{}
+		property       string
+		values         []interface{}
+		expectedResult bool
+		expectedError  error
+	}{
+		{
+			name:           "rfc3339 after rfc3339",
+			usrContext:     map[string]interface{}{"signedUpAt": "2020-01-01T00:00:01Z"},
+			property:       "signedUpAt",
+			values:         []interface{}{"2020-01-01T00:00:00Z"},
+			expectedResult: true,
+		},
+		{
+			name:           "rfc3339 not after rfc3339",
+			usrContext:     map[string]interface{}{"signedUpAt": "2020-01-01T00:00:00Z"},
+			property:       "signedUpAt",
+			values:         []interface{}{"2020-01-01T00:00:00Z"},
+			expectedResult: false,
+		},
+		{
+			name:           "epoch after epoch",
+			usrContext:     map[string]interface{}{"signedUpAt": int64(1577836801)},
+			property:       "signedUpAt",
+			values:         []interface{}{int64(1577836800)},
+			expectedResult: true,
+		},
+		{
+			name:           "after all from list",
+			usrContext:     map[string]interface{}{"signedUpAt": "2020-06-01T00:00:00Z"},
+			property:       "signedUpAt",
+			values:         []interface{}{"2020-01-01T00:00:00Z", "2020-05-01T00:00:00Z"},
+			expectedResult: true,
+		},
+		{
+			name:           "nil not after rfc3339",
+			usrContext:     map[string]interface{}{},
+			property:       "signedUpAt",
+			values:         []interface{}{"2020-01-01T00:00:00Z"},
+			expectedResult: false,
+		},
+		// ========================================================================
+		{
+			name:          "invalid configured time",
+			usrContext:    map[string]interface{}{"signedUpAt": "2020-01-01T00:00:00Z"},
+			property:      "signedUpAt",
+			values:        []interface{}{"2020-01-01"},
+			expectedError: errors.New("invalid time: 2020-01-01"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := operator.After(tt.usrContext[tt.property], tt.values)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedResult, res)
+		})
+	}
+}
+
+func TestBetween(t *testing.T) {
+	t.Parallel()
+	blackFriday := []interface{}{"2020-11-27T00:00:00Z", "2020-11-28T00:00:00Z"}
+	tests := []struct {
+		name           string
+		usrValue       interface{}
+		values         []interface{}
+		expectedResult bool
+		expectedError  error
+	}{
+		{
+			name:           "rfc3339 between",
+			usrValue:       "2020-11-27T10:00:00Z",
+			values:         blackFriday,
+			expectedResult: true,
+		},
+		{
+			name:           "start is inclusive",
+			usrValue:       "2020-11-27T00:00:00Z",
+			values:         blackFriday,
+			expectedResult: true,
+		},
+		{
+			name:           "end is exclusive",
+			usrValue:       "2020-11-28T00:00:00Z",
+			values:         blackFriday,
+			expectedResult: false,
+		},
+		{
+			name:           "time between",
+			usrValue:       time.Date(2020, 11, 27, 12, 0, 0, 0, time.UTC),
+			values:         blackFriday,
+			expectedResult: true,
+		},
+		{
+			name:           "time not between",
+			usrValue:       time.Date(2020, 11, 26, 12, 0, 0, 0, time.UTC),
+			values:         blackFriday,
+			expectedResult: false,
+		},
+		{
+			name:           "epoch between epochs",
+			usrValue:       int64(1606471200),
+			values:         []interface{}{int64(1606435200), int64(1606521600)},
+			expectedResult: true,
+		},
+		{
+			name:           "nil not between",
+			usrValue:       nil,
+			values:         blackFriday,
+			expectedResult: false,
+		},
+		// ========================================================================
+		{
+			name:          "missing end time",
+			usrValue:      "2020-11-27T10:00:00Z",
+			values:        []interface{}{"2020-11-27T00:00:00Z"},
+			expectedError: errors.New("between requires a start and an end time"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := operator.Between(tt.usrValue, tt.values)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedResult, res)
+		})
+	}
+}
+
+func TestNextTime(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2020, 11, 27, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name         string
+		values       []interface{}
+		expectedTime time.Time
+		expectedOk   bool
+	}{
+		{
+			name:         "earliest time after now",
+			values:       []interface{}{"2020-11-28T00:00:00Z", "2020-11-26T00:00:00Z", int64(1606474800)},
+			expectedTime: time.Unix(1606474800, 0),
+			expectedOk:   true,
+		},
+		{
+			name:       "now is not after now",
+			values:     []interface{}{"2020-11-27T10:00:00Z"},
+			expectedOk: false,
+		},
+		{
+			name:         "invalid times are ignored",
+			values:       []interface{}{"tomorrow", "2020-11-28T00:00:00Z"},
+			expectedTime: time.Date(2020, 11, 28, 0, 0, 0, 0, time.UTC),
+			expectedOk:   true,
+		},
+		{
+			name:       "no values",
+			values:     nil,
+			expectedOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			next, ok := operator.NextTime(now, tt.values)
+			assert.Equal(t, tt.expectedOk, ok)
+			assert.True(t, tt.expectedTime.Equal(next), "expected %s, got %s", tt.expectedTime, next)
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package operator
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// SemverEqual operator will check if the version from the user context equals
+// to any of the versions configured on the flag. Build metadata is ignored.
+func SemverEqual(usrValue interface{}, validValues []interface{}) (bool, error) {
+	if usrValue == nil {
+		return false, nil
+	}
+	uv, err := toVersion(usrValue)
+	if err != nil {
+		return false, err
+	}
+	for _, v := range validValues {
+		cv, err := toVersion(v)
+		if err != nil {
+			return false, err
+		}
+		if uv.compare(cv) == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// SemverGreater operator will check if the version from the user context is
+// greater than all of the versions configured on the flag.
+func SemverGreater(usrValue interface{}, validValues []interface{}) (bool, error) {
+	return semverCompareAll(usrValue, validValues, func(cmp int) bool { return cmp > 0 })
+}
+
+// SemverLower operator will check if the version from the user context is
+// lower than all of the versions configured on the flag.
+func SemverLower(usrValue interface{}, validValues []interface{}) (bool, error) {
+	return semverCompareAll(usrValue, validValues, func(cmp int) bool { return cmp < 0 })
+}
+
+// SemverInRange operator will check if the version from the user context
+// satisfies any of the ranges configured on the flag. A range is a list of
+// comparators separated by spaces that must all be satisfied, like
+// ">=2.3.0 <3". The comparators are =, >, >=, < and <=, and a version
+// without a comparator must be equal.
+func SemverInRange(usrValue interface{}, validValues []interface{}) (bool, error) {
+	if usrValue == nil {
+		return false, nil
+	}
+	uv, err := toVersion(usrValue)
+	if err != nil {
+		return false, err
+	}
+	for _, v := range validValues {
+		ok, err := inRange(uv, v)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func semverCompareAll(usrValue interface{}, validValues []interface{}, accept func(cmp int) bool) (bool, error) {
+	if usrValue == nil {
+		return false, nil
+	}
+	uv, err := toVersion(usrValue)
+	if err != nil {
+		return false, err
+	}
+	for _, v := range validValues {
+		cv, err := toVersion(v)
+		if err != nil {
+			return false, err
+		}
+		if !accept(uv.compare(cv)) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func inRange(uv version, rng interface{}) (bool, error) {
+	s, ok := rng.(string)
+	if !ok {
+		return false, errors.New("not a version range")
+	}
+	comparators := strings.Fields(s)
+	if len(comparators) == 0 {
+		return false, errors.New("invalid version range: " + s)
+	}
+	satisfied := true
+	for _, c := range comparators {
+		// the longer comparators must be checked first
+		op := ""
+		for _, prefix := range []string{">=", "<=", ">", "<", "="} {
+			if strings.HasPrefix(c, prefix) {
+				op = prefix
+				break
+			}
+		}
+		cv, err := parseVersion(c[len(op):])
+		if err != nil {
+			return false, errors.New("invalid version range: " + s)
+		}
+		cmp := uv.compare(cv)
+		switch op {
+		case ">=":
+			satisfied = satisfied && cmp >= 0
+		case "<=":
+			satisfied = satisfied && cmp <= 0
+		case ">":
+			satisfied = satisfied && cmp > 0
+		case "<":
+			satisfied = satisfied && cmp < 0
+		default:
+			satisfied = satisfied && cmp == 0
+		}
+	}
+	return satisfied, nil
+}
+
+// version is a semantic version, as described in https://semver.org.
+type version struct {
+	major, minor, patch uint64
+	preRelease          []string
+}
+
+func toVersion(v interface{}) (version, error) {
+	s, ok := v.(string)
+	if !ok {
+		return version{}, errors.New("not a version")
+	}
+	return parseVersion(s)
+}
+
+// parseVersion parses a semantic version. The "v" prefix is optional, and
+// the minor and patch numbers default to zero when missing, so "v2" is the
+// same as "2.0.0".
+func parseVersion(s string) (version, error) {
+	invalid := errors.New("invalid version: " + s)
+	str := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	// build metadata doesn't affect precedence
+	if idx := strings.Index(str, "+"); idx >= 0 {
+		str = str[:idx]
+	}
+	var v version
+	if idx := strings.Index(str, "-"); idx >= 0 {
+		v.preRelease = strings.Split(str[idx+1:], ".")
+		for _, id := range v.preRelease {
+			if id == "" {
+				return version{}, invalid
+			}
+		}
+		str = str[:idx]
+	}
+	parts := strings.Split(str, ".")
+	if len(parts) > 3 {
+		return version{}, invalid
+	}
+	nums := make([]uint64, 3)
+	for idx, p := range parts {
+		n, err := strconv.ParseUint(p, 10, 64)
+		if err != nil {
+			return version{}, invalid
+		}
+		nums[idx] = n
+	}
+	v.major, v.minor, v.patch = nums[0], nums[1], nums[2]
+	return v, nil
+}
+
+// compare returns -1, 0 or 1 if the version is lower, equal or greater
+// than the other version.
+func (v version) compare(other version) int {
+	if c := compareUint(v.major, other.major); c != 0 {
+		return c
+	}
+	if c := compareUint(v.minor, other.minor); c != 0 {
+		return c
+	}
+	if c := compareUint(v.patch, other.patch); c != 0 {
+		return c
+	}
+	// a pre-release version has lower precedence than the normal version
+	switch {
+	case len(v.preRelease) == 0 && len(other.preRelease) == 0:
+		return 0
+	case len(v.preRelease) == 0:
+		return 1
+	case len(other.preRelease) == 0:
+		return -1
+	}
+	for idx := 0; idx < len(v.preRelease) && idx < len(other.preRelease); idx++ {
+		if c := comparePreRelease(v.preRelease[idx], other.preRelease[idx]); c != 0 {
+			return c
+		}
+	}
+	// a larger set of pre-release identifiers has higher precedence
+	return compareUint(uint64(len(v.preRelease)), uint64(len(other.preRelease)))
+}
+
+// comparePreRelease compares two pre-release identifiers. Numeric
+// identifiers are compared numerically and have lower precedence than
+// alphanumeric ones, which are compared lexically.
+func comparePreRelease(id1, id2 string) int {
+	n1, err1 := strconv.ParseUint(id1, 10, 64)
+	n2, err2 := strconv.ParseUint(id2, 10, 64)
+	switch {
+	case err1 == nil && err2 == nil:
+		return compareUint(n1, n2)
+	case err1 == nil:
+		return -1
+	case err2 == nil:
+		return 1
+	default:
+		return strings.Compare(id1, id2)
+	}
+}
+
+func compareUint(n1, n2 uint64) int {
+	switch {
+	case n1 < n2:
+		return -1
+	case n1 > n2:
+		return 1
+	default:
+		return 0
+	}
+}
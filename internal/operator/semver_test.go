@@ -0,0 +1,357 @@
+package operator_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/operator"
+)
+
+func TestSemverEqual(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		usrContext     map[string]interface{}
+		property       string
+		values         []interface{}
+		expectedResult bool
+		expectedError  error
+	}{
+		{
+			name:           "version equal to version",
+			usrContext:     map[string]interface{}{"appVersion": "2.10.0"},
+			property:       "appVersion",
+			values:         []interface{}{"2.10.0"},
+			expectedResult: true,
+		},
+		{
+			name:           "version equal to version from list",
+			usrContext:     map[string]interface{}{"appVersion": "2.10.0"},
+			property:       "appVersion",
+			values:         []interface{}{"2.9.1", "2.10.0"},
+			expectedResult: true,
+		},
+		{
+			name:           "version equal to partial version",
+			usrContext:     map[string]interface{}{"appVersion": "v3.0.0"},
+			property:       "appVersion",
+			values:         []interface{}{"3"},
+			expectedResult: true,
+		},
+		{
+			name:           "build metadata is ignored",
+			usrContext:     map[string]interface{}{"appVersion": "1.0.0+20200501"},
+			property:       "appVersion",
+			values:         []interface{}{"1.0.0"},
+			expectedResult: true,
+		},
+		{
+			name:           "version not equal to pre-release",
+			usrContext:     map[string]interface{}{"appVersion": "1.0.0"},
+			property:       "appVersion",
+			values:         []interface{}{"1.0.0-beta"},
+			expectedResult: false,
+		},
+		{
+			name:           "nil not equal to version",
+			usrContext:     map[string]interface{}{"appVersion": nil},
+			property:       "appVersion",
+			values:         []interface{}{"1.0.0"},
+			expectedResult: false,
+		},
+		// ========================================================================
+		{
+			name:          "invalid user version",
+			usrContext:    map[string]interface{}{"appVersion": "latest"},
+			property:      "appVersion",
+			values:        []interface{}{"1.0.0"},
+			expectedError: errors.New("invalid version: latest"),
+		},
+		{
+			name:          "invalid configured version",
+			usrContext:    map[string]interface{}{"appVersion": "1.0.0"},
+			property:      "appVersion",
+			values:        []interface{}{"1.0.0.1"},
+			expectedError: errors.New("invalid version: 1.0.0.1"),
+		},
+		{
+			name:          "invalid version type",
+			usrContext:    map[string]interface{}{"appVersion": float64(2.1)},
+			property:      "appVersion",
+			values:        []interface{}{"2.1.0"},
+			expectedError: errors.New("not a version"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := operator.SemverEqual(tt.usrContext[tt.property], tt.values)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedResult, res)
+		})
+	}
+}
+
+func TestSemverGreater(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		usrContext     map[string]interface{}
+		property       string
+		values         []interface{}
+		expectedResult bool
+		expectedError  error
+	}{
+		{
+			name:           "minor compared numerically",
+			usrContext:     map[string]interface{}{"appVersion": "2.10.0"},
+			property:       "appVersion",
+			values:         []interface{}{"2.9.1"},
+			expectedResult: true,
+		},
+		{
+			name:           "version greater than all from list",
+			usrContext:     map[string]interface{}{"appVersion": "3.0.0"},
+			property:       "appVersion",
+			values:         []interface{}{"2.9.1", "2.10.0"},
+			expectedResult: true,
+		},
+		{
+			name:           "version not greater than all from list",
+			usrContext:     map[string]interface{}{"appVersion": "2.9.2"},
+			property:       "appVersion",
+			values:         []interface{}{"2.9.1", "2.10.0"},
+			expectedResult: false,
+		},
+		{
+			name:           "version not greater than equal version",
+			usrContext:     map[string]interface{}{"appVersion": "2.10.0"},
+			property:       "appVersion",
+			values:         []interface{}{"2.10.0"},
+			expectedResult: false,
+		},
+		{
+			name:           "release greater than pre-release",
+			usrContext:     map[string]interface{}{"appVersion": "1.0.0"},
+			property:       "appVersion",
+			values:         []interface{}{"1.0.0-rc.1"},
+			expectedResult: true,
+		},
+		{
+			name:           "numeric pre-release compared numerically",
+			usrContext:     map[string]interface{}{"appVersion": "1.0.0-beta.11"},
+			property:       "appVersion",
+			values:         []interface{}{"1.0.0-beta.2"},
+			expectedResult: true,
+		},
+		{
+			name:           "alphanumeric pre-release greater than numeric",
+			usrContext:     map[string]interface{}{"appVersion": "1.0.0-alpha.beta"},
+			property:       "appVersion",
+			values:         []interface{}{"1.0.0-alpha.1"},
+			expectedResult: true,
+		},
+		{
+			name:           "longer pre-release greater than shorter",
+			usrContext:     map[string]interface{}{"appVersion": "1.0.0-alpha.1"},
+			property:       "appVersion",
+			values:         []interface{}{"1.0.0-alpha"},
+			expectedResult: true,
+		},
+		{
+			name:           "nil not greater than version",
+			usrContext:     map[string]interface{}{},
+			property:       "appVersion",
+			values:         []interface{}{"1.0.0"},
+			expectedResult: false,
+		},
+		// ========================================================================
+		{
+			name:          "empty pre-release",
+			usrContext:    map[string]interface{}{"appVersion": "1.0.0-"},
+			property:      "appVersion",
+			values:        []interface{}{"1.0.0"},
+			expectedError: errors.New("invalid version: 1.0.0-"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := operator.SemverGreater(tt.usrContext[tt.property], tt.values)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedResult, res)
+		})
+	}
+}
+
+func TestSemverLower(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		usrContext     map[string]interface{}
+		property       string
+		values         []interface{}
+		expectedResult bool
+		expectedError  error
+	}{
+		{
+			name:           "minor compared numerically",
+			usrContext:     map[string]interface{}{"appVersion": "2.9.1"},
+			property:       "appVersion",
+			values:         []interface{}{"2.10.0"},
+			expectedResult: true,
+		},
+		{
+			name:           "version lower than all from list",
+			usrContext:     map[string]interface{}{"appVersion": "1.9.0"},
+			property:       "appVersion",
+			values:         []interface{}{"2.9.1", "2.10.0"},
+			expectedResult: true,
+		},
+		{
+			name:           "version not lower than equal version",
+			usrContext:     map[string]interface{}{"appVersion": "2.10.0"},
+			property:       "appVersion",
+			values:         []interface{}{"v2.10"},
+			expectedResult: false,
+		},
+		{
+			name:           "pre-release lower than release",
+			usrContext:     map[string]interface{}{"appVersion": "2.0.0-beta"},
+			property:       "appVersion",
+			values:         []interface{}{"2.0.0"},
+			expectedResult: true,
+		},
+		{
+			name:           "nil not lower than version",
+			usrContext:     map[string]interface{}{"appVersion": nil},
+			property:       "appVersion",
+			values:         []interface{}{"1.0.0"},
+			expectedResult: false,
+		},
+		// ========================================================================
+		{
+			name:          "invalid configured version",
+			usrContext:    map[string]interface{}{"appVersion": "1.0.0"},
+			property:      "appVersion",
+			values:        []interface{}{"1.x"},
+			expectedError: errors.New("invalid version: 1.x"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := operator.SemverLower(tt.usrContext[tt.property], tt.values)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedResult, res)
+		})
+	}
+}
+
+func TestSemverInRange(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		usrContext     map[string]interface{}
+		property       string
+		values         []interface{}
+		expectedResult bool
+		expectedError  error
+	}{
+		{
+			name:           "version in range",
+			usrContext:     map[string]interface{}{"appVersion": "2.10.0"},
+			property:       "appVersion",
+			values:         []interface{}{">=2.3.0 <3"},
+			expectedResult: true,
+		},
+		{
+			name:           "lower bound is inclusive",
+			usrContext:     map[string]interface{}{"appVersion": "2.3.0"},
+			property:       "appVersion",
+			values:         []interface{}{">=2.3.0 <3"},
+			expectedResult: true,
+		},
+		{
+			name:           "upper bound is exclusive",
+			usrContext:     map[string]interface{}{"appVersion": "3.0.0"},
+			property:       "appVersion",
+			values:         []interface{}{">=2.3.0 <3"},
+			expectedResult: false,
+		},
+		{
+			name:           "pre-release below upper bound",
+			usrContext:     map[string]interface{}{"appVersion": "3.0.0-beta"},
+			property:       "appVersion",
+			values:         []interface{}{">=2.3.0 <3"},
+			expectedResult: true,
+		},
+		{
+			name:           "version in range from list",
+			usrContext:     map[string]interface{}{"appVersion": "4.1.0"},
+			property:       "appVersion",
+			values:         []interface{}{">=2.3.0 <3", ">4 <=4.1"},
+			expectedResult: true,
+		},
+		{
+			name:           "version in exact range",
+			usrContext:     map[string]interface{}{"appVersion": "1.2.3"},
+			property:       "appVersion",
+			values:         []interface{}{"=1.2.3"},
+			expectedResult: true,
+		},
+		{
+			name:           "version without comparator",
+			usrContext:     map[string]interface{}{"appVersion": "1.2.4"},
+			property:       "appVersion",
+			values:         []interface{}{"1.2.3"},
+			expectedResult: false,
+		},
+		{
+			name:           "nil not in range",
+			usrContext:     map[string]interface{}{"appVersion": nil},
+			property:       "appVersion",
+			values:         []interface{}{">=2.3.0 <3"},
+			expectedResult: false,
+		},
+		// ========================================================================
+		{
+			name:          "invalid comparator",
+			usrContext:    map[string]interface{}{"appVersion": "2.10.0"},
+			property:      "appVersion",
+			values:        []interface{}{"~2.3"},
+			expectedError: errors.New("invalid version range: ~2.3"),
+		},
+		{
+			name:          "empty range",
+			usrContext:    map[string]interface{}{"appVersion": "2.10.0"},
+			property:      "appVersion",
+			values:        []interface{}{" "},
+			expectedError: errors.New("invalid version range:  "),
+		},
+		{
+			name:          "invalid range type",
+			usrContext:    map[string]interface{}{"appVersion": "2.10.0"},
+			property:      "appVersion",
+			values:        []interface{}{int64(2)},
+			expectedError: errors.New("not a version range"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := operator.SemverInRange(tt.usrContext[tt.property], tt.values)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedResult, res)
+		})
+	}
+}
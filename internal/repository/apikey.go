@@ -0,0 +1,23 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/apikey_mock.go -package=repository_mock github.com/victorkt/flaggio/internal/repository APIKey
+
+import (
+	"context"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// APIKey represents a set of operations available to list and manage API keys.
+type APIKey interface {
+	// FindAll returns a list of API keys, including revoked ones.
+	FindAll(ctx context.Context) ([]*flaggio.APIKey, error)
+	// FindByID returns an API key that has a given ID.
+	FindByID(ctx context.Context, id string) (*flaggio.APIKey, error)
+	// FindByHash returns an API key that has a given hash.
+	FindByHash(ctx context.Context, hash string) (*flaggio.APIKey, error)
+	// Create creates a new API key with the given hash.
+	Create(ctx context.Context, input flaggio.NewAPIKey, hash string) (string, error)
+	// Revoke revokes an API key, so that it can't be used anymore.
+	Revoke(ctx context.Context, id string) error
+}
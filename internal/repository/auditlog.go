@@ -0,0 +1,18 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/auditlog_mock.go -package=repository_mock github.com/victorkt/flaggio/internal/repository AuditLog
+
+import (
+	"context"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// AuditLog represents a set of operations available to record and list changes.
+type AuditLog interface {
+	// FindAll returns a list of audit entries that match an optional filter, newest
+	// first, based on an optional offset and limit.
+	FindAll(ctx context.Context, filter *flaggio.AuditLogFilter, offset, limit *int64) (*flaggio.AuditLogResults, error)
+	// Create creates a new audit entry.
+	Create(ctx context.Context, entry flaggio.AuditEntry) (string, error)
+}
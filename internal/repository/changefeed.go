@@ -0,0 +1,16 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/changefeed_mock.go -package=repository_mock github.com/victorkt/flaggio/internal/repository ChangeFeed
+
+import (
+	"context"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// ChangeFeed represents a source of notifications about changes to flags and segments.
+type ChangeFeed interface {
+	// Listen calls fn for every change until the context is cancelled or
+	// the feed fails. The returned error is nil only if the context was cancelled.
+	Listen(ctx context.Context, fn func(*flaggio.ChangeEvent)) error
+}
@@ -0,0 +1,25 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/environment_mock.go -package=repository_mock github.com/victorkt/flaggio/internal/repository Environment
+
+import (
+	"context"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// Environment represents a set of operations available to list and manage environments.
+type Environment interface {
+	// FindAll returns a list of environments.
+	FindAll(ctx context.Context) ([]*flaggio.Environment, error)
+	// FindByID returns an environment that has a given ID.
+	FindByID(ctx context.Context, id string) (*flaggio.Environment, error)
+	// FindByKey returns an environment that has a given key.
+	FindByKey(ctx context.Context, key string) (*flaggio.Environment, error)
+	// Create creates a new environment.
+	Create(ctx context.Context, input flaggio.NewEnvironment) (string, error)
+	// Update updates an environment.
+	Update(ctx context.Context, id string, input flaggio.UpdateEnvironment) error
+	// Delete deletes an environment, along with the flag settings for it.
+	Delete(ctx context.Context, id string) error
+}
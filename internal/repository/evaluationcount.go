@@ -0,0 +1,27 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/evaluationcount_mock.go -package=repository_mock github.com/victorkt/flaggio/internal/repository EvaluationCount
+
+import (
+	"context"
+	"time"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// EvaluationCount represents a set of operations available to count flag evaluations.
+type EvaluationCount interface {
+	// Write adds the evaluation events to the counts of the time buckets they belong to.
+	Write(ctx context.Context, evts []*flaggio.EvaluationEvent) error
+	// FindUsage returns the number of evaluations of a flag that match the filter, by
+	// period of time and variant, oldest first. Periods without evaluations are omitted.
+	FindUsage(ctx context.Context, filter flaggio.UsageFilter) ([]*flaggio.FlagUsage, error)
+	// FindLastEvaluatedAt returns when a flag in a project was last evaluated, or nil
+	// if it was never evaluated. If no project is given, only flags that don't belong
+	// to a project are considered.
+	FindLastEvaluatedAt(ctx context.Context, project *string, flagKey string) (*time.Time, error)
+	// FindAllLastEvaluatedAt returns when each flag in a project was last evaluated, by
+	// flag key. Flags that were never evaluated are not included. If no project is given,
+	// only flags that don't belong to a project are considered.
+	FindAllLastEvaluatedAt(ctx context.Context, project *string) (map[string]time.Time, error)
+}
@@ -10,16 +10,27 @@ import (
 
 // Flag represents a set of operations available to list and manage flags.
 type Flag interface {
-	// FindAll returns a list of flags, based on an optional offset and limit.
-	FindAll(ctx context.Context, search *string, offset, limit *int64) (*flaggio.FlagResults, error)
+	// FindAll returns a list of flags in a project, based on an optional offset and limit.
+	// If no project ID is given, only flags that don't belong to a project are returned.
+	FindAll(ctx context.Context, projectID, search *string, offset, limit *int64) (*flaggio.FlagResults, error)
 	// FindByID returns a flag that has a given ID.
 	FindByID(ctx context.Context, id string) (*flaggio.Flag, error)
-	// FindByKey returns a flag that has a given key.
-	FindByKey(ctx context.Context, key string) (*flaggio.Flag, error)
+	// FindByKey returns a flag in a project that has a given key.
+	// If no project ID is given, only flags that don't belong to a project are considered.
+	FindByKey(ctx context.Context, projectID *string, key string) (*flaggio.Flag, error)
+	// FindByKeys returns the flags in a project that have one of the given keys, in the
+	// order of the keys. Keys that don't match any flag are ignored.
+	// If no project ID is given, only flags that don't belong to a project are considered.
+	FindByKeys(ctx context.Context, projectID *string, keys []string) ([]*flaggio.Flag, error)
 	// Create creates a new flag.
 	Create(ctx context.Context, input flaggio.NewFlag) (string, error)
 	// Update updates a flag.
 	Update(ctx context.Context, id string, input flaggio.UpdateFlag) error
-	// Delete deletes a flag.
+	// UpdateEnvironment updates the settings of a flag for an environment.
+	UpdateEnvironment(ctx context.Context, id, environmentID string, input flaggio.UpdateFlagEnvironment) error
+	// Rollback restores the content of a flag from one of its versions,
+	// creating a new version.
+	Rollback(ctx context.Context, id string, version int) error
+	// Delete deletes a flag, along with its versions.
 	Delete(ctx context.Context, id string) error
 }
@@ -0,0 +1,18 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/flagversion_mock.go -package=repository_mock github.com/victorkt/flaggio/internal/repository FlagVersion
+
+import (
+	"context"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// FlagVersion represents a set of operations available to list the versions of a flag.
+type FlagVersion interface {
+	// FindAll returns the versions of a flag, newest first, based on an optional
+	// offset and limit.
+	FindAll(ctx context.Context, flagID string, offset, limit *int64) ([]*flaggio.FlagVersion, error)
+	// FindByVersion returns a version of a flag.
+	FindByVersion(ctx context.Context, flagID string, version int) (*flaggio.FlagVersion, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/lease_mock.go -package=repository_mock github.com/victorkt/flaggio/internal/repository Lease
+
+import (
+	"context"
+	"time"
+)
+
+// Lease represents a set of operations available to make sure only one
+// process at a time does some work.
+type Lease interface {
+	// Acquire acquires or renews the lease with a given name for a holder,
+	// until ttl has passed. It returns false if the lease is held by another
+	// holder.
+	Acquire(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+	// Release releases the lease with a given name, if it's held by holder.
+	Release(ctx context.Context, name, holder string) error
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/victorkt/flaggio/internal/repository (interfaces: ChangeFeed)
+
+// Package repository_mock is a generated GoMock package.
+package repository_mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	flaggio "github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// MockChangeFeed is a mock of ChangeFeed interface.
+type MockChangeFeed struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeFeedMockRecorder
+}
+
+// MockChangeFeedMockRecorder is the mock recorder for MockChangeFeed.
+type MockChangeFeedMockRecorder struct {
+	mock *MockChangeFeed
+}
+
+// NewMockChangeFeed creates a new mock instance.
+func NewMockChangeFeed(ctrl *gomock.Controller) *MockChangeFeed {
+	mock := &MockChangeFeed{ctrl: ctrl}
+	mock.recorder = &MockChangeFeedMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeFeed) EXPECT() *MockChangeFeedMockRecorder {
+	return m.recorder
+}
+
+// Listen mocks base method.
+func (m *MockChangeFeed) Listen(arg0 context.Context, arg1 func(*flaggio.ChangeEvent)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Listen", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Listen indicates an expected call of Listen.
+func (mr *MockChangeFeedMockRecorder) Listen(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Listen", reflect.TypeOf((*MockChangeFeed)(nil).Listen), arg0, arg1)
+}
@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/victorkt/flaggio/internal/repository (interfaces: Environment)
+
+// Package repository_mock is a generated GoMock package.
+package repository_mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	flaggio "github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// MockEnvironment is a mock of Environment interface.
+type MockEnvironment struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnvironmentMockRecorder
+}
+
+// MockEnvironmentMockRecorder is the mock recorder for MockEnvironment.
+type MockEnvironmentMockRecorder struct {
+	mock *MockEnvironment
+}
+
+// NewMockEnvironment creates a new mock instance.
+func NewMockEnvironment(ctrl *gomock.Controller) *MockEnvironment {
+	mock := &MockEnvironment{ctrl: ctrl}
+	mock.recorder = &MockEnvironmentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnvironment) EXPECT() *MockEnvironmentMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockEnvironment) Create(arg0 context.Context, arg1 flaggio.NewEnvironment) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockEnvironmentMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEnvironment)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockEnvironment) Delete(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockEnvironmentMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEnvironment)(nil).Delete), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockEnvironment) FindAll(arg0 context.Context) ([]*flaggio.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0)
+	ret0, _ := ret[0].([]*flaggio.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockEnvironmentMockRecorder) FindAll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockEnvironment)(nil).FindAll), arg0)
+}
+
+// FindByID mocks base method.
+func (m *MockEnvironment) FindByID(arg0 context.Context, arg1 string) (*flaggio.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*flaggio.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockEnvironmentMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockEnvironment)(nil).FindByID), arg0, arg1)
+}
+
+// FindByKey mocks base method.
+func (m *MockEnvironment) FindByKey(arg0 context.Context, arg1 string) (*flaggio.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByKey", arg0, arg1)
+	ret0, _ := ret[0].(*flaggio.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByKey indicates an expected call of FindByKey.
+func (mr *MockEnvironmentMockRecorder) FindByKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByKey", reflect.TypeOf((*MockEnvironment)(nil).FindByKey), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockEnvironment) Update(arg0 context.Context, arg1 string, arg2 flaggio.UpdateEnvironment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockEnvironmentMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockEnvironment)(nil).Update), arg0, arg1, arg2)
+}
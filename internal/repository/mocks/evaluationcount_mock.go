@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/victorkt/flaggio/internal/repository (interfaces: EvaluationCount)
+
+// Package repository_mock is a generated GoMock package.
+package repository_mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	flaggio "github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// MockEvaluationCount is a mock of EvaluationCount interface.
+type MockEvaluationCount struct {
+	ctrl     *gomock.Controller
+	recorder *MockEvaluationCountMockRecorder
+}
+
+// MockEvaluationCountMockRecorder is the mock recorder for MockEvaluationCount.
+type MockEvaluationCountMockRecorder struct {
+	mock *MockEvaluationCount
+}
+
+// NewMockEvaluationCount creates a new mock instance.
+func NewMockEvaluationCount(ctrl *gomock.Controller) *MockEvaluationCount {
+	mock := &MockEvaluationCount{ctrl: ctrl}
+	mock.recorder = &MockEvaluationCountMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEvaluationCount) EXPECT() *MockEvaluationCountMockRecorder {
+	return m.recorder
+}
+
+// FindAllLastEvaluatedAt mocks base method.
+func (m *MockEvaluationCount) FindAllLastEvaluatedAt(arg0 context.Context, arg1 *string) (map[string]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllLastEvaluatedAt", arg0, arg1)
+	ret0, _ := ret[0].(map[string]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllLastEvaluatedAt indicates an expected call of FindAllLastEvaluatedAt.
+func (mr *MockEvaluationCountMockRecorder) FindAllLastEvaluatedAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllLastEvaluatedAt", reflect.TypeOf((*MockEvaluationCount)(nil).FindAllLastEvaluatedAt), arg0, arg1)
+}
+
+// FindLastEvaluatedAt mocks base method.
+func (m *MockEvaluationCount) FindLastEvaluatedAt(arg0 context.Context, arg1 *string, arg2 string) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLastEvaluatedAt", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLastEvaluatedAt indicates an expected call of FindLastEvaluatedAt.
+func (mr *MockEvaluationCountMockRecorder) FindLastEvaluatedAt(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastEvaluatedAt", reflect.TypeOf((*MockEvaluationCount)(nil).FindLastEvaluatedAt), arg0, arg1, arg2)
+}
+
+// FindUsage mocks base method.
+func (m *MockEvaluationCount) FindUsage(arg0 context.Context, arg1 flaggio.UsageFilter) ([]*flaggio.FlagUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUsage", arg0, arg1)
+	ret0, _ := ret[0].([]*flaggio.FlagUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUsage indicates an expected call of FindUsage.
+func (mr *MockEvaluationCountMockRecorder) FindUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUsage", reflect.TypeOf((*MockEvaluationCount)(nil).FindUsage), arg0, arg1)
+}
+
+// Write mocks base method.
+func (m *MockEvaluationCount) Write(arg0 context.Context, arg1 []*flaggio.EvaluationEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockEvaluationCountMockRecorder) Write(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockEvaluationCount)(nil).Write), arg0, arg1)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/victorkt/flaggio/internal/repository (interfaces: FlagVersion)
+
+// Package repository_mock is a generated GoMock package.
+package repository_mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	flaggio "github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// MockFlagVersion is a mock of FlagVersion interface.
+type MockFlagVersion struct {
+	ctrl     *gomock.Controller
+	recorder *MockFlagVersionMockRecorder
+}
+
+// MockFlagVersionMockRecorder is the mock recorder for MockFlagVersion.
+type MockFlagVersionMockRecorder struct {
+	mock *MockFlagVersion
+}
+
+// NewMockFlagVersion creates a new mock instance.
+func NewMockFlagVersion(ctrl *gomock.Controller) *MockFlagVersion {
+	mock := &MockFlagVersion{ctrl: ctrl}
+	mock.recorder = &MockFlagVersionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFlagVersion) EXPECT() *MockFlagVersionMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockFlagVersion) FindAll(arg0 context.Context, arg1 string, arg2, arg3 *int64) ([]*flaggio.FlagVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*flaggio.FlagVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockFlagVersionMockRecorder) FindAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockFlagVersion)(nil).FindAll), arg0, arg1, arg2, arg3)
+}
+
+// FindByVersion mocks base method.
+func (m *MockFlagVersion) FindByVersion(arg0 context.Context, arg1 string, arg2 int) (*flaggio.FlagVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByVersion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*flaggio.FlagVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByVersion indicates an expected call of FindByVersion.
+func (mr *MockFlagVersionMockRecorder) FindByVersion(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByVersion", reflect.TypeOf((*MockFlagVersion)(nil).FindByVersion), arg0, arg1, arg2)
+}
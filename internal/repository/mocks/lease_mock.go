@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/victorkt/flaggio/internal/repository (interfaces: Lease)
+
+// Package repository_mock is a generated GoMock package.
+package repository_mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLease is a mock of Lease interface.
+type MockLease struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaseMockRecorder
+}
+
+// MockLeaseMockRecorder is the mock recorder for MockLease.
+type MockLeaseMockRecorder struct {
+	mock *MockLease
+}
+
+// NewMockLease creates a new mock instance.
+func NewMockLease(ctrl *gomock.Controller) *MockLease {
+	mock := &MockLease{ctrl: ctrl}
+	mock.recorder = &MockLeaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLease) EXPECT() *MockLeaseMockRecorder {
+	return m.recorder
+}
+
+// Acquire mocks base method.
+func (m *MockLease) Acquire(arg0 context.Context, arg1, arg2 string, arg3 time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Acquire", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Acquire indicates an expected call of Acquire.
+func (mr *MockLeaseMockRecorder) Acquire(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Acquire", reflect.TypeOf((*MockLease)(nil).Acquire), arg0, arg1, arg2, arg3)
+}
+
+// Release mocks base method.
+func (m *MockLease) Release(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockLeaseMockRecorder) Release(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockLease)(nil).Release), arg0, arg1, arg2)
+}
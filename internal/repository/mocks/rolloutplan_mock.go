@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/victorkt/flaggio/internal/repository (interfaces: RolloutPlan)
+
+// Package repository_mock is a generated GoMock package.
+package repository_mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	flaggio "github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// MockRolloutPlan is a mock of RolloutPlan interface.
+type MockRolloutPlan struct {
+	ctrl     *gomock.Controller
+	recorder *MockRolloutPlanMockRecorder
+}
+
+// MockRolloutPlanMockRecorder is the mock recorder for MockRolloutPlan.
+type MockRolloutPlanMockRecorder struct {
+	mock *MockRolloutPlan
+}
+
+// NewMockRolloutPlan creates a new mock instance.
+func NewMockRolloutPlan(ctrl *gomock.Controller) *MockRolloutPlan {
+	mock := &MockRolloutPlan{ctrl: ctrl}
+	mock.recorder = &MockRolloutPlanMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRolloutPlan) EXPECT() *MockRolloutPlanMockRecorder {
+	return m.recorder
+}
+
+// Advance mocks base method.
+func (m *MockRolloutPlan) Advance(arg0 context.Context, arg1 string, arg2 int, arg3 error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Advance", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Advance indicates an expected call of Advance.
+func (mr *MockRolloutPlanMockRecorder) Advance(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Advance", reflect.TypeOf((*MockRolloutPlan)(nil).Advance), arg0, arg1, arg2, arg3)
+}
+
+// Cancel mocks base method.
+func (m *MockRolloutPlan) Cancel(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockRolloutPlanMockRecorder) Cancel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockRolloutPlan)(nil).Cancel), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockRolloutPlan) Create(arg0 context.Context, arg1 flaggio.RolloutPlan) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRolloutPlanMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRolloutPlan)(nil).Create), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockRolloutPlan) FindAll(arg0 context.Context, arg1 *flaggio.RolloutPlanFilter, arg2, arg3 *int64) ([]*flaggio.RolloutPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*flaggio.RolloutPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockRolloutPlanMockRecorder) FindAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRolloutPlan)(nil).FindAll), arg0, arg1, arg2, arg3)
+}
+
+// FindByID mocks base method.
+func (m *MockRolloutPlan) FindByID(arg0 context.Context, arg1 string) (*flaggio.RolloutPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*flaggio.RolloutPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockRolloutPlanMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockRolloutPlan)(nil).FindByID), arg0, arg1)
+}
+
+// FindDue mocks base method.
+func (m *MockRolloutPlan) FindDue(arg0 context.Context, arg1 time.Time, arg2 int64) ([]*flaggio.RolloutPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDue", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*flaggio.RolloutPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDue indicates an expected call of FindDue.
+func (mr *MockRolloutPlanMockRecorder) FindDue(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDue", reflect.TypeOf((*MockRolloutPlan)(nil).FindDue), arg0, arg1, arg2)
+}
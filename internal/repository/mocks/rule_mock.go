@@ -6,50 +6,51 @@ package repository_mock
 
 import (
 	context "context"
+	reflect "reflect"
+
 	gomock "github.com/golang/mock/gomock"
 	flaggio "github.com/victorkt/flaggio/internal/flaggio"
-	reflect "reflect"
 )
 
-// MockRule is a mock of Rule interface
+// MockRule is a mock of Rule interface.
 type MockRule struct {
 	ctrl     *gomock.Controller
 	recorder *MockRuleMockRecorder
 }
 
-// MockRuleMockRecorder is the mock recorder for MockRule
+// MockRuleMockRecorder is the mock recorder for MockRule.
 type MockRuleMockRecorder struct {
 	mock *MockRule
 }
 
-// NewMockRule creates a new mock instance
+// NewMockRule creates a new mock instance.
 func NewMockRule(ctrl *gomock.Controller) *MockRule {
 	mock := &MockRule{ctrl: ctrl}
 	mock.recorder = &MockRuleMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockRule) EXPECT() *MockRuleMockRecorder {
 	return m.recorder
 }
 
-// CreateFlagRule mocks base method
-func (m *MockRule) CreateFlagRule(arg0 context.Context, arg1 string, arg2 flaggio.NewFlagRule) (string, error) {
+// CreateFlagRule mocks base method.
+func (m *MockRule) CreateFlagRule(arg0 context.Context, arg1 string, arg2 *string, arg3 flaggio.NewFlagRule) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateFlagRule", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "CreateFlagRule", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateFlagRule indicates an expected call of CreateFlagRule
-func (mr *MockRuleMockRecorder) CreateFlagRule(arg0, arg1, arg2 interface{}) *gomock.Call {
+// CreateFlagRule indicates an expected call of CreateFlagRule.
+func (mr *MockRuleMockRecorder) CreateFlagRule(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFlagRule", reflect.TypeOf((*MockRule)(nil).CreateFlagRule), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFlagRule", reflect.TypeOf((*MockRule)(nil).CreateFlagRule), arg0, arg1, arg2, arg3)
 }
 
-// CreateSegmentRule mocks base method
+// CreateSegmentRule mocks base method.
 func (m *MockRule) CreateSegmentRule(arg0 context.Context, arg1 string, arg2 flaggio.NewSegmentRule) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateSegmentRule", arg0, arg1, arg2)
@@ -58,27 +59,27 @@ func (m *MockRule) CreateSegmentRule(arg0 context.Context, arg1 string, arg2 fla
 	return ret0, ret1
 }
 
-// CreateSegmentRule indicates an expected call of CreateSegmentRule
+// CreateSegmentRule indicates an expected call of CreateSegmentRule.
 func (mr *MockRuleMockRecorder) CreateSegmentRule(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSegmentRule", reflect.TypeOf((*MockRule)(nil).CreateSegmentRule), arg0, arg1, arg2)
 }
 
-// DeleteFlagRule mocks base method
-func (m *MockRule) DeleteFlagRule(arg0 context.Context, arg1, arg2 string) error {
+// DeleteFlagRule mocks base method.
+func (m *MockRule) DeleteFlagRule(arg0 context.Context, arg1 string, arg2 *string, arg3 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteFlagRule", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "DeleteFlagRule", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteFlagRule indicates an expected call of DeleteFlagRule
-func (mr *MockRuleMockRecorder) DeleteFlagRule(arg0, arg1, arg2 interface{}) *gomock.Call {
+// DeleteFlagRule indicates an expected call of DeleteFlagRule.
+func (mr *MockRuleMockRecorder) DeleteFlagRule(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFlagRule", reflect.TypeOf((*MockRule)(nil).DeleteFlagRule), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFlagRule", reflect.TypeOf((*MockRule)(nil).DeleteFlagRule), arg0, arg1, arg2, arg3)
 }
 
-// DeleteSegmentRule mocks base method
+// DeleteSegmentRule mocks base method.
 func (m *MockRule) DeleteSegmentRule(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteSegmentRule", arg0, arg1, arg2)
@@ -86,28 +87,28 @@ func (m *MockRule) DeleteSegmentRule(arg0 context.Context, arg1, arg2 string) er
 	return ret0
 }
 
-// DeleteSegmentRule indicates an expected call of DeleteSegmentRule
+// DeleteSegmentRule indicates an expected call of DeleteSegmentRule.
 func (mr *MockRuleMockRecorder) DeleteSegmentRule(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSegmentRule", reflect.TypeOf((*MockRule)(nil).DeleteSegmentRule), arg0, arg1, arg2)
 }
 
-// FindFlagRuleByID mocks base method
-func (m *MockRule) FindFlagRuleByID(arg0 context.Context, arg1, arg2 string) (*flaggio.FlagRule, error) {
+// FindFlagRuleByID mocks base method.
+func (m *MockRule) FindFlagRuleByID(arg0 context.Context, arg1 string, arg2 *string, arg3 string) (*flaggio.FlagRule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindFlagRuleByID", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "FindFlagRuleByID", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*flaggio.FlagRule)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindFlagRuleByID indicates an expected call of FindFlagRuleByID
-func (mr *MockRuleMockRecorder) FindFlagRuleByID(arg0, arg1, arg2 interface{}) *gomock.Call {
+// FindFlagRuleByID indicates an expected call of FindFlagRuleByID.
+func (mr *MockRuleMockRecorder) FindFlagRuleByID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFlagRuleByID", reflect.TypeOf((*MockRule)(nil).FindFlagRuleByID), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFlagRuleByID", reflect.TypeOf((*MockRule)(nil).FindFlagRuleByID), arg0, arg1, arg2, arg3)
 }
 
-// FindSegmentRuleByID mocks base method
+// FindSegmentRuleByID mocks base method.
 func (m *MockRule) FindSegmentRuleByID(arg0 context.Context, arg1, arg2 string) (*flaggio.SegmentRule, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindSegmentRuleByID", arg0, arg1, arg2)
@@ -116,27 +117,27 @@ func (m *MockRule) FindSegmentRuleByID(arg0 context.Context, arg1, arg2 string)
 	return ret0, ret1
 }
 
-// FindSegmentRuleByID indicates an expected call of FindSegmentRuleByID
+// FindSegmentRuleByID indicates an expected call of FindSegmentRuleByID.
 func (mr *MockRuleMockRecorder) FindSegmentRuleByID(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSegmentRuleByID", reflect.TypeOf((*MockRule)(nil).FindSegmentRuleByID), arg0, arg1, arg2)
 }
 
-// UpdateFlagRule mocks base method
-func (m *MockRule) UpdateFlagRule(arg0 context.Context, arg1, arg2 string, arg3 flaggio.UpdateFlagRule) error {
+// UpdateFlagRule mocks base method.
+func (m *MockRule) UpdateFlagRule(arg0 context.Context, arg1 string, arg2 *string, arg3 string, arg4 flaggio.UpdateFlagRule) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateFlagRule", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "UpdateFlagRule", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateFlagRule indicates an expected call of UpdateFlagRule
-func (mr *MockRuleMockRecorder) UpdateFlagRule(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+// UpdateFlagRule indicates an expected call of UpdateFlagRule.
+func (mr *MockRuleMockRecorder) UpdateFlagRule(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFlagRule", reflect.TypeOf((*MockRule)(nil).UpdateFlagRule), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFlagRule", reflect.TypeOf((*MockRule)(nil).UpdateFlagRule), arg0, arg1, arg2, arg3, arg4)
 }
 
-// UpdateSegmentRule mocks base method
+// UpdateSegmentRule mocks base method.
 func (m *MockRule) UpdateSegmentRule(arg0 context.Context, arg1, arg2 string, arg3 flaggio.UpdateSegmentRule) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateSegmentRule", arg0, arg1, arg2, arg3)
@@ -144,7 +145,7 @@ func (m *MockRule) UpdateSegmentRule(arg0 context.Context, arg1, arg2 string, ar
 	return ret0
 }
 
-// UpdateSegmentRule indicates an expected call of UpdateSegmentRule
+// UpdateSegmentRule indicates an expected call of UpdateSegmentRule.
 func (mr *MockRuleMockRecorder) UpdateSegmentRule(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSegmentRule", reflect.TypeOf((*MockRule)(nil).UpdateSegmentRule), arg0, arg1, arg2, arg3)
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/victorkt/flaggio/internal/repository (interfaces: ScheduledChange)
+
+// Package repository_mock is a generated GoMock package.
+package repository_mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	flaggio "github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// MockScheduledChange is a mock of ScheduledChange interface.
+type MockScheduledChange struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduledChangeMockRecorder
+}
+
+// MockScheduledChangeMockRecorder is the mock recorder for MockScheduledChange.
+type MockScheduledChangeMockRecorder struct {
+	mock *MockScheduledChange
+}
+
+// NewMockScheduledChange creates a new mock instance.
+func NewMockScheduledChange(ctrl *gomock.Controller) *MockScheduledChange {
+	mock := &MockScheduledChange{ctrl: ctrl}
+	mock.recorder = &MockScheduledChangeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduledChange) EXPECT() *MockScheduledChangeMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockScheduledChange) Cancel(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockScheduledChangeMockRecorder) Cancel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockScheduledChange)(nil).Cancel), arg0, arg1)
+}
+
+// Claim mocks base method.
+func (m *MockScheduledChange) Claim(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockScheduledChangeMockRecorder) Claim(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockScheduledChange)(nil).Claim), arg0, arg1)
+}
+
+// Complete mocks base method.
+func (m *MockScheduledChange) Complete(arg0 context.Context, arg1 string, arg2 time.Time, arg3 error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockScheduledChangeMockRecorder) Complete(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockScheduledChange)(nil).Complete), arg0, arg1, arg2, arg3)
+}
+
+// Create mocks base method.
+func (m *MockScheduledChange) Create(arg0 context.Context, arg1 flaggio.ScheduledChange) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockScheduledChangeMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockScheduledChange)(nil).Create), arg0, arg1)
+}
+
+// FindAll mocks base method.
+func (m *MockScheduledChange) FindAll(arg0 context.Context, arg1 *flaggio.ScheduledChangeFilter, arg2, arg3 *int64) ([]*flaggio.ScheduledChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*flaggio.ScheduledChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockScheduledChangeMockRecorder) FindAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockScheduledChange)(nil).FindAll), arg0, arg1, arg2, arg3)
+}
+
+// FindByID mocks base method.
+func (m *MockScheduledChange) FindByID(arg0 context.Context, arg1 string) (*flaggio.ScheduledChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*flaggio.ScheduledChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockScheduledChangeMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockScheduledChange)(nil).FindByID), arg0, arg1)
+}
+
+// FindDue mocks base method.
+func (m *MockScheduledChange) FindDue(arg0 context.Context, arg1 time.Time, arg2 int64) ([]*flaggio.ScheduledChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDue", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*flaggio.ScheduledChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDue indicates an expected call of FindDue.
+func (mr *MockScheduledChangeMockRecorder) FindDue(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDue", reflect.TypeOf((*MockScheduledChange)(nil).FindDue), arg0, arg1, arg2)
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/victorkt/flaggio/internal/repository (interfaces: Target)
+
+// Package repository_mock is a generated GoMock package.
+package repository_mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	flaggio "github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// MockTarget is a mock of Target interface.
+type MockTarget struct {
+	ctrl     *gomock.Controller
+	recorder *MockTargetMockRecorder
+}
+
+// MockTargetMockRecorder is the mock recorder for MockTarget.
+type MockTargetMockRecorder struct {
+	mock *MockTarget
+}
+
+// NewMockTarget creates a new mock instance.
+func NewMockTarget(ctrl *gomock.Controller) *MockTarget {
+	mock := &MockTarget{ctrl: ctrl}
+	mock.recorder = &MockTargetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTarget) EXPECT() *MockTargetMockRecorder {
+	return m.recorder
+}
+
+// AddUsers mocks base method.
+func (m *MockTarget) AddUsers(arg0 context.Context, arg1, arg2 string, arg3 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUsers", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUsers indicates an expected call of AddUsers.
+func (mr *MockTargetMockRecorder) AddUsers(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUsers", reflect.TypeOf((*MockTarget)(nil).AddUsers), arg0, arg1, arg2, arg3)
+}
+
+// FindAllByFlagID mocks base method.
+func (m *MockTarget) FindAllByFlagID(arg0 context.Context, arg1 string) ([]*flaggio.FlagTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByFlagID", arg0, arg1)
+	ret0, _ := ret[0].([]*flaggio.FlagTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByFlagID indicates an expected call of FindAllByFlagID.
+func (mr *MockTargetMockRecorder) FindAllByFlagID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByFlagID", reflect.TypeOf((*MockTarget)(nil).FindAllByFlagID), arg0, arg1)
+}
+
+// FindAllByFlagIDs mocks base method.
+func (m *MockTarget) FindAllByFlagIDs(arg0 context.Context, arg1 []string) ([]*flaggio.FlagTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByFlagIDs", arg0, arg1)
+	ret0, _ := ret[0].([]*flaggio.FlagTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByFlagIDs indicates an expected call of FindAllByFlagIDs.
+func (mr *MockTargetMockRecorder) FindAllByFlagIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByFlagIDs", reflect.TypeOf((*MockTarget)(nil).FindAllByFlagIDs), arg0, arg1)
+}
+
+// FindAllByUserID mocks base method.
+func (m *MockTarget) FindAllByUserID(arg0 context.Context, arg1 string) ([]*flaggio.FlagTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByUserID", arg0, arg1)
+	ret0, _ := ret[0].([]*flaggio.FlagTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByUserID indicates an expected call of FindAllByUserID.
+func (mr *MockTargetMockRecorder) FindAllByUserID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByUserID", reflect.TypeOf((*MockTarget)(nil).FindAllByUserID), arg0, arg1)
+}
+
+// RemoveUsers mocks base method.
+func (m *MockTarget) RemoveUsers(arg0 context.Context, arg1, arg2 string, arg3 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUsers", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUsers indicates an expected call of RemoveUsers.
+func (mr *MockTargetMockRecorder) RemoveUsers(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUsers", reflect.TypeOf((*MockTarget)(nil).RemoveUsers), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,174 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const apiKeysCollection = "api_keys"
+
+var _ repository.APIKey = (*APIKeyRepository)(nil)
+
+// APIKeyRepository implements repository.APIKey interface using mongodb.
+type APIKeyRepository struct {
+	db  *mongo.Database
+	col *mongo.Collection
+}
+
+// FindAll returns a list of API keys, including revoked ones.
+func (r *APIKeyRepository) FindAll(ctx context.Context) ([]*flaggio.APIKey, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoAPIKeyRepository.FindAll")
+	defer span.Finish()
+
+	cursor, err := r.col.Find(ctx, bson.M{}, &options.FindOptions{
+		Sort:      bson.M{"name": 1},
+		Collation: &options.Collation{Locale: "en"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys := []*flaggio.APIKey{}
+	for cursor.Next(ctx) {
+		var k apiKeyModel
+		// decode the document
+		if err := cursor.Decode(&k); err != nil {
+			return nil, err
+		}
+		apiKeys = append(apiKeys, k.asAPIKey())
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return apiKeys, nil
+}
+
+// FindByID returns an API key that has a given ID.
+func (r *APIKeyRepository) FindByID(ctx context.Context, idHex string) (*flaggio.APIKey, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoAPIKeyRepository.FindByID")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return nil, err
+	}
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// FindByHash returns an API key that has a given hash.
+func (r *APIKeyRepository) FindByHash(ctx context.Context, hash string) (*flaggio.APIKey, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoAPIKeyRepository.FindByHash")
+	defer span.Finish()
+
+	return r.findOne(ctx, bson.M{"hash": hash})
+}
+
+func (r *APIKeyRepository) findOne(ctx context.Context, filter bson.M) (*flaggio.APIKey, error) {
+	var k apiKeyModel
+	if err := r.col.FindOne(ctx, filter).Decode(&k); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.NotFound("api key")
+		}
+		return nil, err
+	}
+	return k.asAPIKey(), nil
+}
+
+// Create creates a new API key with the given hash.
+func (r *APIKeyRepository) Create(ctx context.Context, k flaggio.NewAPIKey, hash string) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoAPIKeyRepository.Create")
+	defer span.Finish()
+
+	projectID, err := projectObjectID(k.ProjectID)
+	if err != nil {
+		return "", err
+	}
+	if err := ensureProject(ctx, r.db, projectID); err != nil {
+		return "", err
+	}
+	var envID *primitive.ObjectID
+	if k.EnvironmentID != nil {
+		oid, err := primitive.ObjectIDFromHex(*k.EnvironmentID)
+		if err != nil {
+			return "", err
+		}
+		count, err := r.db.Collection(environmentsCollection).CountDocuments(ctx, bson.M{"_id": oid})
+		if err != nil {
+			return "", err
+		}
+		if count == 0 {
+			return "", errors.NotFound("environment")
+		}
+		envID = &oid
+	}
+	id := primitive.NewObjectID()
+	_, err = r.col.InsertOne(ctx, &apiKeyModel{
+		ID:            id,
+		CreatedAt:     time.Now(),
+		Name:          k.Name,
+		Kind:          string(k.Kind),
+		Hash:          hash,
+		ProjectID:     projectID,
+		EnvironmentID: envID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return id.Hex(), nil
+}
+
+// Revoke revokes an API key, so that it can't be used anymore.
+// Revoking a key that was already revoked has no effect.
+func (r *APIKeyRepository) Revoke(ctx context.Context, idHex string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoAPIKeyRepository.Revoke")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	res, err := r.col.UpdateOne(
+		ctx,
+		bson.M{"_id": id, "revokedAt": nil},
+		bson.M{"$set": bson.M{"revokedAt": time.Now()}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		// either the key doesn't exist or it was already revoked
+		_, err := r.findOne(ctx, bson.M{"_id": id})
+		return err
+	}
+	return nil
+}
+
+// NewAPIKeyRepository returns a new API key repository that uses mongodb as underlying storage.
+// It also creates all needed indexes, if they don't yet exist.
+func NewAPIKeyRepository(ctx context.Context, db *mongo.Database) (repository.APIKey, error) {
+	col := db.Collection(apiKeysCollection)
+	_, err := col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.M{"hash": 1},
+			Options: options.Index().SetUnique(true).SetBackground(false),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &APIKeyRepository{
+		db:  db,
+		col: col,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const auditLogCollection = "audit_log"
+
+var _ repository.AuditLog = (*AuditLogRepository)(nil)
+
+// AuditLogRepository implements repository.AuditLog interface using mongodb.
+type AuditLogRepository struct {
+	col *mongo.Collection
+}
+
+// FindAll returns a list of audit entries that match an optional filter, newest
+// first, based on an optional offset and limit.
+func (r *AuditLogRepository) FindAll(ctx context.Context, f *flaggio.AuditLogFilter, offset, limit *int64) (*flaggio.AuditLogResults, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoAuditLogRepository.FindAll")
+	defer span.Finish()
+
+	filter := bson.M{}
+	if f != nil {
+		if f.EntityType != nil {
+			filter["entityType"] = string(*f.EntityType)
+		}
+		if f.EntityID != nil {
+			filter["entityId"] = *f.EntityID
+		}
+		if f.ActorID != nil {
+			filter["actor.id"] = *f.ActorID
+		}
+		if f.Action != nil {
+			filter["action"] = string(*f.Action)
+		}
+		createdAt := bson.M{}
+		if f.From != nil {
+			createdAt["$gte"] = *f.From
+		}
+		if f.To != nil {
+			createdAt["$lt"] = *f.To
+		}
+		if len(createdAt) > 0 {
+			filter["createdAt"] = createdAt
+		}
+	}
+	cursor, err := r.col.Find(ctx, filter, &options.FindOptions{
+		Skip:  offset,
+		Limit: limit,
+		Sort:  bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*flaggio.AuditEntry{}
+	for cursor.Next(ctx) {
+		var e auditEntryModel
+		// decode the document
+		if err := cursor.Decode(&e); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e.asAuditEntry())
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	total, err := r.col.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &flaggio.AuditLogResults{
+		Entries: entries,
+		Total:   int(total),
+	}, nil
+}
+
+// Create creates a new audit entry.
+func (r *AuditLogRepository) Create(ctx context.Context, e flaggio.AuditEntry) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoAuditLogRepository.Create")
+	defer span.Finish()
+
+	id := primitive.NewObjectID()
+	changes := make([]auditChangeModel, len(e.Changes))
+	for idx, c := range e.Changes {
+		changes[idx] = auditChangeModel{Path: c.Path, Before: c.Before, After: c.After}
+	}
+	var actor *auditActorModel
+	if e.Actor != nil {
+		actor = &auditActorModel{ID: e.Actor.ID, Email: e.Actor.Email, Name: e.Actor.Name}
+	}
+	_, err := r.col.InsertOne(ctx, &auditEntryModel{
+		ID:         id,
+		Actor:      actor,
+		EntityType: string(e.EntityType),
+		EntityID:   e.EntityID,
+		Action:     string(e.Action),
+		Operation:  e.Operation,
+		Before:     e.Before,
+		After:      e.After,
+		Changes:    changes,
+		CreatedAt:  time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return id.Hex(), nil
+}
+
+// NewAuditLogRepository returns a new audit log repository that uses mongodb as underlying storage.
+// It also creates all needed indexes, if they don't yet exist.
+func NewAuditLogRepository(ctx context.Context, db *mongo.Database) (repository.AuditLog, error) {
+	col := db.Collection(auditLogCollection)
+	_, err := col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "createdAt", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "entityType", Value: 1}, {Key: "entityId", Value: 1}, {Key: "createdAt", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "actor.id", Value: 1}, {Key: "createdAt", Value: -1}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &AuditLogRepository{
+		col: col,
+	}, nil
+}
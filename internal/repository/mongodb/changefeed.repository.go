@@ -0,0 +1,106 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var _ repository.ChangeFeed = (*ChangeFeedRepository)(nil)
+
+// ChangeFeedRepository implements repository.ChangeFeed interface using
+// mongodb change streams on the flags and segments collections. Change
+// streams are only available on replica sets and sharded clusters.
+type ChangeFeedRepository struct {
+	db *mongo.Database
+	// resumeToken is the token of the last change seen, so that the stream
+	// can be resumed without missing changes when Listen is called again.
+	resumeToken bson.Raw
+}
+
+type changeModel struct {
+	OperationType string `bson:"operationType"`
+	Namespace     struct {
+		Collection string `bson:"coll"`
+	} `bson:"ns"`
+	DocumentKey struct {
+		ID primitive.ObjectID `bson:"_id"`
+	} `bson:"documentKey"`
+	FullDocument *struct {
+		Key       string              `bson:"key"`
+		ProjectID *primitive.ObjectID `bson:"projectId,omitempty"`
+	} `bson:"fullDocument"`
+}
+
+// Listen calls fn for every change until the context is cancelled or
+// the feed fails. The returned error is nil only if the context was cancelled.
+// Listen must not be called concurrently.
+func (r *ChangeFeedRepository) Listen(ctx context.Context, fn func(*flaggio.ChangeEvent)) error {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"ns.coll":       bson.M{"$in": []string{flagsCollection, segmentsCollection}},
+			"operationType": bson.M{"$in": []string{"insert", "update", "replace", "delete"}},
+		}}},
+	}
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if r.resumeToken != nil {
+		opts.SetResumeAfter(r.resumeToken)
+	}
+	stream, err := r.db.Watch(ctx, pipeline, opts)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		// the token may no longer be in the oplog, start over next time
+		r.resumeToken = nil
+		return err
+	}
+	defer stream.Close(context.Background())
+
+	for stream.Next(ctx) {
+		var change changeModel
+		if err := stream.Decode(&change); err != nil {
+			return err
+		}
+		r.resumeToken = stream.ResumeToken()
+		fn(change.asChangeEvent())
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	return stream.Err()
+}
+
+func (c changeModel) asChangeEvent() *flaggio.ChangeEvent {
+	evt := &flaggio.ChangeEvent{
+		Type: flaggio.ChangeTypeFlag,
+		ID:   c.DocumentKey.ID.Hex(),
+	}
+	if c.Namespace.Collection == segmentsCollection {
+		evt.Type = flaggio.ChangeTypeSegment
+	}
+	// the document is not available if it was deleted
+	if c.FullDocument != nil {
+		if evt.Type == flaggio.ChangeTypeFlag {
+			evt.Key = c.FullDocument.Key
+		}
+		if c.FullDocument.ProjectID != nil {
+			projectID := c.FullDocument.ProjectID.Hex()
+			evt.ProjectID = &projectID
+		}
+	}
+	return evt
+}
+
+// NewChangeFeedRepository returns a new change feed that uses mongodb
+// change streams to receive changes.
+func NewChangeFeedRepository(db *mongo.Database) repository.ChangeFeed {
+	return &ChangeFeedRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,205 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const environmentsCollection = "environments"
+
+var _ repository.Environment = (*EnvironmentRepository)(nil)
+
+// EnvironmentRepository implements repository.Environment interface using mongodb.
+type EnvironmentRepository struct {
+	db  *mongo.Database
+	col *mongo.Collection
+}
+
+// FindAll returns a list of environments.
+func (r *EnvironmentRepository) FindAll(ctx context.Context) ([]*flaggio.Environment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoEnvironmentRepository.FindAll")
+	defer span.Finish()
+
+	cursor, err := r.col.Find(ctx, bson.M{}, &options.FindOptions{
+		Sort:      bson.M{"key": 1},
+		Collation: &options.Collation{Locale: "en"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	environments := []*flaggio.Environment{}
+	for cursor.Next(ctx) {
+		var e environmentModel
+		// decode the document
+		if err := cursor.Decode(&e); err != nil {
+			return nil, err
+		}
+		environments = append(environments, e.asEnvironment())
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return environments, nil
+}
+
+// FindByID returns an environment that has a given ID.
+func (r *EnvironmentRepository) FindByID(ctx context.Context, idHex string) (*flaggio.Environment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoEnvironmentRepository.FindByID")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return nil, err
+	}
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// FindByKey returns an environment that has a given key.
+func (r *EnvironmentRepository) FindByKey(ctx context.Context, key string) (*flaggio.Environment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoEnvironmentRepository.FindByKey")
+	defer span.Finish()
+
+	return r.findOne(ctx, bson.M{"key": key})
+}
+
+func (r *EnvironmentRepository) findOne(ctx context.Context, filter bson.M) (*flaggio.Environment, error) {
+	var e environmentModel
+	if err := r.col.FindOne(ctx, filter).Decode(&e); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.NotFound("environment")
+		}
+		return nil, err
+	}
+	return e.asEnvironment(), nil
+}
+
+// Create creates a new environment.
+func (r *EnvironmentRepository) Create(ctx context.Context, e flaggio.NewEnvironment) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoEnvironmentRepository.Create")
+	defer span.Finish()
+
+	id := primitive.NewObjectID()
+	_, err := r.col.InsertOne(ctx, &environmentModel{
+		ID:          id,
+		CreatedAt:   time.Now(),
+		Key:         e.Key,
+		Name:        e.Name,
+		Description: e.Description,
+	})
+	if err != nil {
+		return "", err
+	}
+	return id.Hex(), nil
+}
+
+// Update updates an environment.
+func (r *EnvironmentRepository) Update(ctx context.Context, idHex string, e flaggio.UpdateEnvironment) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoEnvironmentRepository.Update")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	mods := bson.M{
+		"updatedAt": time.Now(),
+	}
+	if e.Key != nil {
+		mods["key"] = *e.Key
+	}
+	if e.Name != nil {
+		mods["name"] = *e.Name
+	}
+	if e.Description != nil {
+		mods["description"] = *e.Description
+	}
+	res, err := r.col.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": mods})
+	if err != nil {
+		return err
+	}
+	if res.ModifiedCount == 0 {
+		return errors.NotFound("environment")
+	}
+	return nil
+}
+
+// Delete deletes an environment, along with the flag settings for it.
+func (r *EnvironmentRepository) Delete(ctx context.Context, idHex string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoEnvironmentRepository.Delete")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	res, err := r.col.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return errors.NotFound("environment")
+	}
+	// remove the environment settings from all flags, one by one
+	// so that a new version is saved for each of them
+	cursor, err := r.db.Collection(flagsCollection).Find(
+		ctx,
+		bson.M{"environments.environmentId": id},
+		options.Find().SetProjection(bson.M{"_id": 1}),
+	)
+	if err != nil {
+		return err
+	}
+	var flags []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &flags); err != nil {
+		return err
+	}
+	for _, f := range flags {
+		_, err := updateFlag(
+			ctx,
+			r.db,
+			bson.M{"_id": f.ID, "environments.environmentId": id},
+			bson.M{
+				"$pull": bson.M{"environments": bson.M{"environmentId": id}},
+				"$set":  bson.M{"updatedAt": time.Now()},
+				"$inc":  bson.M{"version": 1},
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewEnvironmentRepository returns a new environment repository that uses mongodb as underlying storage.
+// It also creates all needed indexes, if they don't yet exist.
+func NewEnvironmentRepository(ctx context.Context, db *mongo.Database) (repository.Environment, error) {
+	col := db.Collection(environmentsCollection)
+	_, err := col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.M{"key": 1},
+			Options: options.Index().SetUnique(true).SetBackground(false),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &EnvironmentRepository{
+		db:  db,
+		col: col,
+	}, nil
+}
@@ -0,0 +1,249 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const evaluationCountsCollection = "evaluation_counts"
+
+// evaluationCountBucket is the time span of each count. Counts are kept
+// per hour, so that writes stay cheap no matter how many evaluations
+// happen, while still being fine grained enough to see trends.
+const evaluationCountBucket = time.Hour
+
+var _ repository.EvaluationCount = (*EvaluationCountRepository)(nil)
+
+// EvaluationCountRepository implements repository.EvaluationCount interface using mongodb.
+type EvaluationCountRepository struct {
+	col *mongo.Collection
+}
+
+// Write adds the evaluation events to the counts of the time buckets they belong to.
+// Events are aggregated before being written, so each count is updated only once.
+func (r *EvaluationCountRepository) Write(ctx context.Context, evts []*flaggio.EvaluationEvent) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoEvaluationCountRepository.Write")
+	defer span.Finish()
+
+	if len(evts) == 0 {
+		return nil
+	}
+	counts := make(map[evaluationCountKey]*evaluationCountModel)
+	var keys []evaluationCountKey
+	for _, evt := range evts {
+		key := evaluationCountKey{
+			FlagKey:     evt.FlagKey,
+			Project:     evt.Project,
+			Environment: evt.Environment,
+			VariantID:   evt.VariantID,
+			Reason:      string(evt.Reason),
+			Bucket:      evt.Timestamp.UTC().Truncate(evaluationCountBucket),
+		}
+		count, ok := counts[key]
+		if !ok {
+			count = &evaluationCountModel{evaluationCountKey: key}
+			counts[key] = count
+			keys = append(keys, key)
+		}
+		count.Count++
+		if evt.Timestamp.After(count.LastEvaluatedAt) {
+			count.LastEvaluatedAt = evt.Timestamp
+		}
+	}
+
+	models := make([]mongo.WriteModel, len(keys))
+	for idx, key := range keys {
+		count := counts[key]
+		models[idx] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{
+				"flagKey":     key.FlagKey,
+				"project":     key.Project,
+				"environment": key.Environment,
+				"variantId":   key.VariantID,
+				"reason":      key.Reason,
+				"bucket":      key.Bucket,
+			}).
+			SetUpdate(bson.M{
+				"$inc": bson.M{"count": count.Count},
+				"$max": bson.M{"lastEvaluatedAt": count.LastEvaluatedAt},
+			}).
+			SetUpsert(true)
+	}
+	_, err := r.col.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// FindUsage returns the number of evaluations of a flag that match the filter, by
+// period of time and variant, oldest first. Periods without evaluations are omitted.
+func (r *EvaluationCountRepository) FindUsage(ctx context.Context, f flaggio.UsageFilter) ([]*flaggio.FlagUsage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoEvaluationCountRepository.FindUsage")
+	defer span.Finish()
+
+	filter := bson.M{
+		"flagKey": f.FlagKey,
+		"project": projectKey(f.Project),
+		"bucket":  bson.M{"$gte": f.From, "$lt": f.To},
+	}
+	if f.Environment != nil {
+		filter["environment"] = *f.Environment
+	}
+	// counts are summed by hour and variant in the database,
+	// then by the requested granularity
+	cursor, err := r.col.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"bucket": "$bucket", "variantId": "$variantId"},
+			"count": bson.M{"$sum": "$count"},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id.bucket", Value: 1}, {Key: "_id.variantId", Value: 1}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usage := []*flaggio.FlagUsage{}
+	var current *flaggio.FlagUsage
+	variants := make(map[string]*flaggio.VariantUsage)
+	for cursor.Next(ctx) {
+		var c struct {
+			ID struct {
+				Bucket    time.Time `bson:"bucket"`
+				VariantID string    `bson:"variantId"`
+			} `bson:"_id"`
+			Count int `bson:"count"`
+		}
+		// decode the document
+		if err := cursor.Decode(&c); err != nil {
+			return nil, err
+		}
+		t := f.Granularity.Truncate(c.ID.Bucket)
+		if current == nil || !current.Time.Equal(t) {
+			current = &flaggio.FlagUsage{Time: t, Variants: []*flaggio.VariantUsage{}}
+			usage = append(usage, current)
+			variants = make(map[string]*flaggio.VariantUsage)
+		}
+		vu, ok := variants[c.ID.VariantID]
+		if !ok {
+			vu = &flaggio.VariantUsage{}
+			if c.ID.VariantID != "" {
+				vu.VariantID = &c.ID.VariantID
+			}
+			variants[c.ID.VariantID] = vu
+			current.Variants = append(current.Variants, vu)
+		}
+		vu.Count += c.Count
+		current.Total += c.Count
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// FindLastEvaluatedAt returns when a flag in a project was last evaluated, or nil
+// if it was never evaluated. If no project is given, only flags that don't belong
+// to a project are considered.
+func (r *EvaluationCountRepository) FindLastEvaluatedAt(ctx context.Context, project *string, flagKey string) (*time.Time, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoEvaluationCountRepository.FindLastEvaluatedAt")
+	defer span.Finish()
+
+	var c evaluationCountModel
+	err := r.col.FindOne(ctx,
+		bson.M{"flagKey": flagKey, "project": projectKey(project)},
+		options.FindOne().SetSort(bson.M{"lastEvaluatedAt": -1}),
+	).Decode(&c)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &c.LastEvaluatedAt, nil
+}
+
+// FindAllLastEvaluatedAt returns when each flag in a project was last evaluated, by
+// flag key. Flags that were never evaluated are not included. If no project is given,
+// only flags that don't belong to a project are considered.
+func (r *EvaluationCountRepository) FindAllLastEvaluatedAt(ctx context.Context, project *string) (map[string]time.Time, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoEvaluationCountRepository.FindAllLastEvaluatedAt")
+	defer span.Finish()
+
+	cursor, err := r.col.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"project": projectKey(project)}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":             "$flagKey",
+			"lastEvaluatedAt": bson.M{"$max": "$lastEvaluatedAt"},
+		}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lastEvaluatedAt := make(map[string]time.Time)
+	for cursor.Next(ctx) {
+		var c struct {
+			FlagKey         string    `bson:"_id"`
+			LastEvaluatedAt time.Time `bson:"lastEvaluatedAt"`
+		}
+		// decode the document
+		if err := cursor.Decode(&c); err != nil {
+			return nil, err
+		}
+		lastEvaluatedAt[c.FlagKey] = c.LastEvaluatedAt
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return lastEvaluatedAt, nil
+}
+
+// NewEvaluationCountRepository returns a new evaluation count repository that uses mongodb
+// as underlying storage. It also creates all needed indexes, if they don't yet exist.
+func NewEvaluationCountRepository(ctx context.Context, db *mongo.Database) (repository.EvaluationCount, error) {
+	col := db.Collection(evaluationCountsCollection)
+	_, err := col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "flagKey", Value: 1},
+				{Key: "project", Value: 1},
+				{Key: "environment", Value: 1},
+				{Key: "bucket", Value: 1},
+				{Key: "variantId", Value: 1},
+				{Key: "reason", Value: 1},
+			},
+			Options: options.Index().SetUnique(true).SetBackground(false),
+		},
+		{
+			Keys: bson.D{{Key: "project", Value: 1}, {Key: "flagKey", Value: 1}, {Key: "lastEvaluatedAt", Value: -1}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &EvaluationCountRepository{
+		col: col,
+	}, nil
+}
+
+// projectKey returns the key under which the evaluations of flags in a
+// project are counted. Flags that don't belong to a project are counted
+// under an empty key.
+func projectKey(project *string) string {
+	if project == nil {
+		return ""
+	}
+	return *project
+}
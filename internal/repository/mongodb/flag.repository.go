@@ -2,6 +2,7 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"time"
 
@@ -15,6 +16,8 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+const flagsCollection = "flags"
+
 var _ repository.Flag = (*FlagRepository)(nil)
 
 // FlagRepository implements repository.Flag interface using mongodb.
@@ -23,12 +26,17 @@ type FlagRepository struct {
 	col *mongo.Collection
 }
 
-// FindAll returns a list of flags, based on an optional offset and limit.
-func (r *FlagRepository) FindAll(ctx context.Context, search *string, offset, limit *int64) (*flaggio.FlagResults, error) {
+// FindAll returns a list of flags in a project, based on an optional offset and limit.
+// If no project ID is given, only flags that don't belong to a project are returned.
+func (r *FlagRepository) FindAll(ctx context.Context, projectIDHex, search *string, offset, limit *int64) (*flaggio.FlagResults, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagRepository.FindAll")
 	defer span.Finish()
 
-	filter := bson.M{}
+	projectID, err := projectObjectID(projectIDHex)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"projectId": projectID}
 	if search != nil {
 		filter["$or"] = []bson.M{
 			{"key": primitive.Regex{Pattern: regexp.QuoteMeta(*search), Options: "i"}},
@@ -92,13 +100,18 @@ func (r *FlagRepository) FindByID(ctx context.Context, idHex string) (*flaggio.F
 	return f.asFlag(), nil
 }
 
-// FindByKey returns a flag that has a given key.
-func (r *FlagRepository) FindByKey(ctx context.Context, key string) (*flaggio.Flag, error) {
+// FindByKey returns a flag in a project that has a given key.
+// If no project ID is given, only flags that don't belong to a project are considered.
+func (r *FlagRepository) FindByKey(ctx context.Context, projectIDHex *string, key string) (*flaggio.Flag, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagRepository.FindByKey")
 	defer span.Finish()
 
+	projectID, err := projectObjectID(projectIDHex)
+	if err != nil {
+		return nil, err
+	}
 	// filter for the flag key
-	filter := bson.M{"key": key}
+	filter := bson.M{"projectId": projectID, "key": key}
 
 	var f flagModel
 	if err := r.col.FindOne(ctx, filter).Decode(&f); err != nil {
@@ -110,26 +123,75 @@ func (r *FlagRepository) FindByKey(ctx context.Context, key string) (*flaggio.Fl
 	return f.asFlag(), nil
 }
 
+// FindByKeys returns the flags in a project that have one of the given keys, in the
+// order of the keys. Keys that don't match any flag are ignored.
+// If no project ID is given, only flags that don't belong to a project are considered.
+func (r *FlagRepository) FindByKeys(ctx context.Context, projectIDHex *string, keys []string) ([]*flaggio.Flag, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagRepository.FindByKeys")
+	defer span.Finish()
+
+	projectID, err := projectObjectID(projectIDHex)
+	if err != nil {
+		return nil, err
+	}
+	// filter for the flag keys
+	filter := bson.M{"projectId": projectID, "key": bson.M{"$in": keys}}
+	cursor, err := r.col.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	flagsByKey := make(map[string]*flaggio.Flag, len(keys))
+	for cursor.Next(ctx) {
+		var f flagModel
+		// decode the document
+		if err := cursor.Decode(&f); err != nil {
+			return nil, err
+		}
+		flagsByKey[f.Key] = f.asFlag()
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return flagsInKeyOrder(flagsByKey, keys), nil
+}
+
 // Create creates a new flag.
 func (r *FlagRepository) Create(ctx context.Context, f flaggio.NewFlag) (string, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagRepository.Create")
 	defer span.Finish()
 
-	id := primitive.NewObjectID()
-	_, err := r.col.InsertOne(ctx, &flagModel{
-		ID:          id,
-		CreatedAt:   time.Now(),
-		Key:         f.Key,
-		Name:        f.Name,
-		Description: f.Description,
-		Enabled:     false,
-		Version:     1,
-		Variants:    []variantModel{},
-		Rules:       []flagRuleModel{},
-	})
+	projectID, err := projectObjectID(f.ProjectID)
 	if err != nil {
 		return "", err
 	}
+	if err := ensureProject(ctx, r.db, projectID); err != nil {
+		return "", err
+	}
+	id := primitive.NewObjectID()
+	flg := &flagModel{
+		ID:            id,
+		ProjectID:     projectID,
+		CreatedAt:     time.Now(),
+		Key:           f.Key,
+		Name:          f.Name,
+		Description:   f.Description,
+		Enabled:       false,
+		Version:       1,
+		Variants:      []variantModel{},
+		Prerequisites: []prerequisiteModel{},
+		Rules:         []flagRuleModel{},
+		Environments:  []flagEnvironmentModel{},
+	}
+	if _, err := r.col.InsertOne(ctx, flg); err != nil {
+		return "", err
+	}
+	if err := saveFlagVersion(ctx, r.db, flg); err != nil {
+		return "", err
+	}
 	return id.Hex(), nil
 }
 
@@ -171,6 +233,13 @@ func (r *FlagRepository) Update(ctx context.Context, idHex string, f flaggio.Upd
 		}
 		mods["defaultVariantWhenOff"] = oid
 	}
+	if f.Prerequisites != nil {
+		prereqs, err := r.prerequisites(ctx, id, f.Prerequisites)
+		if err != nil {
+			return err
+		}
+		mods["prerequisites"] = prereqs
+	}
 	if len(mods) == 0 {
 		return errors.BadRequest("nothing to update")
 	}
@@ -179,17 +248,89 @@ func (r *FlagRepository) Update(ctx context.Context, idHex string, f flaggio.Upd
 		"$set": mods,
 		"$inc": bson.M{"version": 1},
 	}
-	res, err := r.col.UpdateOne(ctx, filter, update)
+	found, err := updateFlag(ctx, r.db, filter, update)
 	if err != nil {
 		return err
 	}
-	if res.ModifiedCount == 0 {
+	if !found {
 		return errors.NotFound("flag")
 	}
 	return nil
 }
 
-// Delete deletes a flag.
+// UpdateEnvironment updates the settings of a flag for an environment.
+func (r *FlagRepository) UpdateEnvironment(ctx context.Context, idHex, environmentIDHex string, f flaggio.UpdateFlagEnvironment) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagRepository.UpdateEnvironment")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	envID, err := primitive.ObjectIDFromHex(environmentIDHex)
+	if err != nil {
+		return err
+	}
+	mods := bson.M{
+		"updatedAt": time.Now(),
+	}
+	if f.Enabled != nil {
+		mods["environments.$.enabled"] = *f.Enabled
+	}
+	if f.DefaultVariantWhenOn != nil {
+		oid, err := primitive.ObjectIDFromHex(*f.DefaultVariantWhenOn)
+		if err != nil {
+			return err
+		}
+		mods["environments.$.defaultVariantWhenOn"] = oid
+	}
+	if f.DefaultVariantWhenOff != nil {
+		oid, err := primitive.ObjectIDFromHex(*f.DefaultVariantWhenOff)
+		if err != nil {
+			return err
+		}
+		mods["environments.$.defaultVariantWhenOff"] = oid
+	}
+	if err := r.ensureEnvironment(ctx, id, envID); err != nil {
+		return err
+	}
+	filter := bson.M{"_id": id, "environments.environmentId": envID}
+	update := bson.M{
+		"$set": mods,
+		"$inc": bson.M{"version": 1},
+	}
+	found, err := updateFlag(ctx, r.db, filter, update)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.NotFound("flag")
+	}
+	return nil
+}
+
+// ensureEnvironment makes sure the flag has settings for the environment,
+// adding them if needed. New environment settings have the flag disabled.
+func (r *FlagRepository) ensureEnvironment(ctx context.Context, id, envID primitive.ObjectID) error {
+	count, err := r.db.Collection(environmentsCollection).CountDocuments(ctx, bson.M{"_id": envID})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.NotFound("environment")
+	}
+	_, err = r.col.UpdateOne(
+		ctx,
+		bson.M{"_id": id, "environments.environmentId": bson.M{"$ne": envID}},
+		bson.M{"$push": bson.M{"environments": &flagEnvironmentModel{
+			EnvironmentID: envID,
+			Rules:         []flagRuleModel{},
+		}}},
+	)
+	return err
+}
+
+// Delete deletes a flag, along with its versions.
 func (r *FlagRepository) Delete(ctx context.Context, idHex string) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagRepository.Delete")
 	defer span.Finish()
@@ -205,16 +346,176 @@ func (r *FlagRepository) Delete(ctx context.Context, idHex string) error {
 	if res.DeletedCount == 0 {
 		return errors.NotFound("flag")
 	}
+	// remove all users targeted by the flag
+	if _, err := r.db.Collection(flagTargetsCollection).DeleteMany(ctx, bson.M{"flagId": id}); err != nil {
+		return err
+	}
+	_, err = r.db.Collection(flagVersionsCollection).DeleteMany(ctx, bson.M{"flagId": id})
+	return err
+}
+
+// Rollback restores the content of a flag from one of its versions, creating
+// a new version. The flag key and project are not restored.
+func (r *FlagRepository) Rollback(ctx context.Context, idHex string, version int) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagRepository.Rollback")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	v, err := findFlagVersion(ctx, r.db, id, version)
+	if err != nil {
+		return err
+	}
+	old := v.Flag
+	found, err := updateFlag(ctx, r.db, bson.M{"_id": id}, bson.M{
+		"$set": bson.M{
+			"name":                  old.Name,
+			"description":           old.Description,
+			"enabled":               old.Enabled,
+			"variants":              old.Variants,
+			"prerequisites":         old.Prerequisites,
+			"rules":                 old.Rules,
+			"defaultVariantWhenOn":  old.DefaultVariantWhenOn,
+			"defaultVariantWhenOff": old.DefaultVariantWhenOff,
+			"environments":          old.Environments,
+			"updatedAt":             time.Now(),
+		},
+		"$inc": bson.M{"version": 1},
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.NotFound("flag")
+	}
 	return nil
 }
 
+// prerequisites converts the list of prerequisites into models. It makes sure that all
+// referenced flags and variants exist in the same project as the flag and that the
+// prerequisites don't form a cycle.
+func (r *FlagRepository) prerequisites(ctx context.Context, flagID primitive.ObjectID, input []*flaggio.NewFlagPrerequisite) ([]prerequisiteModel, error) {
+	prereqs := make([]prerequisiteModel, len(input))
+	for idx, p := range input {
+		prereqFlagID, err := primitive.ObjectIDFromHex(p.FlagID)
+		if err != nil {
+			return nil, errors.BadRequest(fmt.Sprintf("invalid flag ID for prerequisite[%d]", idx))
+		}
+		variantID, err := primitive.ObjectIDFromHex(p.VariantID)
+		if err != nil {
+			return nil, errors.BadRequest(fmt.Sprintf("invalid variant ID for prerequisite[%d]", idx))
+		}
+		prereqs[idx] = prerequisiteModel{
+			FlagID:    prereqFlagID,
+			VariantID: variantID,
+		}
+	}
+
+	// fetch the prerequisites of all flags
+	cursor, err := r.col.Find(ctx, bson.M{}, options.Find().SetProjection(bson.M{
+		"projectId":     1,
+		"variants._id":  1,
+		"prerequisites": 1,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	graph := make(map[primitive.ObjectID][]prerequisiteModel)
+	projects := make(map[primitive.ObjectID]string)
+	variants := make(map[primitive.ObjectID]map[primitive.ObjectID]bool)
+	for cursor.Next(ctx) {
+		var f flagModel
+		// decode the document
+		if err := cursor.Decode(&f); err != nil {
+			return nil, err
+		}
+		graph[f.ID] = f.Prerequisites
+		if f.ProjectID != nil {
+			projects[f.ID] = f.ProjectID.Hex()
+		}
+		variants[f.ID] = make(map[primitive.ObjectID]bool, len(f.Variants))
+		for _, vrnt := range f.Variants {
+			variants[f.ID][vrnt.ID] = true
+		}
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	for idx, p := range prereqs {
+		vrnts, ok := variants[p.FlagID]
+		if !ok || projects[p.FlagID] != projects[flagID] {
+			return nil, errors.BadRequest(fmt.Sprintf("flag not found for prerequisite[%d]", idx))
+		}
+		if !vrnts[p.VariantID] {
+			return nil, errors.BadRequest(fmt.Sprintf("variant not found for prerequisite[%d]", idx))
+		}
+	}
+
+	// check for cycles, considering the new prerequisites for the flag
+	graph[flagID] = prereqs
+	visiting := make(map[primitive.ObjectID]bool)
+	visited := make(map[primitive.ObjectID]bool)
+	var hasCycle func(id primitive.ObjectID) bool
+	hasCycle = func(id primitive.ObjectID) bool {
+		if visiting[id] {
+			return true
+		}
+		if visited[id] {
+			return false
+		}
+		visiting[id] = true
+		for _, p := range graph[id] {
+			if hasCycle(p.FlagID) {
+				return true
+			}
+		}
+		visiting[id] = false
+		visited[id] = true
+		return false
+	}
+	if hasCycle(flagID) {
+		return nil, errors.ErrPrerequisiteCycle
+	}
+
+	return prereqs, nil
+}
+
+// flagsInKeyOrder returns the flags with the given keys, in the order of
+// the keys. Repeated keys and keys that have no flag are skipped.
+func flagsInKeyOrder(flagsByKey map[string]*flaggio.Flag, keys []string) []*flaggio.Flag {
+	flgs := make([]*flaggio.Flag, 0, len(flagsByKey))
+	for _, key := range keys {
+		if flg, ok := flagsByKey[key]; ok {
+			flgs = append(flgs, flg)
+			delete(flagsByKey, key)
+		}
+	}
+	return flgs
+}
+
+// isIndexNotFound checks if the error was caused by dropping an index
+// or collection that doesn't exist.
+func isIndexNotFound(err error) bool {
+	cmdErr, ok := err.(mongo.CommandError)
+	return ok && (cmdErr.Code == 26 || cmdErr.Code == 27)
+}
+
 // NewFlagRepository returns a new flag repository that uses mongodb as underlying storage.
 // It also creates all needed indexes, if they don't yet exist.
 func NewFlagRepository(ctx context.Context, db *mongo.Database) (repository.Flag, error) {
-	col := db.Collection("flags")
+	col := db.Collection(flagsCollection)
+	// flag keys used to be unique globally, now they are unique per project
+	if _, err := col.Indexes().DropOne(ctx, "key_1"); err != nil && !isIndexNotFound(err) {
+		return nil, err
+	}
 	_, err := col.Indexes().CreateMany(ctx, []mongo.IndexModel{
 		{
-			Keys:    bson.M{"key": 1},
+			Keys:    bson.D{{Key: "projectId", Value: 1}, {Key: "key", Value: 1}},
 			Options: options.Index().SetUnique(true).SetBackground(false),
 		},
 		{
@@ -0,0 +1,138 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const flagVersionsCollection = "flag_versions"
+
+var _ repository.FlagVersion = (*FlagVersionRepository)(nil)
+
+// FlagVersionRepository implements repository.FlagVersion interface using mongodb.
+type FlagVersionRepository struct {
+	col *mongo.Collection
+}
+
+// FindAll returns the versions of a flag, newest first, based on an optional
+// offset and limit.
+func (r *FlagVersionRepository) FindAll(ctx context.Context, flagIDHex string, offset, limit *int64) ([]*flaggio.FlagVersion, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagVersionRepository.FindAll")
+	defer span.Finish()
+
+	flagID, err := primitive.ObjectIDFromHex(flagIDHex)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := r.col.Find(ctx, bson.M{"flagId": flagID}, &options.FindOptions{
+		Skip:  offset,
+		Limit: limit,
+		Sort:  bson.M{"version": -1},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []*flaggio.FlagVersion{}
+	for cursor.Next(ctx) {
+		var v flagVersionModel
+		// decode the document
+		if err := cursor.Decode(&v); err != nil {
+			return nil, err
+		}
+		versions = append(versions, v.asFlagVersion())
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// FindByVersion returns a version of a flag.
+func (r *FlagVersionRepository) FindByVersion(ctx context.Context, flagIDHex string, version int) (*flaggio.FlagVersion, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoFlagVersionRepository.FindByVersion")
+	defer span.Finish()
+
+	flagID, err := primitive.ObjectIDFromHex(flagIDHex)
+	if err != nil {
+		return nil, err
+	}
+	v, err := findFlagVersion(ctx, r.col.Database(), flagID, version)
+	if err != nil {
+		return nil, err
+	}
+	return v.asFlagVersion(), nil
+}
+
+func findFlagVersion(ctx context.Context, db *mongo.Database, flagID primitive.ObjectID, version int) (*flagVersionModel, error) {
+	var v flagVersionModel
+	filter := bson.M{"flagId": flagID, "version": version}
+	if err := db.Collection(flagVersionsCollection).FindOne(ctx, filter).Decode(&v); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.NotFound("flag version")
+		}
+		return nil, err
+	}
+	return &v, nil
+}
+
+// updateFlag applies an update to the flag that matches the filter and saves
+// the updated flag as a new version. The update must increment the flag version.
+// It returns false if no flag matched the filter.
+func updateFlag(ctx context.Context, db *mongo.Database, filter, update bson.M,
+	opts ...*options.FindOneAndUpdateOptions) (bool, error) {
+	opts = append([]*options.FindOneAndUpdateOptions{
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	}, opts...)
+	var f flagModel
+	err := db.Collection(flagsCollection).FindOneAndUpdate(ctx, filter, update, opts...).Decode(&f)
+	if err == mongo.ErrNoDocuments {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, saveFlagVersion(ctx, db, &f)
+}
+
+// saveFlagVersion saves an immutable copy of the flag, as it is in its
+// current version.
+func saveFlagVersion(ctx context.Context, db *mongo.Database, f *flagModel) error {
+	_, err := db.Collection(flagVersionsCollection).InsertOne(ctx, &flagVersionModel{
+		ID:        primitive.NewObjectID(),
+		FlagID:    f.ID,
+		Version:   f.Version,
+		Flag:      *f,
+		CreatedAt: time.Now(),
+	})
+	return err
+}
+
+// NewFlagVersionRepository returns a new flag version repository that uses mongodb as
+// underlying storage. It also creates all needed indexes, if they don't yet exist.
+func NewFlagVersionRepository(ctx context.Context, db *mongo.Database) (repository.FlagVersion, error) {
+	col := db.Collection(flagVersionsCollection)
+	_, err := col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "flagId", Value: 1}, {Key: "version", Value: -1}},
+			Options: options.Index().SetUnique(true).SetBackground(false),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &FlagVersionRepository{
+		col: col,
+	}, nil
+}
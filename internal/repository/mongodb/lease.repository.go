@@ -0,0 +1,84 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	leasesCollection = "leases"
+	// duplicateKeyCode is the code of the error returned by mongodb when
+	// a document violates a unique index
+	duplicateKeyCode = 11000
+)
+
+var _ repository.Lease = (*LeaseRepository)(nil)
+
+// LeaseRepository implements repository.Lease interface using mongodb.
+type LeaseRepository struct {
+	col *mongo.Collection
+}
+
+// Acquire acquires or renews the lease with a given name for a holder,
+// until ttl has passed. It returns false if the lease is held by another
+// holder.
+func (r *LeaseRepository) Acquire(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoLeaseRepository.Acquire")
+	defer span.Finish()
+
+	now := time.Now()
+	// the lease only matches if it's held by the same holder or expired.
+	// Otherwise, the upsert tries to insert a new lease with the same
+	// name, which fails with a duplicate key error
+	filter := bson.M{
+		"_id": name,
+		"$or": []bson.M{
+			{"holder": holder},
+			{"expiresAt": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"holder": holder, "expiresAt": now.Add(ttl)}}
+	_, err := r.col.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		if isDuplicateKey(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Release releases the lease with a given name, if it's held by holder.
+func (r *LeaseRepository) Release(ctx context.Context, name, holder string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoLeaseRepository.Release")
+	defer span.Finish()
+
+	_, err := r.col.DeleteOne(ctx, bson.M{"_id": name, "holder": holder})
+	return err
+}
+
+func isDuplicateKey(err error) bool {
+	we, ok := err.(mongo.WriteException)
+	if !ok {
+		return false
+	}
+	for _, e := range we.WriteErrors {
+		if e.Code == duplicateKeyCode {
+			return true
+		}
+	}
+	return false
+}
+
+// NewLeaseRepository returns a new lease repository that uses mongodb as underlying storage.
+func NewLeaseRepository(db *mongo.Database) repository.Lease {
+	return &LeaseRepository{
+		col: db.Collection(leasesCollection),
+	}
+}
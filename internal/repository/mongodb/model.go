@@ -1,6 +1,8 @@
 package mongodb
 
 import (
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/victorkt/flaggio/internal/flaggio"
@@ -8,18 +10,21 @@ import (
 )
 
 type flagModel struct {
-	ID                    primitive.ObjectID `bson:"_id"`
-	Key                   string             `bson:"key"`
-	Name                  string             `bson:"name"`
-	Description           *string            `bson:"description"`
-	Enabled               bool               `bson:"enabled"`
-	Version               int                `bson:"version"`
-	Variants              []variantModel     `bson:"variants"`
-	Rules                 []flagRuleModel    `bson:"rules"`
-	DefaultVariantWhenOn  primitive.ObjectID `bson:"defaultVariantWhenOn"`
-	DefaultVariantWhenOff primitive.ObjectID `bson:"defaultVariantWhenOff"`
-	CreatedAt             time.Time          `bson:"createdAt"`
-	UpdatedAt             *time.Time         `bson:"updatedAt"`
+	ID                    primitive.ObjectID     `bson:"_id"`
+	ProjectID             *primitive.ObjectID    `bson:"projectId,omitempty"`
+	Key                   string                 `bson:"key"`
+	Name                  string                 `bson:"name"`
+	Description           *string                `bson:"description"`
+	Enabled               bool                   `bson:"enabled"`
+	Version               int                    `bson:"version"`
+	Variants              []variantModel         `bson:"variants"`
+	Prerequisites         []prerequisiteModel    `bson:"prerequisites"`
+	Rules                 []flagRuleModel        `bson:"rules"`
+	DefaultVariantWhenOn  primitive.ObjectID     `bson:"defaultVariantWhenOn"`
+	DefaultVariantWhenOff primitive.ObjectID     `bson:"defaultVariantWhenOff"`
+	Environments          []flagEnvironmentModel `bson:"environments"`
+	CreatedAt             time.Time              `bson:"createdAt"`
+	UpdatedAt             *time.Time             `bson:"updatedAt"`
 }
 
 func (f *flagModel) asFlag() *flaggio.Flag {
@@ -30,21 +35,32 @@ func (f *flagModel) asFlag() *flaggio.Flag {
 		variants[idx] = vrnt
 		variantsMap[vrnt.ID] = vrnt
 	}
+	prerequisites := make([]*flaggio.FlagPrerequisite, len(f.Prerequisites))
+	for idx, prereq := range f.Prerequisites {
+		prerequisites[idx] = prereq.asPrerequisite()
+	}
 	rules := make([]*flaggio.FlagRule, len(f.Rules))
 	for idx, rl := range f.Rules {
 		rules[idx] = rl.asRule(variantsMap)
 	}
+	environments := make([]*flaggio.FlagEnvironment, len(f.Environments))
+	for idx, env := range f.Environments {
+		environments[idx] = env.asFlagEnvironment(variantsMap)
+	}
 	return &flaggio.Flag{
 		ID:                    f.ID.Hex(),
+		ProjectID:             hexOrNil(f.ProjectID),
 		Key:                   f.Key,
 		Name:                  f.Name,
 		Description:           f.Description,
 		Enabled:               f.Enabled,
 		Version:               f.Version,
 		Variants:              variants,
+		Prerequisites:         prerequisites,
 		Rules:                 rules,
 		DefaultVariantWhenOn:  variantsMap[f.DefaultVariantWhenOn.Hex()],
 		DefaultVariantWhenOff: variantsMap[f.DefaultVariantWhenOff.Hex()],
+		Environments:          environments,
 		CreatedAt:             f.CreatedAt,
 		UpdatedAt:             f.UpdatedAt,
 	}
@@ -64,10 +80,45 @@ func (v variantModel) asVariant() *flaggio.Variant {
 	}
 }
 
+type prerequisiteModel struct {
+	FlagID    primitive.ObjectID `bson:"flagId"`
+	VariantID primitive.ObjectID `bson:"variantId"`
+}
+
+func (p prerequisiteModel) asPrerequisite() *flaggio.FlagPrerequisite {
+	return &flaggio.FlagPrerequisite{
+		FlagID:    p.FlagID.Hex(),
+		VariantID: p.VariantID.Hex(),
+	}
+}
+
+type flagEnvironmentModel struct {
+	EnvironmentID         primitive.ObjectID `bson:"environmentId"`
+	Enabled               bool               `bson:"enabled"`
+	Rules                 []flagRuleModel    `bson:"rules"`
+	DefaultVariantWhenOn  primitive.ObjectID `bson:"defaultVariantWhenOn"`
+	DefaultVariantWhenOff primitive.ObjectID `bson:"defaultVariantWhenOff"`
+}
+
+func (e flagEnvironmentModel) asFlagEnvironment(vrnts map[string]*flaggio.Variant) *flaggio.FlagEnvironment {
+	rules := make([]*flaggio.FlagRule, len(e.Rules))
+	for idx, rl := range e.Rules {
+		rules[idx] = rl.asRule(vrnts)
+	}
+	return &flaggio.FlagEnvironment{
+		EnvironmentID:         e.EnvironmentID.Hex(),
+		Enabled:               e.Enabled,
+		Rules:                 rules,
+		DefaultVariantWhenOn:  vrnts[e.DefaultVariantWhenOn.Hex()],
+		DefaultVariantWhenOff: vrnts[e.DefaultVariantWhenOff.Hex()],
+	}
+}
+
 type flagRuleModel struct {
 	ID            primitive.ObjectID  `bson:"_id"`
 	Constraints   []constraintModel   `bson:"constraints"`
 	Distributions []distributionModel `bson:"distributions"`
+	BucketBy      string              `bson:"bucketBy,omitempty"`
 }
 
 func (r flagRuleModel) asRule(vrnts map[string]*flaggio.Variant) *flaggio.FlagRule {
@@ -79,11 +130,16 @@ func (r flagRuleModel) asRule(vrnts map[string]*flaggio.Variant) *flaggio.FlagRu
 	for idx, dstrbtn := range r.Distributions {
 		distributions[idx] = dstrbtn.asDistribution(vrnts)
 	}
+	bucketBy := r.BucketBy
+	if bucketBy == "" {
+		bucketBy = flaggio.DefaultBucketBy
+	}
 	return &flaggio.FlagRule{
 		Rule: flaggio.Rule{
 			ID:          r.ID.Hex(),
 			Constraints: constraints,
 		},
+		BucketBy:      bucketBy,
 		Distributions: distributions,
 	}
 }
@@ -137,12 +193,13 @@ func (r segmentRuleModel) asRule() *flaggio.SegmentRule {
 }
 
 type segmentModel struct {
-	ID          primitive.ObjectID `bson:"_id"`
-	Name        string             `bson:"name"`
-	Description *string            `bson:"description"`
-	Rules       []segmentRuleModel `bson:"rules"`
-	CreatedAt   time.Time          `bson:"createdAt"`
-	UpdatedAt   *time.Time         `bson:"updatedAt"`
+	ID          primitive.ObjectID  `bson:"_id"`
+	ProjectID   *primitive.ObjectID `bson:"projectId,omitempty"`
+	Name        string              `bson:"name"`
+	Description *string             `bson:"description"`
+	Rules       []segmentRuleModel  `bson:"rules"`
+	CreatedAt   time.Time           `bson:"createdAt"`
+	UpdatedAt   *time.Time          `bson:"updatedAt"`
 }
 
 func (f *segmentModel) asSegment() *flaggio.Segment {
@@ -152,6 +209,7 @@ func (f *segmentModel) asSegment() *flaggio.Segment {
 	}
 	return &flaggio.Segment{
 		ID:          f.ID.Hex(),
+		ProjectID:   hexOrNil(f.ProjectID),
 		Name:        f.Name,
 		Description: f.Description,
 		Rules:       rules,
@@ -159,3 +217,342 @@ func (f *segmentModel) asSegment() *flaggio.Segment {
 		UpdatedAt:   f.UpdatedAt,
 	}
 }
+
+type targetModel struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	FlagID    primitive.ObjectID `bson:"flagId"`
+	VariantID primitive.ObjectID `bson:"variantId"`
+	UserID    string             `bson:"userId"`
+}
+
+type environmentModel struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	Key         string             `bson:"key"`
+	Name        string             `bson:"name"`
+	Description *string            `bson:"description"`
+	CreatedAt   time.Time          `bson:"createdAt"`
+	UpdatedAt   *time.Time         `bson:"updatedAt"`
+}
+
+func (e environmentModel) asEnvironment() *flaggio.Environment {
+	return &flaggio.Environment{
+		ID:          e.ID.Hex(),
+		Key:         e.Key,
+		Name:        e.Name,
+		Description: e.Description,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
+
+type projectModel struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	Key         string             `bson:"key"`
+	Name        string             `bson:"name"`
+	Description *string            `bson:"description"`
+	CreatedAt   time.Time          `bson:"createdAt"`
+	UpdatedAt   *time.Time         `bson:"updatedAt"`
+}
+
+func (p projectModel) asProject() *flaggio.Project {
+	return &flaggio.Project{
+		ID:          p.ID.Hex(),
+		Key:         p.Key,
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
+
+func hexOrNil(id *primitive.ObjectID) *string {
+	if id == nil {
+		return nil
+	}
+	h := id.Hex()
+	return &h
+}
+
+type apiKeyModel struct {
+	ID            primitive.ObjectID  `bson:"_id"`
+	Name          string              `bson:"name"`
+	Kind          string              `bson:"kind"`
+	Hash          string              `bson:"hash"`
+	ProjectID     *primitive.ObjectID `bson:"projectId"`
+	EnvironmentID *primitive.ObjectID `bson:"environmentId"`
+	CreatedAt     time.Time           `bson:"createdAt"`
+	RevokedAt     *time.Time          `bson:"revokedAt"`
+}
+
+func (k apiKeyModel) asAPIKey() *flaggio.APIKey {
+	return &flaggio.APIKey{
+		ID:            k.ID.Hex(),
+		Name:          k.Name,
+		Kind:          flaggio.APIKeyKind(k.Kind),
+		Hash:          k.Hash,
+		ProjectID:     hexOrNil(k.ProjectID),
+		EnvironmentID: hexOrNil(k.EnvironmentID),
+		CreatedAt:     k.CreatedAt,
+		RevokedAt:     k.RevokedAt,
+	}
+}
+
+type userModel struct {
+	ID           primitive.ObjectID `bson:"_id"`
+	Email        string             `bson:"email"`
+	Name         string             `bson:"name"`
+	Role         string             `bson:"role"`
+	PasswordHash string             `bson:"passwordHash"`
+	CreatedAt    time.Time          `bson:"createdAt"`
+	UpdatedAt    *time.Time         `bson:"updatedAt"`
+}
+
+func (u userModel) asUser() *flaggio.User {
+	return &flaggio.User{
+		ID:           u.ID.Hex(),
+		Email:        u.Email,
+		Name:         u.Name,
+		Role:         flaggio.UserRole(u.Role),
+		PasswordHash: u.PasswordHash,
+		CreatedAt:    u.CreatedAt,
+		UpdatedAt:    u.UpdatedAt,
+	}
+}
+
+type sessionModel struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	UserID    primitive.ObjectID `bson:"userId"`
+	TokenHash string             `bson:"tokenHash"`
+	CreatedAt time.Time          `bson:"createdAt"`
+	ExpiresAt time.Time          `bson:"expiresAt"`
+}
+
+func (s sessionModel) asSession() *flaggio.Session {
+	return &flaggio.Session{
+		ID:        s.ID.Hex(),
+		UserID:    s.UserID.Hex(),
+		TokenHash: s.TokenHash,
+		CreatedAt: s.CreatedAt,
+		ExpiresAt: s.ExpiresAt,
+	}
+}
+
+type auditEntryModel struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	Actor      *auditActorModel   `bson:"actor"`
+	EntityType string             `bson:"entityType"`
+	EntityID   string             `bson:"entityId"`
+	Action     string             `bson:"action"`
+	Operation  string             `bson:"operation"`
+	Before     interface{}        `bson:"before"`
+	After      interface{}        `bson:"after"`
+	Changes    []auditChangeModel `bson:"changes"`
+	CreatedAt  time.Time          `bson:"createdAt"`
+}
+
+func (e auditEntryModel) asAuditEntry() *flaggio.AuditEntry {
+	var actor *flaggio.AuditActor
+	if e.Actor != nil {
+		actor = &flaggio.AuditActor{ID: e.Actor.ID, Email: e.Actor.Email, Name: e.Actor.Name}
+	}
+	changes := make([]*flaggio.AuditChange, len(e.Changes))
+	for idx, c := range e.Changes {
+		changes[idx] = &flaggio.AuditChange{
+			Path:   c.Path,
+			Before: plainValue(c.Before),
+			After:  plainValue(c.After),
+		}
+	}
+	return &flaggio.AuditEntry{
+		ID:         e.ID.Hex(),
+		Actor:      actor,
+		EntityType: flaggio.AuditEntityType(e.EntityType),
+		EntityID:   e.EntityID,
+		Action:     flaggio.AuditAction(e.Action),
+		Operation:  e.Operation,
+		Before:     plainValue(e.Before),
+		After:      plainValue(e.After),
+		Changes:    changes,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+type auditActorModel struct {
+	ID    string `bson:"id"`
+	Email string `bson:"email"`
+	Name  string `bson:"name"`
+}
+
+type auditChangeModel struct {
+	Path   string      `bson:"path"`
+	Before interface{} `bson:"before"`
+	After  interface{} `bson:"after"`
+}
+
+// plainValue converts the documents and arrays decoded by mongodb into
+// plain maps and slices, so that they can be encoded as JSON.
+func plainValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case primitive.D:
+		m := make(map[string]interface{}, len(val))
+		for _, e := range val {
+			m[e.Key] = plainValue(e.Value)
+		}
+		return m
+	case primitive.M:
+		m := make(map[string]interface{}, len(val))
+		for k, e := range val {
+			m[k] = plainValue(e)
+		}
+		return m
+	case primitive.A:
+		l := make([]interface{}, len(val))
+		for idx, e := range val {
+			l[idx] = plainValue(e)
+		}
+		return l
+	default:
+		return v
+	}
+}
+
+type flagVersionModel struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	FlagID    primitive.ObjectID `bson:"flagId"`
+	Version   int                `bson:"version"`
+	Flag      flagModel          `bson:"flag"`
+	CreatedAt time.Time          `bson:"createdAt"`
+}
+
+func (v flagVersionModel) asFlagVersion() *flaggio.FlagVersion {
+	return &flaggio.FlagVersion{
+		FlagID:    v.FlagID.Hex(),
+		Version:   v.Version,
+		Flag:      v.Flag.asFlag(),
+		CreatedAt: v.CreatedAt,
+	}
+}
+
+type evaluationCountKey struct {
+	FlagKey     string    `bson:"flagKey"`
+	Project     string    `bson:"project"`
+	Environment string    `bson:"environment"`
+	VariantID   string    `bson:"variantId"`
+	Reason      string    `bson:"reason"`
+	Bucket      time.Time `bson:"bucket"`
+}
+
+type evaluationCountModel struct {
+	evaluationCountKey `bson:",inline"`
+	Count              int64     `bson:"count"`
+	LastEvaluatedAt    time.Time `bson:"lastEvaluatedAt"`
+}
+
+type scheduledChangeModel struct {
+	ID            primitive.ObjectID  `bson:"_id"`
+	FlagID        primitive.ObjectID  `bson:"flagId"`
+	Type          string              `bson:"type"`
+	EnvironmentID *primitive.ObjectID `bson:"environmentId"`
+	RuleID        *primitive.ObjectID `bson:"ruleId"`
+	// Input is the JSON encoded input of the change, so that it's decoded
+	// with the same types it was received with
+	Input      string           `bson:"input"`
+	ExecuteAt  time.Time        `bson:"executeAt"`
+	Status     string           `bson:"status"`
+	Error      *string          `bson:"error"`
+	CreatedBy  *auditActorModel `bson:"createdBy"`
+	CreatedAt  time.Time        `bson:"createdAt"`
+	ExecutedAt *time.Time       `bson:"executedAt"`
+}
+
+func (s scheduledChangeModel) asScheduledChange() (*flaggio.ScheduledChange, error) {
+	var createdBy *flaggio.AuditActor
+	if s.CreatedBy != nil {
+		createdBy = &flaggio.AuditActor{ID: s.CreatedBy.ID, Email: s.CreatedBy.Email, Name: s.CreatedBy.Name}
+	}
+	sc := &flaggio.ScheduledChange{
+		ID:            s.ID.Hex(),
+		FlagID:        s.FlagID.Hex(),
+		Type:          flaggio.ScheduledChangeType(s.Type),
+		EnvironmentID: hexOrNil(s.EnvironmentID),
+		RuleID:        hexOrNil(s.RuleID),
+		ExecuteAt:     s.ExecuteAt,
+		Status:        flaggio.ScheduledChangeStatus(s.Status),
+		Error:         s.Error,
+		CreatedBy:     createdBy,
+		CreatedAt:     s.CreatedAt,
+		ExecutedAt:    s.ExecutedAt,
+	}
+	var input interface{}
+	switch sc.Type {
+	case flaggio.ScheduledChangeTypeUpdateFlag:
+		sc.UpdateFlag = &flaggio.UpdateFlag{}
+		input = sc.UpdateFlag
+	case flaggio.ScheduledChangeTypeUpdateFlagEnvironment:
+		sc.UpdateFlagEnvironment = &flaggio.UpdateFlagEnvironment{}
+		input = sc.UpdateFlagEnvironment
+	case flaggio.ScheduledChangeTypeCreateFlagRule:
+		sc.NewFlagRule = &flaggio.NewFlagRule{}
+		input = sc.NewFlagRule
+	case flaggio.ScheduledChangeTypeUpdateFlagRule:
+		sc.UpdateFlagRule = &flaggio.UpdateFlagRule{}
+		input = sc.UpdateFlagRule
+	}
+	if input != nil {
+		dec := json.NewDecoder(strings.NewReader(s.Input))
+		// numbers are received as json.Number by the admin API
+		dec.UseNumber()
+		if err := dec.Decode(input); err != nil {
+			return nil, err
+		}
+	}
+	return sc, nil
+}
+
+type rolloutPlanModel struct {
+	ID            primitive.ObjectID  `bson:"_id"`
+	FlagID        primitive.ObjectID  `bson:"flagId"`
+	EnvironmentID *primitive.ObjectID `bson:"environmentId"`
+	RuleID        primitive.ObjectID  `bson:"ruleId"`
+	VariantID     primitive.ObjectID  `bson:"variantId"`
+	Steps         []rolloutStepModel  `bson:"steps"`
+	AppliedSteps  int                 `bson:"appliedSteps"`
+	// NextExecuteAt is the time of the next step to be applied, nil when all
+	// steps were applied, so that due plans can be queried
+	NextExecuteAt *time.Time       `bson:"nextExecuteAt"`
+	Status        string           `bson:"status"`
+	Error         *string          `bson:"error"`
+	CreatedBy     *auditActorModel `bson:"createdBy"`
+	CreatedAt     time.Time        `bson:"createdAt"`
+	UpdatedAt     *time.Time       `bson:"updatedAt"`
+}
+
+func (p rolloutPlanModel) asRolloutPlan() *flaggio.RolloutPlan {
+	var createdBy *flaggio.AuditActor
+	if p.CreatedBy != nil {
+		createdBy = &flaggio.AuditActor{ID: p.CreatedBy.ID, Email: p.CreatedBy.Email, Name: p.CreatedBy.Name}
+	}
+	steps := make([]*flaggio.RolloutStep, len(p.Steps))
+	for idx, s := range p.Steps {
+		steps[idx] = &flaggio.RolloutStep{ExecuteAt: s.ExecuteAt, Percentage: s.Percentage}
+	}
+	return &flaggio.RolloutPlan{
+		ID:            p.ID.Hex(),
+		FlagID:        p.FlagID.Hex(),
+		EnvironmentID: hexOrNil(p.EnvironmentID),
+		RuleID:        p.RuleID.Hex(),
+		VariantID:     p.VariantID.Hex(),
+		Steps:         steps,
+		AppliedSteps:  p.AppliedSteps,
+		Status:        flaggio.RolloutPlanStatus(p.Status),
+		Error:         p.Error,
+		CreatedBy:     createdBy,
+		CreatedAt:     p.CreatedAt,
+		UpdatedAt:     p.UpdatedAt,
+	}
+}
+
+type rolloutStepModel struct {
+	ExecuteAt  time.Time `bson:"executeAt"`
+	Percentage int       `bson:"percentage"`
+}
@@ -0,0 +1,214 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const projectsCollection = "projects"
+
+var _ repository.Project = (*ProjectRepository)(nil)
+
+// ProjectRepository implements repository.Project interface using mongodb.
+type ProjectRepository struct {
+	db  *mongo.Database
+	col *mongo.Collection
+}
+
+// FindAll returns a list of projects.
+func (r *ProjectRepository) FindAll(ctx context.Context) ([]*flaggio.Project, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoProjectRepository.FindAll")
+	defer span.Finish()
+
+	cursor, err := r.col.Find(ctx, bson.M{}, &options.FindOptions{
+		Sort:      bson.M{"key": 1},
+		Collation: &options.Collation{Locale: "en"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	projects := []*flaggio.Project{}
+	for cursor.Next(ctx) {
+		var p projectModel
+		// decode the document
+		if err := cursor.Decode(&p); err != nil {
+			return nil, err
+		}
+		projects = append(projects, p.asProject())
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+// FindByID returns a project that has a given ID.
+func (r *ProjectRepository) FindByID(ctx context.Context, idHex string) (*flaggio.Project, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoProjectRepository.FindByID")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return nil, err
+	}
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// FindByKey returns a project that has a given key.
+func (r *ProjectRepository) FindByKey(ctx context.Context, key string) (*flaggio.Project, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoProjectRepository.FindByKey")
+	defer span.Finish()
+
+	return r.findOne(ctx, bson.M{"key": key})
+}
+
+func (r *ProjectRepository) findOne(ctx context.Context, filter bson.M) (*flaggio.Project, error) {
+	var p projectModel
+	if err := r.col.FindOne(ctx, filter).Decode(&p); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.NotFound("project")
+		}
+		return nil, err
+	}
+	return p.asProject(), nil
+}
+
+// Create creates a new project.
+func (r *ProjectRepository) Create(ctx context.Context, p flaggio.NewProject) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoProjectRepository.Create")
+	defer span.Finish()
+
+	id := primitive.NewObjectID()
+	_, err := r.col.InsertOne(ctx, &projectModel{
+		ID:          id,
+		CreatedAt:   time.Now(),
+		Key:         p.Key,
+		Name:        p.Name,
+		Description: p.Description,
+	})
+	if err != nil {
+		return "", err
+	}
+	return id.Hex(), nil
+}
+
+// Update updates a project.
+func (r *ProjectRepository) Update(ctx context.Context, idHex string, p flaggio.UpdateProject) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoProjectRepository.Update")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	mods := bson.M{
+		"updatedAt": time.Now(),
+	}
+	if p.Key != nil {
+		mods["key"] = *p.Key
+	}
+	if p.Name != nil {
+		mods["name"] = *p.Name
+	}
+	if p.Description != nil {
+		mods["description"] = *p.Description
+	}
+	res, err := r.col.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": mods})
+	if err != nil {
+		return err
+	}
+	if res.ModifiedCount == 0 {
+		return errors.NotFound("project")
+	}
+	return nil
+}
+
+// Delete deletes a project. Projects that still own flags or segments
+// can't be deleted.
+func (r *ProjectRepository) Delete(ctx context.Context, idHex string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoProjectRepository.Delete")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	for _, col := range []string{flagsCollection, segmentsCollection} {
+		count, err := r.db.Collection(col).CountDocuments(ctx, bson.M{"projectId": id})
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return errors.BadRequest("project still has flags or segments")
+		}
+	}
+	res, err := r.col.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return errors.NotFound("project")
+	}
+	return nil
+}
+
+// projectObjectID converts an optional project ID into an object ID. When no
+// project ID is given, nil is returned, which matches documents that don't
+// belong to a project.
+func projectObjectID(idHex *string) (*primitive.ObjectID, error) {
+	if idHex == nil {
+		return nil, nil
+	}
+	id, err := primitive.ObjectIDFromHex(*idHex)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// ensureProject checks that the project with the given ID exists. A nil ID
+// is always valid.
+func ensureProject(ctx context.Context, db *mongo.Database, id *primitive.ObjectID) error {
+	if id == nil {
+		return nil
+	}
+	count, err := db.Collection(projectsCollection).CountDocuments(ctx, bson.M{"_id": *id})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.NotFound("project")
+	}
+	return nil
+}
+
+// NewProjectRepository returns a new project repository that uses mongodb as underlying storage.
+// It also creates all needed indexes, if they don't yet exist.
+func NewProjectRepository(ctx context.Context, db *mongo.Database) (repository.Project, error) {
+	col := db.Collection(projectsCollection)
+	_, err := col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.M{"key": 1},
+			Options: options.Index().SetUnique(true).SetBackground(false),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ProjectRepository{
+		db:  db,
+		col: col,
+	}, nil
+}
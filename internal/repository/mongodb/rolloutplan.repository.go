@@ -0,0 +1,278 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const rolloutPlansCollection = "rollout_plans"
+
+var _ repository.RolloutPlan = (*RolloutPlanRepository)(nil)
+
+// RolloutPlanRepository implements repository.RolloutPlan interface using mongodb.
+type RolloutPlanRepository struct {
+	col *mongo.Collection
+}
+
+// FindAll returns a list of rollout plans that match an optional filter,
+// newest first, based on an optional offset and limit.
+func (r *RolloutPlanRepository) FindAll(ctx context.Context, f *flaggio.RolloutPlanFilter, offset, limit *int64) ([]*flaggio.RolloutPlan, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoRolloutPlanRepository.FindAll")
+	defer span.Finish()
+
+	filter := bson.M{}
+	if f != nil {
+		if f.FlagID != nil {
+			flagID, err := primitive.ObjectIDFromHex(*f.FlagID)
+			if err != nil {
+				return nil, err
+			}
+			filter["flagId"] = flagID
+		}
+		if f.Status != nil {
+			filter["status"] = string(*f.Status)
+		}
+	}
+	return r.find(ctx, filter, &options.FindOptions{
+		Skip:  offset,
+		Limit: limit,
+		Sort:  bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}},
+	})
+}
+
+// FindByID returns a rollout plan that has a given ID.
+func (r *RolloutPlanRepository) FindByID(ctx context.Context, idHex string) (*flaggio.RolloutPlan, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoRolloutPlanRepository.FindByID")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return nil, err
+	}
+	var p rolloutPlanModel
+	if err := r.col.FindOne(ctx, bson.M{"_id": id}).Decode(&p); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.NotFound("rollout plan")
+		}
+		return nil, err
+	}
+	return p.asRolloutPlan(), nil
+}
+
+// FindDue returns up to limit active plans that have a step that should be
+// applied at or before a given time.
+func (r *RolloutPlanRepository) FindDue(ctx context.Context, now time.Time, limit int64) ([]*flaggio.RolloutPlan, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoRolloutPlanRepository.FindDue")
+	defer span.Finish()
+
+	filter := bson.M{
+		"status":        string(flaggio.RolloutPlanStatusActive),
+		"nextExecuteAt": bson.M{"$lte": now},
+	}
+	return r.find(ctx, filter, &options.FindOptions{
+		Limit: &limit,
+		Sort:  bson.D{{Key: "nextExecuteAt", Value: 1}, {Key: "_id", Value: 1}},
+	})
+}
+
+// Create creates a new active rollout plan. A rule can only have one active
+// plan at a time.
+func (r *RolloutPlanRepository) Create(ctx context.Context, p flaggio.RolloutPlan) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoRolloutPlanRepository.Create")
+	defer span.Finish()
+
+	if err := p.Validate(); err != nil {
+		return "", err
+	}
+	flagID, err := primitive.ObjectIDFromHex(p.FlagID)
+	if err != nil {
+		return "", err
+	}
+	envID, err := optionalObjectID(p.EnvironmentID)
+	if err != nil {
+		return "", err
+	}
+	ruleID, err := primitive.ObjectIDFromHex(p.RuleID)
+	if err != nil {
+		return "", err
+	}
+	variantID, err := primitive.ObjectIDFromHex(p.VariantID)
+	if err != nil {
+		return "", err
+	}
+	steps := make([]rolloutStepModel, len(p.Steps))
+	for idx, s := range p.Steps {
+		steps[idx] = rolloutStepModel{ExecuteAt: s.ExecuteAt, Percentage: s.Percentage}
+	}
+	var createdBy *auditActorModel
+	if p.CreatedBy != nil {
+		createdBy = &auditActorModel{ID: p.CreatedBy.ID, Email: p.CreatedBy.Email, Name: p.CreatedBy.Name}
+	}
+	id := primitive.NewObjectID()
+	_, err = r.col.InsertOne(ctx, &rolloutPlanModel{
+		ID:            id,
+		FlagID:        flagID,
+		EnvironmentID: envID,
+		RuleID:        ruleID,
+		VariantID:     variantID,
+		Steps:         steps,
+		NextExecuteAt: &steps[0].ExecuteAt,
+		Status:        string(flaggio.RolloutPlanStatusActive),
+		CreatedBy:     createdBy,
+		CreatedAt:     time.Now(),
+	})
+	if err != nil {
+		if isDuplicateKey(err) {
+			return "", errors.BadRequest("rule already has an active rollout plan")
+		}
+		return "", err
+	}
+	return id.Hex(), nil
+}
+
+// Cancel cancels an active rollout plan.
+func (r *RolloutPlanRepository) Cancel(ctx context.Context, idHex string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoRolloutPlanRepository.Cancel")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	res, err := r.col.UpdateOne(ctx,
+		bson.M{"_id": id, "status": string(flaggio.RolloutPlanStatusActive)},
+		bson.M{"$set": bson.M{
+			"status":        string(flaggio.RolloutPlanStatusCancelled),
+			"nextExecuteAt": nil,
+			"updatedAt":     time.Now(),
+		}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return r.notActive(ctx, id)
+	}
+	return nil
+}
+
+// Advance records the number of steps applied to an active rollout plan.
+// The plan is completed once all steps are applied, and marked as failed
+// when execErr is not nil.
+func (r *RolloutPlanRepository) Advance(ctx context.Context, idHex string, appliedSteps int, execErr error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoRolloutPlanRepository.Advance")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	filter := bson.M{"_id": id, "status": string(flaggio.RolloutPlanStatusActive)}
+	var p rolloutPlanModel
+	if err := r.col.FindOne(ctx, filter).Decode(&p); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return r.notActive(ctx, id)
+		}
+		return err
+	}
+	set := bson.M{
+		"status":        string(flaggio.RolloutPlanStatusActive),
+		"appliedSteps":  appliedSteps,
+		"nextExecuteAt": nil,
+		"updatedAt":     time.Now(),
+	}
+	switch {
+	case execErr != nil:
+		set["status"] = string(flaggio.RolloutPlanStatusFailed)
+		set["appliedSteps"] = p.AppliedSteps
+		set["error"] = execErr.Error()
+	case appliedSteps >= len(p.Steps):
+		set["status"] = string(flaggio.RolloutPlanStatusCompleted)
+	default:
+		set["nextExecuteAt"] = p.Steps[appliedSteps].ExecuteAt
+	}
+	// only update the plan if it didn't change since it was read
+	filter["appliedSteps"] = p.AppliedSteps
+	res, err := r.col.UpdateOne(ctx, filter, bson.M{"$set": set})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return r.notActive(ctx, id)
+	}
+	return nil
+}
+
+func (r *RolloutPlanRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*flaggio.RolloutPlan, error) {
+	cursor, err := r.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := []*flaggio.RolloutPlan{}
+	for cursor.Next(ctx) {
+		var p rolloutPlanModel
+		// decode the document
+		if err := cursor.Decode(&p); err != nil {
+			return nil, err
+		}
+		plans = append(plans, p.asRolloutPlan())
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return plans, nil
+}
+
+// notActive returns the error for a plan that could not be updated because
+// it doesn't exist or is no longer active.
+func (r *RolloutPlanRepository) notActive(ctx context.Context, id primitive.ObjectID) error {
+	count, err := r.col.CountDocuments(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.NotFound("rollout plan")
+	}
+	return errors.BadRequest("rollout plan is not active")
+}
+
+// NewRolloutPlanRepository returns a new rollout plan repository that uses mongodb
+// as underlying storage. It also creates all needed indexes, if they don't yet exist.
+func NewRolloutPlanRepository(ctx context.Context, db *mongo.Database) (repository.RolloutPlan, error) {
+	col := db.Collection(rolloutPlansCollection)
+	_, err := col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "nextExecuteAt", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "flagId", Value: 1}, {Key: "createdAt", Value: -1}},
+		},
+		{
+			// only one active plan per rule
+			Keys: bson.D{{Key: "flagId", Value: 1}, {Key: "environmentId", Value: 1}, {Key: "ruleId", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetBackground(false).
+				SetPartialFilterExpression(bson.M{"status": string(flaggio.RolloutPlanStatusActive)}),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &RolloutPlanRepository{
+		col: col,
+	}, nil
+}
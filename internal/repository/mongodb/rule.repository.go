@@ -23,8 +23,9 @@ type RuleRepository struct {
 	segmentRepo *SegmentRepository
 }
 
-// FindFlagRuleByID returns a flag rule that has a given ID.
-func (r *RuleRepository) FindFlagRuleByID(ctx context.Context, flagIDHex, idHex string) (*flaggio.FlagRule, error) {
+// FindFlagRuleByID returns a flag rule that has a given ID. When an environment ID is
+// given, the rule is searched in the flag settings for that environment.
+func (r *RuleRepository) FindFlagRuleByID(ctx context.Context, flagIDHex string, environmentIDHex *string, idHex string) (*flaggio.FlagRule, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoRuleRepository.FindFlagRuleByID")
 	defer span.Finish()
 
@@ -38,6 +39,16 @@ func (r *RuleRepository) FindFlagRuleByID(ctx context.Context, flagIDHex, idHex
 	}
 	filter := bson.M{"_id": flagID, "rules._id": ruleID}
 	projection := bson.M{"variants": 1, "rules.$": 1}
+	if environmentIDHex != nil {
+		envID, err := primitive.ObjectIDFromHex(*environmentIDHex)
+		if err != nil {
+			return nil, err
+		}
+		filter = bson.M{"_id": flagID, "environments": bson.M{
+			"$elemMatch": bson.M{"environmentId": envID, "rules._id": ruleID},
+		}}
+		projection = bson.M{"variants": 1, "environments.$": 1}
+	}
 	opts := options.FindOne().SetProjection(projection)
 
 	var f flagModel
@@ -47,15 +58,24 @@ func (r *RuleRepository) FindFlagRuleByID(ctx context.Context, flagIDHex, idHex
 		}
 		return nil, err
 	}
-	if len(f.Rules) != 1 {
-		return nil, errors.NotFound("rule")
-	}
 	flg := f.asFlag()
-	return flg.Rules[0], nil
+	rules := flg.Rules
+	if environmentIDHex != nil {
+		if len(flg.Environments) != 1 {
+			return nil, errors.NotFound("rule")
+		}
+		rules = flg.Environments[0].Rules
+	}
+	for _, rl := range rules {
+		if rl.ID == idHex {
+			return rl, nil
+		}
+	}
+	return nil, errors.NotFound("rule")
 }
 
-// CreateFlagRule creates a new rule under a flag.
-func (r *RuleRepository) CreateFlagRule(ctx context.Context, flagIDHex string, fr flaggio.NewFlagRule) (string, error) {
+// CreateFlagRule creates a new rule under a flag, optionally for an environment.
+func (r *RuleRepository) CreateFlagRule(ctx context.Context, flagIDHex string, environmentIDHex *string, fr flaggio.NewFlagRule) (string, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoRuleRepository.CreateFlagRule")
 	defer span.Finish()
 
@@ -85,27 +105,42 @@ func (r *RuleRepository) CreateFlagRule(ctx context.Context, flagIDHex string, f
 		Constraints:   constraints,
 		Distributions: distributions,
 	}
+	if fr.BucketBy != nil {
+		flgRuleModel.BucketBy = *fr.BucketBy
+	}
 	flagID, err := primitive.ObjectIDFromHex(flagIDHex)
 	if err != nil {
 		return "", err
 	}
 	filter := bson.M{"_id": flagID}
-	res, err := r.flagRepo.col.UpdateOne(ctx, filter, bson.M{
-		"$push": bson.M{"rules": flgRuleModel},
+	field := "rules"
+	if environmentIDHex != nil {
+		envID, err := primitive.ObjectIDFromHex(*environmentIDHex)
+		if err != nil {
+			return "", err
+		}
+		if err := r.flagRepo.ensureEnvironment(ctx, flagID, envID); err != nil {
+			return "", err
+		}
+		filter["environments.environmentId"] = envID
+		field = "environments.$.rules"
+	}
+	found, err := updateFlag(ctx, r.flagRepo.db, filter, bson.M{
+		"$push": bson.M{field: flgRuleModel},
 		"$set":  bson.M{"updatedAt": time.Now()},
 		"$inc":  bson.M{"version": 1},
 	})
 	if err != nil {
 		return "", err
 	}
-	if res.ModifiedCount == 0 {
+	if !found {
 		return "", errors.NotFound("flag")
 	}
 	return flgRuleModel.ID.Hex(), nil
 }
 
-// UpdateFlagRule updates a rule under a flag.
-func (r *RuleRepository) UpdateFlagRule(ctx context.Context, flagIDHex, idHex string, fr flaggio.UpdateFlagRule) error {
+// UpdateFlagRule updates a rule under a flag, optionally for an environment.
+func (r *RuleRepository) UpdateFlagRule(ctx context.Context, flagIDHex string, environmentIDHex *string, idHex string, fr flaggio.UpdateFlagRule) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoRuleRepository.UpdateFlagRule")
 	defer span.Finish()
 
@@ -138,27 +173,49 @@ func (r *RuleRepository) UpdateFlagRule(ctx context.Context, flagIDHex, idHex st
 			Percentage: d.Percentage,
 		}
 	}
+	filter := bson.M{"_id": flagID, "rules._id": id}
+	prefix := "rules.$."
+	opts := options.FindOneAndUpdate()
+	if environmentIDHex != nil {
+		envID, err := primitive.ObjectIDFromHex(*environmentIDHex)
+		if err != nil {
+			return err
+		}
+		filter = bson.M{"_id": flagID, "environments": bson.M{
+			"$elemMatch": bson.M{"environmentId": envID, "rules._id": id},
+		}}
+		prefix = "environments.$[env].rules.$[rule]."
+		opts.SetArrayFilters(options.ArrayFilters{Filters: []interface{}{
+			bson.M{"env.environmentId": envID},
+			bson.M{"rule._id": id},
+		}})
+	}
 	mods := bson.M{
-		"updatedAt":             time.Now(),
-		"rules.$.constraints":   constraints,
-		"rules.$.distributions": distributions,
+		"updatedAt":              time.Now(),
+		prefix + "constraints":   constraints,
+		prefix + "distributions": distributions,
+	}
+	if fr.BucketBy != nil {
+		mods[prefix+"bucketBy"] = *fr.BucketBy
 	}
-	res, err := r.flagRepo.col.UpdateOne(
+	found, err := updateFlag(
 		ctx,
-		bson.M{"_id": flagID, "rules._id": id},
+		r.flagRepo.db,
+		filter,
 		bson.M{"$set": mods, "$inc": bson.M{"version": 1}},
+		opts,
 	)
 	if err != nil {
 		return err
 	}
-	if res.ModifiedCount == 0 {
+	if !found {
 		return errors.NotFound("flag rule")
 	}
 	return nil
 }
 
-// DeleteFlagRule deletes a rule under a flag.
-func (r *RuleRepository) DeleteFlagRule(ctx context.Context, flagIDHex, idHex string) error {
+// DeleteFlagRule deletes a rule under a flag, optionally for an environment.
+func (r *RuleRepository) DeleteFlagRule(ctx context.Context, flagIDHex string, environmentIDHex *string, idHex string) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoRuleRepository.DeleteFlagRule")
 	defer span.Finish()
 
@@ -170,15 +227,25 @@ func (r *RuleRepository) DeleteFlagRule(ctx context.Context, flagIDHex, idHex st
 	if err != nil {
 		return err
 	}
-	res, err := r.flagRepo.col.UpdateOne(ctx, bson.M{"_id": flagID}, bson.M{
-		"$pull": bson.M{"rules": bson.M{"_id": id}},
+	filter := bson.M{"_id": flagID}
+	field := "rules"
+	if environmentIDHex != nil {
+		envID, err := primitive.ObjectIDFromHex(*environmentIDHex)
+		if err != nil {
+			return err
+		}
+		filter["environments.environmentId"] = envID
+		field = "environments.$.rules"
+	}
+	found, err := updateFlag(ctx, r.flagRepo.db, filter, bson.M{
+		"$pull": bson.M{field: bson.M{"_id": id}},
 		"$set":  bson.M{"updatedAt": time.Now()},
 		"$inc":  bson.M{"version": 1},
 	})
 	if err != nil {
 		return err
 	}
-	if res.ModifiedCount == 0 {
+	if !found {
 		return errors.NotFound("flag rule")
 	}
 	return nil
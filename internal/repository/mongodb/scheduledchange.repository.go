@@ -0,0 +1,296 @@
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const scheduledChangesCollection = "scheduled_changes"
+
+var _ repository.ScheduledChange = (*ScheduledChangeRepository)(nil)
+
+// ScheduledChangeRepository implements repository.ScheduledChange interface using mongodb.
+type ScheduledChangeRepository struct {
+	col *mongo.Collection
+}
+
+// FindAll returns a list of scheduled changes that match an optional filter,
+// sorted by execution time, based on an optional offset and limit.
+func (r *ScheduledChangeRepository) FindAll(ctx context.Context, f *flaggio.ScheduledChangeFilter, offset, limit *int64) ([]*flaggio.ScheduledChange, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoScheduledChangeRepository.FindAll")
+	defer span.Finish()
+
+	filter := bson.M{}
+	if f != nil {
+		if f.FlagID != nil {
+			flagID, err := primitive.ObjectIDFromHex(*f.FlagID)
+			if err != nil {
+				return nil, err
+			}
+			filter["flagId"] = flagID
+		}
+		if f.Status != nil {
+			filter["status"] = string(*f.Status)
+		}
+	}
+	return r.find(ctx, filter, &options.FindOptions{
+		Skip:  offset,
+		Limit: limit,
+		Sort:  bson.D{{Key: "executeAt", Value: 1}, {Key: "_id", Value: 1}},
+	})
+}
+
+// FindByID returns a scheduled change that has a given ID.
+func (r *ScheduledChangeRepository) FindByID(ctx context.Context, idHex string) (*flaggio.ScheduledChange, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoScheduledChangeRepository.FindByID")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return nil, err
+	}
+	var s scheduledChangeModel
+	if err := r.col.FindOne(ctx, bson.M{"_id": id}).Decode(&s); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.NotFound("scheduled change")
+		}
+		return nil, err
+	}
+	return s.asScheduledChange()
+}
+
+// FindDue returns up to limit pending changes that should be executed at
+// or before a given time, sorted by execution time.
+func (r *ScheduledChangeRepository) FindDue(ctx context.Context, now time.Time, limit int64) ([]*flaggio.ScheduledChange, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoScheduledChangeRepository.FindDue")
+	defer span.Finish()
+
+	filter := bson.M{
+		"status":    string(flaggio.ScheduledChangeStatusPending),
+		"executeAt": bson.M{"$lte": now},
+	}
+	return r.find(ctx, filter, &options.FindOptions{
+		Limit: &limit,
+		Sort:  bson.D{{Key: "executeAt", Value: 1}, {Key: "_id", Value: 1}},
+	})
+}
+
+// Create creates a new pending scheduled change.
+func (r *ScheduledChangeRepository) Create(ctx context.Context, sc flaggio.ScheduledChange) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoScheduledChangeRepository.Create")
+	defer span.Finish()
+
+	if err := sc.Validate(); err != nil {
+		return "", err
+	}
+	flagID, err := primitive.ObjectIDFromHex(sc.FlagID)
+	if err != nil {
+		return "", err
+	}
+	envID, err := optionalObjectID(sc.EnvironmentID)
+	if err != nil {
+		return "", err
+	}
+	ruleID, err := optionalObjectID(sc.RuleID)
+	if err != nil {
+		return "", err
+	}
+	var input []byte
+	if in := sc.Input(); in != nil {
+		input, err = json.Marshal(in)
+		if err != nil {
+			return "", err
+		}
+	}
+	var createdBy *auditActorModel
+	if sc.CreatedBy != nil {
+		createdBy = &auditActorModel{ID: sc.CreatedBy.ID, Email: sc.CreatedBy.Email, Name: sc.CreatedBy.Name}
+	}
+	id := primitive.NewObjectID()
+	_, err = r.col.InsertOne(ctx, &scheduledChangeModel{
+		ID:            id,
+		FlagID:        flagID,
+		Type:          string(sc.Type),
+		EnvironmentID: envID,
+		RuleID:        ruleID,
+		Input:         string(input),
+		ExecuteAt:     sc.ExecuteAt,
+		Status:        string(flaggio.ScheduledChangeStatusPending),
+		CreatedBy:     createdBy,
+		CreatedAt:     time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return id.Hex(), nil
+}
+
+// Cancel cancels a pending scheduled change.
+func (r *ScheduledChangeRepository) Cancel(ctx context.Context, idHex string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoScheduledChangeRepository.Cancel")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	res, err := r.col.UpdateOne(ctx,
+		bson.M{"_id": id, "status": string(flaggio.ScheduledChangeStatusPending)},
+		bson.M{"$set": bson.M{"status": string(flaggio.ScheduledChangeStatusCancelled)}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return r.notPending(ctx, id)
+	}
+	return nil
+}
+
+// Claim marks a pending scheduled change as running, so that it can't be
+// cancelled or applied by anyone else. It returns false if the change is
+// no longer pending.
+func (r *ScheduledChangeRepository) Claim(ctx context.Context, idHex string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoScheduledChangeRepository.Claim")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return false, err
+	}
+	err = r.col.FindOneAndUpdate(ctx,
+		bson.M{"_id": id, "status": string(flaggio.ScheduledChangeStatusPending)},
+		bson.M{"$set": bson.M{"status": string(flaggio.ScheduledChangeStatusRunning)}},
+	).Err()
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Complete marks a running scheduled change as executed. The change is
+// marked as failed when execErr is not nil.
+func (r *ScheduledChangeRepository) Complete(ctx context.Context, idHex string, executedAt time.Time, execErr error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoScheduledChangeRepository.Complete")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	set := bson.M{"status": string(flaggio.ScheduledChangeStatusApplied), "executedAt": executedAt}
+	if execErr != nil {
+		set["status"] = string(flaggio.ScheduledChangeStatusFailed)
+		set["error"] = execErr.Error()
+	}
+	res, err := r.col.UpdateOne(ctx,
+		bson.M{"_id": id, "status": string(flaggio.ScheduledChangeStatusRunning)},
+		bson.M{"$set": set},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return r.notRunning(ctx, id)
+	}
+	return nil
+}
+
+func (r *ScheduledChangeRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*flaggio.ScheduledChange, error) {
+	cursor, err := r.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []*flaggio.ScheduledChange{}
+	for cursor.Next(ctx) {
+		var s scheduledChangeModel
+		// decode the document
+		if err := cursor.Decode(&s); err != nil {
+			return nil, err
+		}
+		sc, err := s.asScheduledChange()
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, sc)
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// notPending returns the error for a change that could not be updated
+// because it doesn't exist or was already executed or cancelled.
+func (r *ScheduledChangeRepository) notPending(ctx context.Context, id primitive.ObjectID) error {
+	count, err := r.col.CountDocuments(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.NotFound("scheduled change")
+	}
+	return errors.BadRequest("scheduled change is not pending")
+}
+
+// notRunning returns the error for a change that could not be completed
+// because it doesn't exist or isn't being applied.
+func (r *ScheduledChangeRepository) notRunning(ctx context.Context, id primitive.ObjectID) error {
+	count, err := r.col.CountDocuments(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.NotFound("scheduled change")
+	}
+	return errors.BadRequest("scheduled change is not running")
+}
+
+// optionalObjectID converts an optional ID into an object ID.
+func optionalObjectID(idHex *string) (*primitive.ObjectID, error) {
+	if idHex == nil {
+		return nil, nil
+	}
+	id, err := primitive.ObjectIDFromHex(*idHex)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// NewScheduledChangeRepository returns a new scheduled change repository that uses mongodb
+// as underlying storage. It also creates all needed indexes, if they don't yet exist.
+func NewScheduledChangeRepository(ctx context.Context, db *mongo.Database) (repository.ScheduledChange, error) {
+	col := db.Collection(scheduledChangesCollection)
+	_, err := col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "executeAt", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "flagId", Value: 1}, {Key: "executeAt", Value: 1}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ScheduledChangeRepository{
+		col: col,
+	}, nil
+}
@@ -14,6 +14,8 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+const segmentsCollection = "segments"
+
 var _ repository.Segment = (*SegmentRepository)(nil)
 
 // SegmentRepository implements repository.Segment interface using mongodb.
@@ -22,12 +24,18 @@ type SegmentRepository struct {
 	col *mongo.Collection
 }
 
-// FindAll returns a list of segments, based on an optional offset and limit.
-func (r *SegmentRepository) FindAll(ctx context.Context, offset, limit *int64) ([]*flaggio.Segment, error) {
+// FindAll returns a list of segments in a project, based on an optional offset and limit.
+// If no project ID is given, only segments that don't belong to a project are returned.
+func (r *SegmentRepository) FindAll(ctx context.Context, projectIDHex *string, offset, limit *int64) ([]*flaggio.Segment, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoSegmentRepository.FindAll")
 	defer span.Finish()
 
-	cursor, err := r.col.Find(ctx, bson.M{}, &options.FindOptions{
+	projectID, err := projectObjectID(projectIDHex)
+	if err != nil {
+		return nil, err
+	}
+	filter := bson.M{"projectId": projectID}
+	cursor, err := r.col.Find(ctx, filter, &options.FindOptions{
 		Skip:      offset,
 		Limit:     limit,
 		Sort:      bson.M{"name": 1},
@@ -80,9 +88,17 @@ func (r *SegmentRepository) Create(ctx context.Context, f flaggio.NewSegment) (s
 	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoSegmentRepository.Create")
 	defer span.Finish()
 
+	projectID, err := projectObjectID(f.ProjectID)
+	if err != nil {
+		return "", err
+	}
+	if err := ensureProject(ctx, r.db, projectID); err != nil {
+		return "", err
+	}
 	id := primitive.NewObjectID()
-	_, err := r.col.InsertOne(ctx, &segmentModel{
+	_, err = r.col.InsertOne(ctx, &segmentModel{
 		ID:          id,
+		ProjectID:   projectID,
 		CreatedAt:   time.Now(),
 		Name:        f.Name,
 		Description: f.Description,
@@ -149,8 +165,12 @@ func (r *SegmentRepository) Delete(ctx context.Context, idHex string) error {
 // NewSegmentRepository returns a new segment repository that uses mongodb as underlying storage.
 // It also creates all needed indexes, if they don't yet exist.
 func NewSegmentRepository(ctx context.Context, db *mongo.Database) (repository.Segment, error) {
-	col := db.Collection("segments")
+	col := db.Collection(segmentsCollection)
 	_, err := col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.M{"projectId": 1},
+			Options: options.Index().SetBackground(false),
+		},
 		{
 			Keys:    bson.M{"rules._id": 1},
 			Options: options.Index().SetUnique(true).SetSparse(true).SetBackground(false),
@@ -0,0 +1,107 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const sessionsCollection = "sessions"
+
+var _ repository.Session = (*SessionRepository)(nil)
+
+// SessionRepository implements repository.Session interface using mongodb.
+type SessionRepository struct {
+	col *mongo.Collection
+}
+
+// FindByTokenHash returns a session that has a given token hash and
+// is not expired.
+func (r *SessionRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*flaggio.Session, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoSessionRepository.FindByTokenHash")
+	defer span.Finish()
+
+	var s sessionModel
+	// expired sessions are only removed periodically by mongodb,
+	// so they need to be filtered out
+	filter := bson.M{"tokenHash": tokenHash, "expiresAt": bson.M{"$gt": time.Now()}}
+	if err := r.col.FindOne(ctx, filter).Decode(&s); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.NotFound("session")
+		}
+		return nil, err
+	}
+	return s.asSession(), nil
+}
+
+// Create creates a new session for a user.
+func (r *SessionRepository) Create(ctx context.Context, userIDHex, tokenHash string, expiresAt time.Time) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoSessionRepository.Create")
+	defer span.Finish()
+
+	userID, err := primitive.ObjectIDFromHex(userIDHex)
+	if err != nil {
+		return "", err
+	}
+	id := primitive.NewObjectID()
+	_, err = r.col.InsertOne(ctx, &sessionModel{
+		ID:        id,
+		UserID:    userID,
+		TokenHash: tokenHash,
+		CreatedAt: time.Now(),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return "", err
+	}
+	return id.Hex(), nil
+}
+
+// Delete deletes the session that has a given token hash.
+func (r *SessionRepository) Delete(ctx context.Context, tokenHash string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoSessionRepository.Delete")
+	defer span.Finish()
+
+	res, err := r.col.DeleteOne(ctx, bson.M{"tokenHash": tokenHash})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return errors.NotFound("session")
+	}
+	return nil
+}
+
+// NewSessionRepository returns a new session repository that uses mongodb as underlying storage.
+// It also creates all needed indexes, if they don't yet exist. Expired sessions are removed
+// automatically by mongodb.
+func NewSessionRepository(ctx context.Context, db *mongo.Database) (repository.Session, error) {
+	col := db.Collection(sessionsCollection)
+	_, err := col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.M{"tokenHash": 1},
+			Options: options.Index().SetUnique(true).SetBackground(false),
+		},
+		{
+			Keys: bson.M{"userId": 1},
+		},
+		{
+			Keys:    bson.M{"expiresAt": 1},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &SessionRepository{
+		col: col,
+	}, nil
+}
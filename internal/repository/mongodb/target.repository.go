@@ -0,0 +1,255 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// targets are stored in their own collection, one document per targeted
+// user, so that flags with thousands of targeted users are kept small
+const flagTargetsCollection = "flag_targets"
+
+var _ repository.Target = (*TargetRepository)(nil)
+
+// TargetRepository implements repository.Target interface using mongodb.
+type TargetRepository struct {
+	flagRepo *FlagRepository
+	col      *mongo.Collection
+}
+
+// FindAllByFlagID returns the targets of a flag, one for each targeted variant.
+func (r *TargetRepository) FindAllByFlagID(ctx context.Context, flagIDHex string) ([]*flaggio.FlagTarget, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoTargetRepository.FindAllByFlagID")
+	defer span.Finish()
+
+	flagID, err := primitive.ObjectIDFromHex(flagIDHex)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := r.col.Find(ctx, bson.M{"flagId": flagID}, options.Find().SetSort(bson.M{"userId": 1}))
+	if err != nil {
+		return nil, err
+	}
+
+	targets := []*flaggio.FlagTarget{}
+	targetsByVariant := map[primitive.ObjectID]*flaggio.FlagTarget{}
+	for cursor.Next(ctx) {
+		var t targetModel
+		// decode the document
+		if err := cursor.Decode(&t); err != nil {
+			return nil, err
+		}
+		tgt, ok := targetsByVariant[t.VariantID]
+		if !ok {
+			tgt = &flaggio.FlagTarget{FlagID: flagIDHex, VariantID: t.VariantID.Hex()}
+			targetsByVariant[t.VariantID] = tgt
+			targets = append(targets, tgt)
+		}
+		tgt.Users = append(tgt.Users, t.UserID)
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return targets, nil
+}
+
+// FindAllByFlagIDs returns the targets of all the given flags, one for each
+// targeted variant of each flag.
+func (r *TargetRepository) FindAllByFlagIDs(ctx context.Context, flagIDsHex []string) ([]*flaggio.FlagTarget, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoTargetRepository.FindAllByFlagIDs")
+	defer span.Finish()
+
+	if len(flagIDsHex) == 0 {
+		return []*flaggio.FlagTarget{}, nil
+	}
+	flagIDs := make([]primitive.ObjectID, len(flagIDsHex))
+	for idx, flagIDHex := range flagIDsHex {
+		flagID, err := primitive.ObjectIDFromHex(flagIDHex)
+		if err != nil {
+			return nil, err
+		}
+		flagIDs[idx] = flagID
+	}
+	cursor, err := r.col.Find(
+		ctx,
+		bson.M{"flagId": bson.M{"$in": flagIDs}},
+		options.Find().SetSort(bson.D{{Key: "flagId", Value: 1}, {Key: "userId", Value: 1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	type targetKey struct {
+		flagID, variantID primitive.ObjectID
+	}
+	targets := []*flaggio.FlagTarget{}
+	targetsByKey := map[targetKey]*flaggio.FlagTarget{}
+	for cursor.Next(ctx) {
+		var t targetModel
+		// decode the document
+		if err := cursor.Decode(&t); err != nil {
+			return nil, err
+		}
+		key := targetKey{flagID: t.FlagID, variantID: t.VariantID}
+		tgt, ok := targetsByKey[key]
+		if !ok {
+			tgt = &flaggio.FlagTarget{FlagID: t.FlagID.Hex(), VariantID: t.VariantID.Hex()}
+			targetsByKey[key] = tgt
+			targets = append(targets, tgt)
+		}
+		tgt.Users = append(tgt.Users, t.UserID)
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return targets, nil
+}
+
+// FindAllByUserID returns the targets that include a given user, across all flags.
+// Only the given user is listed in the users of each target.
+func (r *TargetRepository) FindAllByUserID(ctx context.Context, userID string) ([]*flaggio.FlagTarget, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoTargetRepository.FindAllByUserID")
+	defer span.Finish()
+
+	cursor, err := r.col.Find(ctx, bson.M{"userId": userID})
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []*flaggio.FlagTarget
+	for cursor.Next(ctx) {
+		var t targetModel
+		// decode the document
+		if err := cursor.Decode(&t); err != nil {
+			return nil, err
+		}
+		targets = append(targets, &flaggio.FlagTarget{
+			FlagID:    t.FlagID.Hex(),
+			VariantID: t.VariantID.Hex(),
+			Users:     []string{t.UserID},
+		})
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return targets, nil
+}
+
+// AddUsers adds users to the target of a flag variant. Users already targeted
+// by another variant of the same flag are moved to the given variant.
+func (r *TargetRepository) AddUsers(ctx context.Context, flagIDHex, variantIDHex string, users []string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoTargetRepository.AddUsers")
+	defer span.Finish()
+
+	flagID, variantID, err := r.touchVariant(ctx, flagIDHex, variantIDHex, users)
+	if err != nil {
+		return err
+	}
+	if len(users) == 0 {
+		return nil
+	}
+	writes := make([]mongo.WriteModel, len(users))
+	for idx, usr := range users {
+		writes[idx] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"flagId": flagID, "userId": usr}).
+			SetUpdate(bson.M{
+				"$set":         bson.M{"variantId": variantID},
+				"$setOnInsert": bson.M{"_id": primitive.NewObjectID()},
+			}).
+			SetUpsert(true)
+	}
+	_, err = r.col.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// RemoveUsers removes users from the target of a flag variant.
+func (r *TargetRepository) RemoveUsers(ctx context.Context, flagIDHex, variantIDHex string, users []string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoTargetRepository.RemoveUsers")
+	defer span.Finish()
+
+	flagID, variantID, err := r.touchVariant(ctx, flagIDHex, variantIDHex, users)
+	if err != nil {
+		return err
+	}
+	if len(users) == 0 {
+		return nil
+	}
+	_, err = r.col.DeleteMany(ctx, bson.M{
+		"flagId":    flagID,
+		"variantId": variantID,
+		"userId":    bson.M{"$in": users},
+	})
+	return err
+}
+
+// touchVariant validates the input and bumps the version of the flag, making
+// sure the flag and variant exist.
+func (r *TargetRepository) touchVariant(ctx context.Context, flagIDHex, variantIDHex string, users []string) (primitive.ObjectID, primitive.ObjectID, error) {
+	flagID, err := primitive.ObjectIDFromHex(flagIDHex)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, err
+	}
+	variantID, err := primitive.ObjectIDFromHex(variantIDHex)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, err
+	}
+	for _, usr := range users {
+		if usr == "" {
+			return primitive.NilObjectID, primitive.NilObjectID, errors.BadRequest("user IDs can't be empty")
+		}
+	}
+	found, err := updateFlag(
+		ctx,
+		r.flagRepo.db,
+		bson.M{"_id": flagID, "variants._id": variantID},
+		bson.M{"$set": bson.M{"updatedAt": time.Now()}, "$inc": bson.M{"version": 1}},
+	)
+	if err != nil {
+		return primitive.NilObjectID, primitive.NilObjectID, err
+	}
+	if !found {
+		return primitive.NilObjectID, primitive.NilObjectID, errors.NotFound("variant")
+	}
+	return flagID, variantID, nil
+}
+
+// NewTargetRepository returns a new target repository that uses mongodb
+// as underlying storage.
+func NewTargetRepository(ctx context.Context, flagRepo *FlagRepository) (repository.Target, error) {
+	col := flagRepo.db.Collection(flagTargetsCollection)
+	_, err := col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "flagId", Value: 1}, {Key: "userId", Value: 1}},
+			Options: options.Index().SetUnique(true).SetBackground(false),
+		},
+		{
+			Keys:    bson.M{"userId": 1},
+			Options: options.Index().SetBackground(false),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &TargetRepository{
+		flagRepo: flagRepo,
+		col:      col,
+	}, nil
+}
@@ -0,0 +1,185 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const usersCollection = "users"
+
+var _ repository.User = (*UserRepository)(nil)
+
+// UserRepository implements repository.User interface using mongodb.
+type UserRepository struct {
+	db  *mongo.Database
+	col *mongo.Collection
+}
+
+// FindAll returns a list of users.
+func (r *UserRepository) FindAll(ctx context.Context) ([]*flaggio.User, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoUserRepository.FindAll")
+	defer span.Finish()
+
+	cursor, err := r.col.Find(ctx, bson.M{}, &options.FindOptions{
+		Sort:      bson.M{"email": 1},
+		Collation: &options.Collation{Locale: "en"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	users := []*flaggio.User{}
+	for cursor.Next(ctx) {
+		var u userModel
+		// decode the document
+		if err := cursor.Decode(&u); err != nil {
+			return nil, err
+		}
+		users = append(users, u.asUser())
+	}
+
+	// check if the cursor encountered any errors while iterating
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// FindByID returns a user that has a given ID.
+func (r *UserRepository) FindByID(ctx context.Context, idHex string) (*flaggio.User, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoUserRepository.FindByID")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return nil, err
+	}
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// FindByEmail returns a user that has a given email.
+func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*flaggio.User, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoUserRepository.FindByEmail")
+	defer span.Finish()
+
+	return r.findOne(ctx, bson.M{"email": normalizeEmail(email)})
+}
+
+func (r *UserRepository) findOne(ctx context.Context, filter bson.M) (*flaggio.User, error) {
+	var u userModel
+	if err := r.col.FindOne(ctx, filter).Decode(&u); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.NotFound("user")
+		}
+		return nil, err
+	}
+	return u.asUser(), nil
+}
+
+// Create creates a new user with the given password hash.
+func (r *UserRepository) Create(ctx context.Context, u flaggio.NewUser, passwordHash string) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoUserRepository.Create")
+	defer span.Finish()
+
+	id := primitive.NewObjectID()
+	_, err := r.col.InsertOne(ctx, &userModel{
+		ID:           id,
+		CreatedAt:    time.Now(),
+		Email:        normalizeEmail(u.Email),
+		Name:         u.Name,
+		Role:         string(u.Role),
+		PasswordHash: passwordHash,
+	})
+	if err != nil {
+		return "", err
+	}
+	return id.Hex(), nil
+}
+
+// Update updates a user. The password is only changed if passwordHash is not nil.
+func (r *UserRepository) Update(ctx context.Context, idHex string, u flaggio.UpdateUser, passwordHash *string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoUserRepository.Update")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	mods := bson.M{
+		"updatedAt": time.Now(),
+	}
+	if u.Name != nil {
+		mods["name"] = *u.Name
+	}
+	if u.Role != nil {
+		mods["role"] = string(*u.Role)
+	}
+	if passwordHash != nil {
+		mods["passwordHash"] = *passwordHash
+	}
+	res, err := r.col.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": mods})
+	if err != nil {
+		return err
+	}
+	if res.ModifiedCount == 0 {
+		return errors.NotFound("user")
+	}
+	if passwordHash != nil {
+		// force the user to log in again with the new password
+		_, err = r.db.Collection(sessionsCollection).DeleteMany(ctx, bson.M{"userId": id})
+	}
+	return err
+}
+
+// Delete deletes a user, along with their sessions.
+func (r *UserRepository) Delete(ctx context.Context, idHex string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MongoUserRepository.Delete")
+	defer span.Finish()
+
+	id, err := primitive.ObjectIDFromHex(idHex)
+	if err != nil {
+		return err
+	}
+	res, err := r.col.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return errors.NotFound("user")
+	}
+	_, err = r.db.Collection(sessionsCollection).DeleteMany(ctx, bson.M{"userId": id})
+	return err
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// NewUserRepository returns a new user repository that uses mongodb as underlying storage.
+// It also creates all needed indexes, if they don't yet exist.
+func NewUserRepository(ctx context.Context, db *mongo.Database) (repository.User, error) {
+	col := db.Collection(usersCollection)
+	_, err := col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.M{"email": 1},
+			Options: options.Index().SetUnique(true).SetBackground(false),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &UserRepository{
+		db:  db,
+		col: col,
+	}, nil
+}
@@ -66,7 +66,7 @@ func (r *VariantRepository) Create(ctx context.Context, flagIDHex string, v flag
 		return "", err
 	}
 	filter := bson.M{"_id": flagID}
-	res, err := r.flagRepo.col.UpdateOne(ctx, filter, bson.M{
+	found, err := updateFlag(ctx, r.flagRepo.db, filter, bson.M{
 		"$push": bson.M{"variants": vrntModel},
 		"$set":  bson.M{"updatedAt": time.Now()},
 		"$inc":  bson.M{"version": 1},
@@ -74,7 +74,7 @@ func (r *VariantRepository) Create(ctx context.Context, flagIDHex string, v flag
 	if err != nil {
 		return "", err
 	}
-	if res.ModifiedCount == 0 {
+	if !found {
 		return "", errors.NotFound("flag")
 	}
 	return vrntModel.ID.Hex(), nil
@@ -102,15 +102,16 @@ func (r *VariantRepository) Update(ctx context.Context, flagIDHex, idHex string,
 	if v.Value != nil {
 		mods["variants.$.value"] = v.Value
 	}
-	res, err := r.flagRepo.col.UpdateOne(
+	found, err := updateFlag(
 		ctx,
+		r.flagRepo.db,
 		bson.M{"_id": flagID, "variants._id": id},
 		bson.M{"$set": mods, "$inc": bson.M{"version": 1}},
 	)
 	if err != nil {
 		return err
 	}
-	if res.ModifiedCount == 0 {
+	if !found {
 		return errors.NotFound("variant")
 	}
 	return nil
@@ -129,7 +130,7 @@ func (r *VariantRepository) Delete(ctx context.Context, flagIDHex, idHex string)
 	if err != nil {
 		return err
 	}
-	res, err := r.flagRepo.col.UpdateOne(ctx, bson.M{"_id": flagID}, bson.M{
+	found, err := updateFlag(ctx, r.flagRepo.db, bson.M{"_id": flagID, "variants._id": id}, bson.M{
 		"$pull": bson.M{"variants": bson.M{"_id": id}},
 		"$set":  bson.M{"updatedAt": time.Now()},
 		"$inc":  bson.M{"version": 1},
@@ -137,10 +138,12 @@ func (r *VariantRepository) Delete(ctx context.Context, flagIDHex, idHex string)
 	if err != nil {
 		return err
 	}
-	if res.ModifiedCount == 0 {
+	if !found {
 		return errors.NotFound("variant")
 	}
-	return nil
+	// remove all users targeted by the variant
+	_, err = r.flagRepo.db.Collection(flagTargetsCollection).DeleteMany(ctx, bson.M{"flagId": flagID, "variantId": id})
+	return err
 }
 
 // NewVariantRepository returns a new variant repository that uses mongodb
@@ -0,0 +1,26 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/project_mock.go -package=repository_mock github.com/victorkt/flaggio/internal/repository Project
+
+import (
+	"context"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// Project represents a set of operations available to list and manage projects.
+type Project interface {
+	// FindAll returns a list of projects.
+	FindAll(ctx context.Context) ([]*flaggio.Project, error)
+	// FindByID returns a project that has a given ID.
+	FindByID(ctx context.Context, id string) (*flaggio.Project, error)
+	// FindByKey returns a project that has a given key.
+	FindByKey(ctx context.Context, key string) (*flaggio.Project, error)
+	// Create creates a new project.
+	Create(ctx context.Context, input flaggio.NewProject) (string, error)
+	// Update updates a project.
+	Update(ctx context.Context, id string, input flaggio.UpdateProject) error
+	// Delete deletes a project. Projects that still own flags or segments
+	// can't be deleted.
+	Delete(ctx context.Context, id string) error
+}
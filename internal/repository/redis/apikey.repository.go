@@ -0,0 +1,118 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"github.com/vmihailenco/msgpack/v4"
+)
+
+var _ repository.APIKey = (*APIKeyRepository)(nil)
+
+// APIKeyRepository implements repository.APIKey interface using redis.
+type APIKeyRepository struct {
+	redis *redis.Client
+	store repository.APIKey
+	ttl   time.Duration
+}
+
+// FindAll returns a list of API keys, including revoked ones.
+func (r *APIKeyRepository) FindAll(ctx context.Context) ([]*flaggio.APIKey, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisAPIKeyRepository.FindAll")
+	defer span.Finish()
+
+	// no caching for listing API keys
+	return r.store.FindAll(ctx)
+}
+
+// FindByID returns an API key that has a given ID.
+func (r *APIKeyRepository) FindByID(ctx context.Context, id string) (*flaggio.APIKey, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisAPIKeyRepository.FindByID")
+	defer span.Finish()
+
+	// no caching for API keys by ID, they are only used by the admin
+	return r.store.FindByID(ctx, id)
+}
+
+// FindByHash returns an API key that has a given hash.
+func (r *APIKeyRepository) FindByHash(ctx context.Context, hash string) (*flaggio.APIKey, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisAPIKeyRepository.FindByHash")
+	defer span.Finish()
+
+	cacheKey := flaggio.APIKeyCacheKey("hash", hash)
+
+	// fetch results from cache
+	cached, err := r.redis.WithContext(ctx).Get(cacheKey).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		// an unexpected error occurred, return it
+		return nil, err
+	}
+	if cached != "" {
+		// cache hit, unmarshall and return result
+		var k flaggio.APIKey
+		if err := msgpack.Unmarshal([]byte(cached), &k); err == nil {
+			// return if no errors, otherwise defer to the store
+			return &k, nil
+		}
+	}
+
+	// cache miss, fetch from store
+	res, err := r.store.FindByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	// marshall and save result
+	b, err := msgpack.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.redis.WithContext(ctx).Set(cacheKey, b, r.ttl).Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Create creates a new API key with the given hash.
+func (r *APIKeyRepository) Create(ctx context.Context, input flaggio.NewAPIKey, hash string) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisAPIKeyRepository.Create")
+	defer span.Finish()
+
+	// nothing to invalidate
+	return r.store.Create(ctx, input, hash)
+}
+
+// Revoke revokes an API key, so that it can't be used anymore.
+func (r *APIKeyRepository) Revoke(ctx context.Context, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisAPIKeyRepository.Revoke")
+	defer span.Finish()
+
+	// find the API key so we can get the hash
+	k, err := r.store.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := r.store.Revoke(ctx, id); err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys
+	return r.redis.WithContext(ctx).Del(flaggio.APIKeyCacheKey("hash", k.Hash)).Err()
+}
+
+// NewAPIKeyRepository returns a new API key repository that uses redis
+// as underlying storage.
+func NewAPIKeyRepository(redisClient *redis.Client, store repository.APIKey) repository.APIKey {
+	return &APIKeyRepository{
+		redis: redisClient,
+		store: store,
+		ttl:   1 * time.Hour,
+	}
+}
@@ -0,0 +1,124 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	repository_mock "github.com/victorkt/flaggio/internal/repository/mocks"
+	redis_repo "github.com/victorkt/flaggio/internal/repository/redis"
+)
+
+var (
+	apiKey = &flaggio.APIKey{
+		ID: "1", Name: "backend", Kind: flaggio.APIKeyKindServer, Hash: "abc",
+	}
+)
+
+func TestAPIKeyRepository_FindByHash(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockAPIKey)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "calls underlying repository on cache miss",
+			run: func(t *testing.T, apiKeyStoreRepo *repository_mock.MockAPIKey) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				apiKeyRedisRepo := redis_repo.NewAPIKeyRepository(redisClient, apiKeyStoreRepo)
+				apiKeyStoreRepo.EXPECT().FindByHash(gomock.AssignableToTypeOf(ctxInterface), "abc").
+					Times(1).Return(apiKey, nil)
+
+				res, err := apiKeyRedisRepo.FindByHash(ctx, "abc")
+				assert.NoError(t, err)
+				assert.Equal(t, apiKey, res)
+			},
+		},
+		{
+			name: "doesnt call underlying repository on cache hit",
+			run: func(t *testing.T, apiKeyStoreRepo *repository_mock.MockAPIKey) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				apiKeyRedisRepo := redis_repo.NewAPIKeyRepository(redisClient, apiKeyStoreRepo)
+				apiKeyStoreRepo.EXPECT().FindByHash(gomock.AssignableToTypeOf(ctxInterface), "abc").
+					Times(0)
+
+				res, err := apiKeyRedisRepo.FindByHash(ctx, "abc")
+				assert.NoError(t, err)
+				assert.Equal(t, apiKey, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			apiKeyStoreRepo := repository_mock.NewMockAPIKey(mockCtrl)
+
+			tt.run(t, apiKeyStoreRepo)
+		})
+	}
+}
+
+func TestAPIKeyRepository_Revoke(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockAPIKey)
+	}{
+		{
+			name: "clears the cached API key",
+			run: func(t *testing.T, apiKeyStoreRepo *repository_mock.MockAPIKey) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache an API key
+				err := redisCtx.Set(flaggio.APIKeyCacheKey("hash", "abc"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// prepare repository mock
+				apiKeyRedisRepo := redis_repo.NewAPIKeyRepository(redisClient, apiKeyStoreRepo)
+				apiKeyStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return(apiKey, nil)
+				apiKeyStoreRepo.EXPECT().Revoke(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return(nil)
+
+				// call redis repository
+				err = apiKeyRedisRepo.Revoke(ctx, "1")
+				assert.NoError(t, err)
+
+				// check cached keys are cleared
+				cachedKeys, err := redisCtx.Keys(flaggio.APIKeyCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			apiKeyStoreRepo := repository_mock.NewMockAPIKey(mockCtrl)
+
+			tt.run(t, apiKeyStoreRepo)
+		})
+	}
+}
@@ -1,8 +1,53 @@
 package redis
 
+import (
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
 func shouldCacheFindAll(search *string, offset, limit *int64) bool {
 	if search == nil && offset == nil && limit == nil {
 		return true
 	}
 	return false
 }
+
+// flagsCacheKey returns the cache key for the list of flags in a project.
+func flagsCacheKey(projectID *string) string {
+	if projectID == nil {
+		return flaggio.FlagCacheKey("*")
+	}
+	return flaggio.FlagCacheKey("project", *projectID, "*")
+}
+
+// flagKeyCacheKey returns the cache key for a flag in a project, by flag key.
+func flagKeyCacheKey(projectID *string, key string) string {
+	if projectID == nil {
+		return flaggio.FlagCacheKey("key", key)
+	}
+	return flaggio.FlagCacheKey("project", *projectID, "key", key)
+}
+
+// flagCacheKeys returns all cache keys that hold the given flag.
+func flagCacheKeys(f *flaggio.Flag) []string {
+	return []string{
+		flagsCacheKey(f.ProjectID),
+		flaggio.FlagCacheKey(f.ID),
+		flagKeyCacheKey(f.ProjectID, f.Key),
+	}
+}
+
+// segmentsCacheKey returns the cache key for the list of segments in a project.
+func segmentsCacheKey(projectID *string) string {
+	if projectID == nil {
+		return flaggio.SegmentCacheKey("*")
+	}
+	return flaggio.SegmentCacheKey("project", *projectID, "*")
+}
+
+// segmentCacheKeys returns all cache keys that hold the given segment.
+func segmentCacheKeys(s *flaggio.Segment) []string {
+	return []string{
+		segmentsCacheKey(s.ProjectID),
+		flaggio.SegmentCacheKey(s.ID),
+	}
+}
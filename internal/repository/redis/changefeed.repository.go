@@ -0,0 +1,78 @@
+package redis
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-redis/redis/v7"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"github.com/vmihailenco/msgpack/v4"
+)
+
+var _ repository.ChangeFeed = (*ChangeFeedRepository)(nil)
+
+// ChangeFeedRepository implements repository.ChangeFeed interface using redis
+// pub/sub. Changes are published by the other redis repositories whenever
+// they invalidate the cache of a flag or a segment.
+type ChangeFeedRepository struct {
+	redis *redis.Client
+}
+
+// Listen calls fn for every change until the context is cancelled or
+// the feed fails. The returned error is nil only if the context was cancelled.
+func (r *ChangeFeedRepository) Listen(ctx context.Context, fn func(*flaggio.ChangeEvent)) error {
+	pubsub := r.redis.WithContext(ctx).Subscribe(flaggio.ChangesChannel)
+	defer pubsub.Close()
+
+	// wait until the subscription is confirmed
+	if _, err := pubsub.Receive(); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-ch:
+			if !ok {
+				return errors.New("redis subscription closed")
+			}
+			var evt flaggio.ChangeEvent
+			if err := msgpack.Unmarshal([]byte(msg.Payload), &evt); err != nil {
+				// ignore unknown messages
+				continue
+			}
+			fn(&evt)
+		}
+	}
+}
+
+// publishChange notifies all listeners of the change feed about a change.
+func publishChange(redisCtx *redis.Client, evt *flaggio.ChangeEvent) error {
+	b, err := msgpack.Marshal(evt)
+	if err != nil {
+		return err
+	}
+	return redisCtx.Publish(flaggio.ChangesChannel, b).Err()
+}
+
+func flagChangeEvent(f *flaggio.Flag) *flaggio.ChangeEvent {
+	return &flaggio.ChangeEvent{Type: flaggio.ChangeTypeFlag, ID: f.ID, Key: f.Key, ProjectID: f.ProjectID}
+}
+
+func segmentChangeEvent(s *flaggio.Segment) *flaggio.ChangeEvent {
+	return &flaggio.ChangeEvent{Type: flaggio.ChangeTypeSegment, ID: s.ID, ProjectID: s.ProjectID}
+}
+
+// NewChangeFeedRepository returns a new change feed that uses redis
+// pub/sub to receive changes.
+func NewChangeFeedRepository(redisClient *redis.Client) repository.ChangeFeed {
+	return &ChangeFeedRepository{
+		redis: redisClient,
+	}
+}
@@ -0,0 +1,60 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	repository_mock "github.com/victorkt/flaggio/internal/repository/mocks"
+	redis_repo "github.com/victorkt/flaggio/internal/repository/redis"
+)
+
+func TestChangeFeedRepository_Listen(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// start listening to changes
+	changes := make(chan *flaggio.ChangeEvent, 1)
+	listenErr := make(chan error, 1)
+	changeFeedRepo := redis_repo.NewChangeFeedRepository(redisClient)
+	go func() {
+		listenErr <- changeFeedRepo.Listen(ctx, func(evt *flaggio.ChangeEvent) {
+			changes <- evt
+		})
+	}()
+	// wait until the subscription is active
+	for redisClient.PubSubNumSub(flaggio.ChangesChannel).Val()[flaggio.ChangesChannel] == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// prepare repository mock
+	flg := flagResults.Flags[0]
+	flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
+	flagStoreRepo.EXPECT().Update(gomock.AssignableToTypeOf(ctxInterface), "1", flaggio.UpdateFlag{Name: stringPtr("f1")}).
+		Times(1).Return(nil)
+	flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+		Times(1).Return(flg, nil)
+
+	// change the flag
+	err := flagRedisRepo.Update(ctx, "1", flaggio.UpdateFlag{Name: stringPtr("f1")})
+	assert.NoError(t, err)
+
+	// check the change was received
+	select {
+	case evt := <-changes:
+		assert.Equal(t, &flaggio.ChangeEvent{Type: flaggio.ChangeTypeFlag, ID: flg.ID, Key: flg.Key, ProjectID: flg.ProjectID}, evt)
+	case <-ctx.Done():
+		t.Fatal("change was not received")
+	}
+
+	// stop listening
+	cancel()
+	assert.NoError(t, <-listenErr)
+}
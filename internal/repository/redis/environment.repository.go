@@ -0,0 +1,170 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"github.com/vmihailenco/msgpack/v4"
+)
+
+var _ repository.Environment = (*EnvironmentRepository)(nil)
+
+// EnvironmentRepository implements repository.Environment interface using redis.
+type EnvironmentRepository struct {
+	redis *redis.Client
+	store repository.Environment
+	ttl   time.Duration
+}
+
+// FindAll returns a list of environments.
+func (r *EnvironmentRepository) FindAll(ctx context.Context) ([]*flaggio.Environment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisEnvironmentRepository.FindAll")
+	defer span.Finish()
+
+	// no caching for listing environments
+	return r.store.FindAll(ctx)
+}
+
+// FindByID returns an environment that has a given ID.
+func (r *EnvironmentRepository) FindByID(ctx context.Context, id string) (*flaggio.Environment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisEnvironmentRepository.FindByID")
+	defer span.Finish()
+
+	return r.findCached(ctx, flaggio.EnvironmentCacheKey(id), func() (*flaggio.Environment, error) {
+		return r.store.FindByID(ctx, id)
+	})
+}
+
+// FindByKey returns an environment that has a given key.
+func (r *EnvironmentRepository) FindByKey(ctx context.Context, key string) (*flaggio.Environment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisEnvironmentRepository.FindByKey")
+	defer span.Finish()
+
+	return r.findCached(ctx, flaggio.EnvironmentCacheKey("key", key), func() (*flaggio.Environment, error) {
+		return r.store.FindByKey(ctx, key)
+	})
+}
+
+func (r *EnvironmentRepository) findCached(ctx context.Context, cacheKey string,
+	find func() (*flaggio.Environment, error)) (*flaggio.Environment, error) {
+	// fetch results from cache
+	cached, err := r.redis.WithContext(ctx).Get(cacheKey).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		// an unexpected error occurred, return it
+		return nil, err
+	}
+	if cached != "" {
+		// cache hit, unmarshall and return result
+		var e flaggio.Environment
+		if err := msgpack.Unmarshal([]byte(cached), &e); err == nil {
+			// return if no errors, otherwise defer to the store
+			return &e, nil
+		}
+	}
+
+	// cache miss, fetch from store
+	res, err := find()
+	if err != nil {
+		return nil, err
+	}
+
+	// marshall and save result
+	b, err := msgpack.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.redis.WithContext(ctx).Set(cacheKey, b, r.ttl).Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Create creates a new environment.
+func (r *EnvironmentRepository) Create(ctx context.Context, input flaggio.NewEnvironment) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisEnvironmentRepository.Create")
+	defer span.Finish()
+
+	// nothing to invalidate
+	return r.store.Create(ctx, input)
+}
+
+// Update updates an environment.
+func (r *EnvironmentRepository) Update(ctx context.Context, id string, input flaggio.UpdateEnvironment) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisEnvironmentRepository.Update")
+	defer span.Finish()
+
+	// find the environment so we can get the environment key
+	e, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := r.store.Update(ctx, id, input); err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys
+	return r.invalidateRelevantCacheKeys(ctx, e, false)
+}
+
+// Delete deletes an environment, along with the flag settings for it.
+func (r *EnvironmentRepository) Delete(ctx context.Context, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisEnvironmentRepository.Delete")
+	defer span.Finish()
+
+	// find the environment so we can get the environment key
+	e, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := r.store.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys, including flags, since
+	// the environment settings were removed from them
+	return r.invalidateRelevantCacheKeys(ctx, e, true)
+}
+
+func (r *EnvironmentRepository) invalidateRelevantCacheKeys(ctx context.Context, e *flaggio.Environment, flags bool) error {
+	redisCtx := r.redis.WithContext(ctx)
+
+	// invalidate all relevant keys
+	keysToInvalidate, err := redisCtx.Keys(flaggio.EvalCacheKey("*")).Result()
+	if err != nil {
+		return err
+	}
+	if flags {
+		flagKeys, err := redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+		if err != nil {
+			return err
+		}
+		keysToInvalidate = append(keysToInvalidate, flagKeys...)
+	}
+	keysToInvalidate = append(
+		[]string{
+			flaggio.EnvironmentCacheKey(e.ID),
+			flaggio.EnvironmentCacheKey("key", e.Key),
+		},
+		keysToInvalidate...,
+	)
+
+	return redisCtx.Del(keysToInvalidate...).Err()
+}
+
+// NewEnvironmentRepository returns a new environment repository that uses redis
+// as underlying storage.
+func NewEnvironmentRepository(redisClient *redis.Client, store repository.Environment) repository.Environment {
+	return &EnvironmentRepository{
+		redis: redisClient,
+		store: store,
+		ttl:   1 * time.Hour,
+	}
+}
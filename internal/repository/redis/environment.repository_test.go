@@ -0,0 +1,130 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	repository_mock "github.com/victorkt/flaggio/internal/repository/mocks"
+	redis_repo "github.com/victorkt/flaggio/internal/repository/redis"
+)
+
+var (
+	env = &flaggio.Environment{
+		ID: "1", Key: "production", Name: "Production",
+	}
+)
+
+func TestEnvironmentRepository_FindByKey(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockEnvironment)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "calls underlying repository on cache miss",
+			run: func(t *testing.T, environmentStoreRepo *repository_mock.MockEnvironment) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				environmentRedisRepo := redis_repo.NewEnvironmentRepository(redisClient, environmentStoreRepo)
+				environmentStoreRepo.EXPECT().FindByKey(gomock.AssignableToTypeOf(ctxInterface), "production").
+					Times(1).Return(env, nil)
+
+				res, err := environmentRedisRepo.FindByKey(ctx, "production")
+				assert.NoError(t, err)
+				assert.Equal(t, env, res)
+			},
+		},
+		{
+			name: "doesnt call underlying repository on cache hit",
+			run: func(t *testing.T, environmentStoreRepo *repository_mock.MockEnvironment) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				environmentRedisRepo := redis_repo.NewEnvironmentRepository(redisClient, environmentStoreRepo)
+				environmentStoreRepo.EXPECT().FindByKey(gomock.AssignableToTypeOf(ctxInterface), "production").
+					Times(0)
+
+				res, err := environmentRedisRepo.FindByKey(ctx, "production")
+				assert.NoError(t, err)
+				assert.Equal(t, env, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			environmentStoreRepo := repository_mock.NewMockEnvironment(mockCtrl)
+
+			tt.run(t, environmentStoreRepo)
+		})
+	}
+}
+
+func TestEnvironmentRepository_Update(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockEnvironment)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "clears all cached evaluations and relevant cached environments",
+			run: func(t *testing.T, environmentStoreRepo *repository_mock.MockEnvironment) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache an evaluation and an environment
+				err := redisCtx.Set(flaggio.EvalCacheKey("test"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+				err = redisCtx.Set(flaggio.EnvironmentCacheKey("key", "production"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// prepare repository mock
+				environmentRedisRepo := redis_repo.NewEnvironmentRepository(redisClient, environmentStoreRepo)
+				environmentStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return(env, nil)
+				environmentStoreRepo.EXPECT().Update(gomock.AssignableToTypeOf(ctxInterface), "1", flaggio.UpdateEnvironment{}).
+					Times(1).Return(nil)
+
+				// call redis repository
+				err = environmentRedisRepo.Update(ctx, "1", flaggio.UpdateEnvironment{})
+				assert.NoError(t, err)
+
+				// check cached keys are cleared
+				cachedKeys, err := redisCtx.Keys(flaggio.EvalCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 0)
+				cachedKeys, err = redisCtx.Keys(flaggio.EnvironmentCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			environmentStoreRepo := repository_mock.NewMockEnvironment(mockCtrl)
+
+			tt.run(t, environmentStoreRepo)
+		})
+	}
+}
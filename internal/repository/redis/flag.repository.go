@@ -21,13 +21,14 @@ type FlagRepository struct {
 	ttl   time.Duration
 }
 
-// FindAll returns a list of flags, based on an optional offset and limit.
-func (r *FlagRepository) FindAll(ctx context.Context, search *string, offset, limit *int64) (*flaggio.FlagResults, error) {
+// FindAll returns a list of flags in a project, based on an optional offset and limit.
+// If no project ID is given, only flags that don't belong to a project are returned.
+func (r *FlagRepository) FindAll(ctx context.Context, projectID, search *string, offset, limit *int64) (*flaggio.FlagResults, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisFlagRepository.FindAll")
 	defer span.Finish()
 
 	shouldCache := shouldCacheFindAll(search, offset, limit)
-	cacheKey := flaggio.FlagCacheKey("*")
+	cacheKey := flagsCacheKey(projectID)
 
 	if shouldCache {
 		// fetch flag results from cache
@@ -47,7 +48,7 @@ func (r *FlagRepository) FindAll(ctx context.Context, search *string, offset, li
 	}
 
 	// cache miss or disabled, fetch from store
-	res, err := r.store.FindAll(ctx, search, offset, limit)
+	res, err := r.store.FindAll(ctx, projectID, search, offset, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -106,12 +107,13 @@ func (r *FlagRepository) FindByID(ctx context.Context, id string) (*flaggio.Flag
 	return res, nil
 }
 
-// FindByKey returns a flag that has a given key.
-func (r *FlagRepository) FindByKey(ctx context.Context, key string) (*flaggio.Flag, error) {
+// FindByKey returns a flag in a project that has a given key.
+// If no project ID is given, only flags that don't belong to a project are considered.
+func (r *FlagRepository) FindByKey(ctx context.Context, projectID *string, key string) (*flaggio.Flag, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisFlagRepository.FindByKey")
 	defer span.Finish()
 
-	cacheKey := flaggio.FlagCacheKey("key", key)
+	cacheKey := flagKeyCacheKey(projectID, key)
 
 	// fetch flag results from cache
 	cached, err := r.redis.WithContext(ctx).Get(cacheKey).Result()
@@ -129,7 +131,7 @@ func (r *FlagRepository) FindByKey(ctx context.Context, key string) (*flaggio.Fl
 	}
 
 	// cache miss, fetch from store
-	res, err := r.store.FindByKey(ctx, key)
+	res, err := r.store.FindByKey(ctx, projectID, key)
 	if err != nil {
 		return nil, err
 	}
@@ -146,6 +148,84 @@ func (r *FlagRepository) FindByKey(ctx context.Context, key string) (*flaggio.Fl
 	return res, nil
 }
 
+// FindByKeys returns the flags in a project that have one of the given keys, in the
+// order of the keys. Keys that don't match any flag are ignored.
+// If no project ID is given, only flags that don't belong to a project are considered.
+// Flags are cached individually, as in FindByKey.
+func (r *FlagRepository) FindByKeys(ctx context.Context, projectID *string, keys []string) ([]*flaggio.Flag, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisFlagRepository.FindByKeys")
+	defer span.Finish()
+
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	cacheKeys := make([]string, len(keys))
+	for idx, key := range keys {
+		cacheKeys[idx] = flagKeyCacheKey(projectID, key)
+	}
+
+	// fetch flags from cache
+	cached, err := r.redis.WithContext(ctx).MGet(cacheKeys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	flagsByKey := make(map[string]*flaggio.Flag, len(keys))
+	var missing []string
+	for idx, key := range keys {
+		if s, ok := cached[idx].(string); ok {
+			// cache hit, unmarshall the flag
+			var f flaggio.Flag
+			if err := msgpack.Unmarshal([]byte(s), &f); err == nil {
+				flagsByKey[key] = &f
+				continue
+			}
+		}
+		// cache miss or bad data, defer to the store
+		missing = append(missing, key)
+	}
+
+	if len(missing) > 0 {
+		// fetch missing flags from store
+		res, err := r.store.FindByKeys(ctx, projectID, missing)
+		if err != nil {
+			return nil, err
+		}
+		if len(res) == 0 {
+			// none of the missing keys exist
+			return flagsInKeyOrder(flagsByKey, keys), nil
+		}
+
+		// marshall and save results
+		pipe := r.redis.WithContext(ctx).Pipeline()
+		for _, f := range res {
+			b, err := msgpack.Marshal(f)
+			if err != nil {
+				return nil, err
+			}
+			pipe.Set(flagKeyCacheKey(projectID, f.Key), b, r.ttl)
+			flagsByKey[f.Key] = f
+		}
+		if _, err := pipe.Exec(); err != nil {
+			return nil, err
+		}
+	}
+
+	return flagsInKeyOrder(flagsByKey, keys), nil
+}
+
+// flagsInKeyOrder returns the flags in the order of the given keys,
+// skipping repeated and unknown keys.
+func flagsInKeyOrder(flagsByKey map[string]*flaggio.Flag, keys []string) []*flaggio.Flag {
+	flgs := make([]*flaggio.Flag, 0, len(flagsByKey))
+	for _, key := range keys {
+		if f, ok := flagsByKey[key]; ok {
+			flgs = append(flgs, f)
+			delete(flagsByKey, key)
+		}
+	}
+	return flgs
+}
+
 // Create creates a new flag.
 func (r *FlagRepository) Create(ctx context.Context, input flaggio.NewFlag) (string, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisFlagRepository.Create")
@@ -157,7 +237,7 @@ func (r *FlagRepository) Create(ctx context.Context, input flaggio.NewFlag) (str
 	}
 
 	// invalidate all relevant keys
-	return id, r.invalidateRelevantCacheKeys(ctx, id, input.Key)
+	return id, r.invalidateRelevantCacheKeys(ctx, &flaggio.Flag{ID: id, ProjectID: input.ProjectID, Key: input.Key})
 }
 
 // Update updates a flag.
@@ -165,31 +245,66 @@ func (r *FlagRepository) Update(ctx context.Context, id string, input flaggio.Up
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisFlagRepository.Update")
 	defer span.Finish()
 
+	// find the flag so we can get the flag key and project
+	// before the key is changed
+	f, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	if err := r.store.Update(ctx, id, input); err != nil {
 		return err
 	}
 
-	var flagKey string
-	if input.Key != nil {
-		flagKey = *input.Key
-	} else {
-		f, err := r.FindByID(ctx, id)
-		if err != nil {
-			return err
-		}
-		flagKey = f.Key
+	// invalidate all relevant keys
+	return r.invalidateRelevantCacheKeys(ctx, f)
+}
+
+// UpdateEnvironment updates the settings of a flag for an environment.
+func (r *FlagRepository) UpdateEnvironment(ctx context.Context, id, environmentID string, input flaggio.UpdateFlagEnvironment) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisFlagRepository.UpdateEnvironment")
+	defer span.Finish()
+
+	if err := r.store.UpdateEnvironment(ctx, id, environmentID, input); err != nil {
+		return err
+	}
+
+	// find the flag so we can get the flag key and project
+	f, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
 	}
 
 	// invalidate all relevant keys
-	return r.invalidateRelevantCacheKeys(ctx, id, flagKey)
+	return r.invalidateRelevantCacheKeys(ctx, f)
 }
 
-// Delete deletes a flag.
+// Rollback restores the content of a flag from one of its versions,
+// creating a new version.
+func (r *FlagRepository) Rollback(ctx context.Context, id string, version int) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisFlagRepository.Rollback")
+	defer span.Finish()
+
+	if err := r.store.Rollback(ctx, id, version); err != nil {
+		return err
+	}
+
+	// find the flag so we can get the flag key and project
+	f, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys
+	return r.invalidateRelevantCacheKeys(ctx, f)
+}
+
+// Delete deletes a flag, along with its versions.
 func (r *FlagRepository) Delete(ctx context.Context, id string) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisFlagRepository.Delete")
 	defer span.Finish()
 
-	// find the flag so we can get the flag key
+	// find the flag so we can get the flag key and project
 	f, err := r.FindByID(ctx, id)
 	if err != nil {
 		return err
@@ -201,10 +316,10 @@ func (r *FlagRepository) Delete(ctx context.Context, id string) error {
 	}
 
 	// invalidate all relevant keys
-	return r.invalidateRelevantCacheKeys(ctx, id, f.Key)
+	return r.invalidateRelevantCacheKeys(ctx, f)
 }
 
-func (r *FlagRepository) invalidateRelevantCacheKeys(ctx context.Context, flagID, flagKey string) error {
+func (r *FlagRepository) invalidateRelevantCacheKeys(ctx context.Context, f *flaggio.Flag) error {
 	redisCtx := r.redis.WithContext(ctx)
 
 	// invalidate all relevant keys
@@ -212,16 +327,12 @@ func (r *FlagRepository) invalidateRelevantCacheKeys(ctx context.Context, flagID
 	if err != nil {
 		return err
 	}
-	keysToInvalidate = append(
-		[]string{
-			flaggio.FlagCacheKey("*"),
-			flaggio.FlagCacheKey(flagID),
-			flaggio.FlagCacheKey("key", flagKey),
-		},
-		keysToInvalidate...,
-	)
+	keysToInvalidate = append(flagCacheKeys(f), keysToInvalidate...)
 
-	return redisCtx.Del(keysToInvalidate...).Err()
+	if err := redisCtx.Del(keysToInvalidate...).Err(); err != nil {
+		return err
+	}
+	return publishChange(redisCtx, flagChangeEvent(f))
 }
 
 // NewFlagRepository returns a new flag repository that uses redis
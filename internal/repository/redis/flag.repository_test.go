@@ -41,10 +41,10 @@ func TestFlagRepository_FindAll(t *testing.T) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
-				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil).
+				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, nil).
 					Times(1).Return(flagResults, nil)
 
-				res, err := flagRedisRepo.FindAll(ctx, nil, nil, nil)
+				res, err := flagRedisRepo.FindAll(ctx, nil, nil, nil, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, flagResults, res)
 			},
@@ -55,10 +55,10 @@ func TestFlagRepository_FindAll(t *testing.T) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
-				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil).
+				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, nil).
 					Times(0)
 
-				res, err := flagRedisRepo.FindAll(ctx, nil, nil, nil)
+				res, err := flagRedisRepo.FindAll(ctx, nil, nil, nil, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, flagResults, res)
 			},
@@ -69,10 +69,10 @@ func TestFlagRepository_FindAll(t *testing.T) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
-				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), stringPtr("my search"), nil, nil).
+				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, stringPtr("my search"), nil, nil).
 					Times(1).Return(flagResults, nil)
 
-				res, err := flagRedisRepo.FindAll(ctx, stringPtr("my search"), nil, nil)
+				res, err := flagRedisRepo.FindAll(ctx, nil, stringPtr("my search"), nil, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, flagResults, res)
 			},
@@ -83,10 +83,10 @@ func TestFlagRepository_FindAll(t *testing.T) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
-				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, int64Ptr(1), nil).
+				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, int64Ptr(1), nil).
 					Times(1).Return(flagResults, nil)
 
-				res, err := flagRedisRepo.FindAll(ctx, nil, int64Ptr(1), nil)
+				res, err := flagRedisRepo.FindAll(ctx, nil, nil, int64Ptr(1), nil)
 				assert.NoError(t, err)
 				assert.Equal(t, flagResults, res)
 			},
@@ -97,10 +97,10 @@ func TestFlagRepository_FindAll(t *testing.T) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
-				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, int64Ptr(10)).
+				flagStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, int64Ptr(10)).
 					Times(1).Return(flagResults, nil)
 
-				res, err := flagRedisRepo.FindAll(ctx, nil, nil, int64Ptr(10))
+				res, err := flagRedisRepo.FindAll(ctx, nil, nil, nil, int64Ptr(10))
 				assert.NoError(t, err)
 				assert.Equal(t, flagResults, res)
 			},
@@ -192,10 +192,10 @@ func TestFlagRepository_FindByKey(t *testing.T) {
 				defer cancel()
 				flg := flagResults.Flags[1]
 				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
-				flagStoreRepo.EXPECT().FindByKey(gomock.AssignableToTypeOf(ctxInterface), "f2").
+				flagStoreRepo.EXPECT().FindByKey(gomock.AssignableToTypeOf(ctxInterface), nil, "f2").
 					Times(1).Return(flg, nil)
 
-				res, err := flagRedisRepo.FindByKey(ctx, "f2")
+				res, err := flagRedisRepo.FindByKey(ctx, nil, "f2")
 				assert.NoError(t, err)
 				assert.Equal(t, flg, res)
 			},
@@ -207,14 +207,96 @@ func TestFlagRepository_FindByKey(t *testing.T) {
 				defer cancel()
 				flg := flagResults.Flags[1]
 				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
-				flagStoreRepo.EXPECT().FindByKey(gomock.AssignableToTypeOf(ctxInterface), "f2").
+				flagStoreRepo.EXPECT().FindByKey(gomock.AssignableToTypeOf(ctxInterface), nil, "f2").
 					Times(0)
 
-				res, err := flagRedisRepo.FindByKey(ctx, "f2")
+				res, err := flagRedisRepo.FindByKey(ctx, nil, "f2")
 				assert.NoError(t, err)
 				assert.Equal(t, flg, res)
 			},
 		},
+		{
+			name: "caches flags with the same key in other projects separately",
+			run: func(t *testing.T, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				flg := &flaggio.Flag{ID: "3", ProjectID: stringPtr("p1"), Key: "f2"}
+				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
+				flagStoreRepo.EXPECT().FindByKey(gomock.AssignableToTypeOf(ctxInterface), stringPtr("p1"), "f2").
+					Times(1).Return(flg, nil)
+
+				res, err := flagRedisRepo.FindByKey(ctx, stringPtr("p1"), "f2")
+				assert.NoError(t, err)
+				assert.Equal(t, flg, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+
+			tt.run(t, flagStoreRepo)
+		})
+	}
+}
+
+func TestFlagRepository_FindByKeys(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockFlag)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "calls underlying repository on cache miss",
+			run: func(t *testing.T, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
+				flagStoreRepo.EXPECT().FindByKeys(gomock.AssignableToTypeOf(ctxInterface), nil, []string{"f2", "f3"}).
+					Times(1).Return([]*flaggio.Flag{flagResults.Flags[1]}, nil)
+
+				res, err := flagRedisRepo.FindByKeys(ctx, nil, []string{"f2", "f3"})
+				assert.NoError(t, err)
+				assert.Equal(t, []*flaggio.Flag{flagResults.Flags[1]}, res)
+			},
+		},
+		{
+			name: "only calls underlying repository for keys not cached",
+			run: func(t *testing.T, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
+				flagStoreRepo.EXPECT().FindByKeys(gomock.AssignableToTypeOf(ctxInterface), nil, []string{"f1"}).
+					Times(1).Return([]*flaggio.Flag{flagResults.Flags[0]}, nil)
+
+				res, err := flagRedisRepo.FindByKeys(ctx, nil, []string{"f2", "f1"})
+				assert.NoError(t, err)
+				assert.Equal(t, []*flaggio.Flag{flagResults.Flags[1], flagResults.Flags[0]}, res)
+			},
+		},
+		{
+			name: "doesnt call underlying repository on cache hit",
+			run: func(t *testing.T, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
+				flagStoreRepo.EXPECT().FindByKeys(gomock.AssignableToTypeOf(ctxInterface), gomock.Any(), gomock.Any()).
+					Times(0)
+
+				res, err := flagRedisRepo.FindByKeys(ctx, nil, []string{"f1", "f2", "f1"})
+				assert.NoError(t, err)
+				assert.Equal(t, flagResults.Flags, res)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -332,7 +414,7 @@ func TestFlagRepository_Update(t *testing.T) {
 	}{
 		// these tests are meant to be run in order
 		{
-			name: "clears all cached evaluations & searches for flag when flag key is not provided",
+			name: "clears all cached evaluations",
 			run: func(t *testing.T, flagStoreRepo *repository_mock.MockFlag) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
@@ -366,7 +448,7 @@ func TestFlagRepository_Update(t *testing.T) {
 			},
 		},
 		{
-			name: "clears relevant cached flags & uses provided flag key",
+			name: "clears relevant cached flags under the previous flag key",
 			run: func(t *testing.T, flagStoreRepo *repository_mock.MockFlag) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
@@ -382,14 +464,15 @@ func TestFlagRepository_Update(t *testing.T) {
 				assert.Len(t, cachedKeys, 1)
 
 				// prepare repository mock
+				flg := flagResults.Flags[0]
 				flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
-				flagStoreRepo.EXPECT().Update(gomock.AssignableToTypeOf(ctxInterface), "1", flaggio.UpdateFlag{Key: stringPtr("f1")}).
+				flagStoreRepo.EXPECT().Update(gomock.AssignableToTypeOf(ctxInterface), "1", flaggio.UpdateFlag{Key: stringPtr("f9")}).
 					Times(1).Return(nil)
 				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
-					Times(0)
+					Times(1).Return(flg, nil)
 
 				// call redis repository
-				err = flagRedisRepo.Update(ctx, "1", flaggio.UpdateFlag{Key: stringPtr("f1")})
+				err = flagRedisRepo.Update(ctx, "1", flaggio.UpdateFlag{Key: stringPtr("f9")})
 				assert.NoError(t, err)
 
 				// check cached keys are cleared
@@ -505,6 +588,47 @@ func TestFlagRepository_Delete(t *testing.T) {
 	}
 }
 
+func TestFlagRepository_Rollback(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	redisCtx := redisClient.WithContext(ctx)
+
+	// cache an evaluation and a flag
+	err := redisCtx.Set(flaggio.EvalCacheKey("test"), "whatever", 10*time.Minute).Err()
+	assert.NoError(t, err)
+	err = redisCtx.Set(flaggio.FlagCacheKey("key", "f1"), "whatever", 10*time.Minute).Err()
+	assert.NoError(t, err)
+
+	// prepare repository mock
+	flg := flagResults.Flags[0]
+	flagRedisRepo := redis_repo.NewFlagRepository(redisClient, flagStoreRepo)
+	flagStoreRepo.EXPECT().Rollback(gomock.AssignableToTypeOf(ctxInterface), "1", 2).
+		Times(1).Return(nil)
+	flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+		Times(1).Return(flg, nil)
+
+	// call redis repository
+	err = flagRedisRepo.Rollback(ctx, "1", 2)
+	assert.NoError(t, err)
+
+	// check cached keys are cleared
+	cachedKeys, err := redisCtx.Keys(flaggio.EvalCacheKey("*")).Result()
+	assert.NoError(t, err)
+	assert.Len(t, cachedKeys, 0)
+	cachedKeys, err = redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+	assert.NoError(t, err)
+	assert.Len(t, cachedKeys, 0)
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
@@ -0,0 +1,163 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	"github.com/vmihailenco/msgpack/v4"
+)
+
+var _ repository.Project = (*ProjectRepository)(nil)
+
+// ProjectRepository implements repository.Project interface using redis.
+type ProjectRepository struct {
+	redis *redis.Client
+	store repository.Project
+	ttl   time.Duration
+}
+
+// FindAll returns a list of projects.
+func (r *ProjectRepository) FindAll(ctx context.Context) ([]*flaggio.Project, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisProjectRepository.FindAll")
+	defer span.Finish()
+
+	// no caching for listing projects
+	return r.store.FindAll(ctx)
+}
+
+// FindByID returns a project that has a given ID.
+func (r *ProjectRepository) FindByID(ctx context.Context, id string) (*flaggio.Project, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisProjectRepository.FindByID")
+	defer span.Finish()
+
+	return r.findCached(ctx, flaggio.ProjectCacheKey(id), func() (*flaggio.Project, error) {
+		return r.store.FindByID(ctx, id)
+	})
+}
+
+// FindByKey returns a project that has a given key.
+func (r *ProjectRepository) FindByKey(ctx context.Context, key string) (*flaggio.Project, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisProjectRepository.FindByKey")
+	defer span.Finish()
+
+	return r.findCached(ctx, flaggio.ProjectCacheKey("key", key), func() (*flaggio.Project, error) {
+		return r.store.FindByKey(ctx, key)
+	})
+}
+
+func (r *ProjectRepository) findCached(ctx context.Context, cacheKey string,
+	find func() (*flaggio.Project, error)) (*flaggio.Project, error) {
+	// fetch results from cache
+	cached, err := r.redis.WithContext(ctx).Get(cacheKey).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		// an unexpected error occurred, return it
+		return nil, err
+	}
+	if cached != "" {
+		// cache hit, unmarshall and return result
+		var p flaggio.Project
+		if err := msgpack.Unmarshal([]byte(cached), &p); err == nil {
+			// return if no errors, otherwise defer to the store
+			return &p, nil
+		}
+	}
+
+	// cache miss, fetch from store
+	res, err := find()
+	if err != nil {
+		return nil, err
+	}
+
+	// marshall and save result
+	b, err := msgpack.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.redis.WithContext(ctx).Set(cacheKey, b, r.ttl).Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Create creates a new project.
+func (r *ProjectRepository) Create(ctx context.Context, input flaggio.NewProject) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisProjectRepository.Create")
+	defer span.Finish()
+
+	// nothing to invalidate
+	return r.store.Create(ctx, input)
+}
+
+// Update updates a project.
+func (r *ProjectRepository) Update(ctx context.Context, id string, input flaggio.UpdateProject) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisProjectRepository.Update")
+	defer span.Finish()
+
+	// find the project so we can get the project key
+	p, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := r.store.Update(ctx, id, input); err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys
+	return r.invalidateRelevantCacheKeys(ctx, p)
+}
+
+// Delete deletes a project. Projects that still own flags or segments
+// can't be deleted.
+func (r *ProjectRepository) Delete(ctx context.Context, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisProjectRepository.Delete")
+	defer span.Finish()
+
+	// find the project so we can get the project key
+	p, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := r.store.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys
+	return r.invalidateRelevantCacheKeys(ctx, p)
+}
+
+func (r *ProjectRepository) invalidateRelevantCacheKeys(ctx context.Context, p *flaggio.Project) error {
+	redisCtx := r.redis.WithContext(ctx)
+
+	// invalidate all relevant keys
+	keysToInvalidate, err := redisCtx.Keys(flaggio.EvalCacheKey("*")).Result()
+	if err != nil {
+		return err
+	}
+	keysToInvalidate = append(
+		[]string{
+			flaggio.ProjectCacheKey(p.ID),
+			flaggio.ProjectCacheKey("key", p.Key),
+		},
+		keysToInvalidate...,
+	)
+
+	return redisCtx.Del(keysToInvalidate...).Err()
+}
+
+// NewProjectRepository returns a new project repository that uses redis
+// as underlying storage.
+func NewProjectRepository(redisClient *redis.Client, store repository.Project) repository.Project {
+	return &ProjectRepository{
+		redis: redisClient,
+		store: store,
+		ttl:   1 * time.Hour,
+	}
+}
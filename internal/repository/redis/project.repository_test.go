@@ -0,0 +1,130 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	repository_mock "github.com/victorkt/flaggio/internal/repository/mocks"
+	redis_repo "github.com/victorkt/flaggio/internal/repository/redis"
+)
+
+var (
+	prj = &flaggio.Project{
+		ID: "1", Key: "checkout", Name: "Checkout",
+	}
+)
+
+func TestProjectRepository_FindByKey(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockProject)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "calls underlying repository on cache miss",
+			run: func(t *testing.T, projectStoreRepo *repository_mock.MockProject) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				projectRedisRepo := redis_repo.NewProjectRepository(redisClient, projectStoreRepo)
+				projectStoreRepo.EXPECT().FindByKey(gomock.AssignableToTypeOf(ctxInterface), "checkout").
+					Times(1).Return(prj, nil)
+
+				res, err := projectRedisRepo.FindByKey(ctx, "checkout")
+				assert.NoError(t, err)
+				assert.Equal(t, prj, res)
+			},
+		},
+		{
+			name: "doesnt call underlying repository on cache hit",
+			run: func(t *testing.T, projectStoreRepo *repository_mock.MockProject) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				projectRedisRepo := redis_repo.NewProjectRepository(redisClient, projectStoreRepo)
+				projectStoreRepo.EXPECT().FindByKey(gomock.AssignableToTypeOf(ctxInterface), "checkout").
+					Times(0)
+
+				res, err := projectRedisRepo.FindByKey(ctx, "checkout")
+				assert.NoError(t, err)
+				assert.Equal(t, prj, res)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			projectStoreRepo := repository_mock.NewMockProject(mockCtrl)
+
+			tt.run(t, projectStoreRepo)
+		})
+	}
+}
+
+func TestProjectRepository_Update(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockProject)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "clears all cached evaluations and relevant cached projects",
+			run: func(t *testing.T, projectStoreRepo *repository_mock.MockProject) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache an evaluation and a project
+				err := redisCtx.Set(flaggio.EvalCacheKey("test"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+				err = redisCtx.Set(flaggio.ProjectCacheKey("key", "checkout"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// prepare repository mock
+				projectRedisRepo := redis_repo.NewProjectRepository(redisClient, projectStoreRepo)
+				projectStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return(prj, nil)
+				projectStoreRepo.EXPECT().Update(gomock.AssignableToTypeOf(ctxInterface), "1", flaggio.UpdateProject{}).
+					Times(1).Return(nil)
+
+				// call redis repository
+				err = projectRedisRepo.Update(ctx, "1", flaggio.UpdateProject{})
+				assert.NoError(t, err)
+
+				// check cached keys are cleared
+				cachedKeys, err := redisCtx.Keys(flaggio.EvalCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 0)
+				cachedKeys, err = redisCtx.Keys(flaggio.ProjectCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			projectStoreRepo := repository_mock.NewMockProject(mockCtrl)
+
+			tt.run(t, projectStoreRepo)
+		})
+	}
+}
@@ -22,20 +22,20 @@ type RuleRepository struct {
 }
 
 // FindFlagRuleByID returns a flag rule that has a given ID.
-func (r *RuleRepository) FindFlagRuleByID(ctx context.Context, flagIDHex, idHex string) (*flaggio.FlagRule, error) {
+func (r *RuleRepository) FindFlagRuleByID(ctx context.Context, flagIDHex string, environmentIDHex *string, idHex string) (*flaggio.FlagRule, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRuleRepository.FindFlagRuleByID")
 	defer span.Finish()
 
 	// no caching for rules
-	return r.store.FindFlagRuleByID(ctx, flagIDHex, idHex)
+	return r.store.FindFlagRuleByID(ctx, flagIDHex, environmentIDHex, idHex)
 }
 
 // CreateFlagRule creates a new rule under a flag.
-func (r *RuleRepository) CreateFlagRule(ctx context.Context, flagID string, input flaggio.NewFlagRule) (string, error) {
+func (r *RuleRepository) CreateFlagRule(ctx context.Context, flagID string, environmentID *string, input flaggio.NewFlagRule) (string, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRuleRepository.CreateFlagRule")
 	defer span.Finish()
 
-	id, err := r.store.CreateFlagRule(ctx, flagID, input)
+	id, err := r.store.CreateFlagRule(ctx, flagID, environmentID, input)
 	if err != nil {
 		return "", err
 	}
@@ -45,11 +45,11 @@ func (r *RuleRepository) CreateFlagRule(ctx context.Context, flagID string, inpu
 }
 
 // UpdateFlagRule updates a rule under a flag.
-func (r *RuleRepository) UpdateFlagRule(ctx context.Context, flagID, id string, input flaggio.UpdateFlagRule) error {
+func (r *RuleRepository) UpdateFlagRule(ctx context.Context, flagID string, environmentID *string, id string, input flaggio.UpdateFlagRule) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRuleRepository.UpdateFlagRule")
 	defer span.Finish()
 
-	err := r.store.UpdateFlagRule(ctx, flagID, id, input)
+	err := r.store.UpdateFlagRule(ctx, flagID, environmentID, id, input)
 	if err != nil {
 		return err
 	}
@@ -59,11 +59,11 @@ func (r *RuleRepository) UpdateFlagRule(ctx context.Context, flagID, id string,
 }
 
 // DeleteFlagRule deletes a rule under a flag.
-func (r *RuleRepository) DeleteFlagRule(ctx context.Context, flagID, id string) error {
+func (r *RuleRepository) DeleteFlagRule(ctx context.Context, flagID string, environmentID *string, id string) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRuleRepository.DeleteFlagRule")
 	defer span.Finish()
 
-	err := r.store.DeleteFlagRule(ctx, flagID, id)
+	err := r.store.DeleteFlagRule(ctx, flagID, environmentID, id)
 	if err != nil {
 		return err
 	}
@@ -114,7 +114,7 @@ func (r *RuleRepository) DeleteSegmentRule(ctx context.Context, segmentID, id st
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisRuleRepository.DeleteSegmentRule")
 	defer span.Finish()
 
-	err := r.store.DeleteFlagRule(ctx, segmentID, id)
+	err := r.store.DeleteSegmentRule(ctx, segmentID, id)
 	if err != nil {
 		return err
 	}
@@ -124,7 +124,7 @@ func (r *RuleRepository) DeleteSegmentRule(ctx context.Context, segmentID, id st
 }
 
 func (r *RuleRepository) invalidateFlagRelevantCacheKeys(ctx context.Context, flagID string) error {
-	// find the flag so we can get the flag key
+	// find the flag so we can get the flag key and project
 	f, err := r.flagStore.FindByID(ctx, flagID)
 	if err != nil {
 		return err
@@ -137,19 +137,21 @@ func (r *RuleRepository) invalidateFlagRelevantCacheKeys(ctx context.Context, fl
 	if err != nil {
 		return err
 	}
-	keysToInvalidate = append(
-		[]string{
-			flaggio.FlagCacheKey("*"),
-			flaggio.FlagCacheKey(flagID),
-			flaggio.FlagCacheKey("key", f.Key),
-		},
-		keysToInvalidate...,
-	)
-
-	return redisCtx.Del(keysToInvalidate...).Err()
+	keysToInvalidate = append(flagCacheKeys(f), keysToInvalidate...)
+
+	if err := redisCtx.Del(keysToInvalidate...).Err(); err != nil {
+		return err
+	}
+	return publishChange(redisCtx, flagChangeEvent(f))
 }
 
 func (r *RuleRepository) invalidateSegmentRelevantCacheKeys(ctx context.Context, segmentID string) error {
+	// find the segment so we can get the segment project
+	sgmnt, err := r.segmentStore.FindByID(ctx, segmentID)
+	if err != nil {
+		return err
+	}
+
 	redisCtx := r.redis.WithContext(ctx)
 
 	// invalidate all relevant keys
@@ -157,15 +159,12 @@ func (r *RuleRepository) invalidateSegmentRelevantCacheKeys(ctx context.Context,
 	if err != nil {
 		return err
 	}
-	keysToInvalidate = append(
-		[]string{
-			flaggio.SegmentCacheKey("*"),
-			flaggio.SegmentCacheKey(segmentID),
-		},
-		keysToInvalidate...,
-	)
-
-	return redisCtx.Del(keysToInvalidate...).Err()
+	keysToInvalidate = append(segmentCacheKeys(sgmnt), keysToInvalidate...)
+
+	if err := redisCtx.Del(keysToInvalidate...).Err(); err != nil {
+		return err
+	}
+	return publishChange(redisCtx, segmentChangeEvent(sgmnt))
 }
 
 // NewRuleRepository returns a new rule repository that uses redis
@@ -38,14 +38,14 @@ func TestRuleRepository_FindFlagRuleByID(t *testing.T) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 				ruleRedisRepo := redis_repo.NewRuleRepository(redisClient, ruleStoreRepo, flagStoreRepo, segmentStoreRepo)
-				ruleStoreRepo.EXPECT().FindFlagRuleByID(gomock.AssignableToTypeOf(ctxInterface), "2", "1").
+				ruleStoreRepo.EXPECT().FindFlagRuleByID(gomock.AssignableToTypeOf(ctxInterface), "2", nil, "1").
 					Times(2).Return(frl, nil)
 
-				res, err := ruleRedisRepo.FindFlagRuleByID(ctx, "2", "1")
+				res, err := ruleRedisRepo.FindFlagRuleByID(ctx, "2", nil, "1")
 				assert.NoError(t, err)
 				assert.Equal(t, frl, res)
 
-				res2, err2 := ruleRedisRepo.FindFlagRuleByID(ctx, "2", "1")
+				res2, err2 := ruleRedisRepo.FindFlagRuleByID(ctx, "2", nil, "1")
 				assert.NoError(t, err2)
 				assert.Equal(t, frl, res2)
 			},
@@ -96,13 +96,13 @@ func TestRuleRepository_CreateFlagRule(t *testing.T) {
 				// prepare repository mock
 				flg := flagResults.Flags[0]
 				ruleRedisRepo := redis_repo.NewRuleRepository(redisClient, ruleStoreRepo, flagStoreRepo, segmentStoreRepo)
-				ruleStoreRepo.EXPECT().CreateFlagRule(gomock.AssignableToTypeOf(ctxInterface), "2", flaggio.NewFlagRule{}).
+				ruleStoreRepo.EXPECT().CreateFlagRule(gomock.AssignableToTypeOf(ctxInterface), "2", nil, flaggio.NewFlagRule{}).
 					Times(1).Return(frl.ID, nil)
 				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
 					Times(1).Return(flg, nil)
 
 				// call redis repository
-				id, err := ruleRedisRepo.CreateFlagRule(ctx, "2", flaggio.NewFlagRule{})
+				id, err := ruleRedisRepo.CreateFlagRule(ctx, "2", nil, flaggio.NewFlagRule{})
 				assert.NoError(t, err)
 				assert.Equal(t, frl.ID, id)
 
@@ -131,13 +131,13 @@ func TestRuleRepository_CreateFlagRule(t *testing.T) {
 				// prepare repository mock
 				flg := flagResults.Flags[0]
 				ruleRedisRepo := redis_repo.NewRuleRepository(redisClient, ruleStoreRepo, flagStoreRepo, segmentStoreRepo)
-				ruleStoreRepo.EXPECT().CreateFlagRule(gomock.AssignableToTypeOf(ctxInterface), "2", flaggio.NewFlagRule{}).
+				ruleStoreRepo.EXPECT().CreateFlagRule(gomock.AssignableToTypeOf(ctxInterface), "2", nil, flaggio.NewFlagRule{}).
 					Times(1).Return(frl.ID, nil)
 				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
 					Times(1).Return(flg, nil)
 
 				// call redis repository
-				id, err := ruleRedisRepo.CreateFlagRule(ctx, "2", flaggio.NewFlagRule{})
+				id, err := ruleRedisRepo.CreateFlagRule(ctx, "2", nil, flaggio.NewFlagRule{})
 				assert.NoError(t, err)
 				assert.Equal(t, frl.ID, id)
 
@@ -193,13 +193,13 @@ func TestRuleRepository_UpdateFlagRule(t *testing.T) {
 				// prepare repository mock
 				flg := flagResults.Flags[0]
 				ruleRedisRepo := redis_repo.NewRuleRepository(redisClient, ruleStoreRepo, flagStoreRepo, segmentStoreRepo)
-				ruleStoreRepo.EXPECT().UpdateFlagRule(gomock.AssignableToTypeOf(ctxInterface), "2", "1", flaggio.UpdateFlagRule{}).
+				ruleStoreRepo.EXPECT().UpdateFlagRule(gomock.AssignableToTypeOf(ctxInterface), "2", nil, "1", flaggio.UpdateFlagRule{}).
 					Times(1).Return(nil)
 				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
 					Times(1).Return(flg, nil)
 
 				// call redis repository
-				err = ruleRedisRepo.UpdateFlagRule(ctx, "2", "1", flaggio.UpdateFlagRule{})
+				err = ruleRedisRepo.UpdateFlagRule(ctx, "2", nil, "1", flaggio.UpdateFlagRule{})
 				assert.NoError(t, err)
 
 				// check cached keys are cleared
@@ -227,13 +227,13 @@ func TestRuleRepository_UpdateFlagRule(t *testing.T) {
 				// prepare repository mock
 				flg := flagResults.Flags[0]
 				ruleRedisRepo := redis_repo.NewRuleRepository(redisClient, ruleStoreRepo, flagStoreRepo, segmentStoreRepo)
-				ruleStoreRepo.EXPECT().UpdateFlagRule(gomock.AssignableToTypeOf(ctxInterface), "2", "1", flaggio.UpdateFlagRule{}).
+				ruleStoreRepo.EXPECT().UpdateFlagRule(gomock.AssignableToTypeOf(ctxInterface), "2", nil, "1", flaggio.UpdateFlagRule{}).
 					Times(1).Return(nil)
 				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
 					Times(1).Return(flg, nil)
 
 				// call redis repository
-				err = ruleRedisRepo.UpdateFlagRule(ctx, "2", "1", flaggio.UpdateFlagRule{})
+				err = ruleRedisRepo.UpdateFlagRule(ctx, "2", nil, "1", flaggio.UpdateFlagRule{})
 				assert.NoError(t, err)
 
 				// check cached keys are cleared
@@ -288,13 +288,13 @@ func TestRuleRepository_DeleteFlagRule(t *testing.T) {
 				// prepare repository mock
 				flg := flagResults.Flags[0]
 				ruleRedisRepo := redis_repo.NewRuleRepository(redisClient, ruleStoreRepo, flagStoreRepo, segmentStoreRepo)
-				ruleStoreRepo.EXPECT().DeleteFlagRule(gomock.AssignableToTypeOf(ctxInterface), "2", "1").
+				ruleStoreRepo.EXPECT().DeleteFlagRule(gomock.AssignableToTypeOf(ctxInterface), "2", nil, "1").
 					Times(1).Return(nil)
 				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
 					Times(1).Return(flg, nil)
 
 				// call redis repository
-				err = ruleRedisRepo.DeleteFlagRule(ctx, "2", "1")
+				err = ruleRedisRepo.DeleteFlagRule(ctx, "2", nil, "1")
 				assert.NoError(t, err)
 
 				// check cached keys are cleared
@@ -322,13 +322,13 @@ func TestRuleRepository_DeleteFlagRule(t *testing.T) {
 				// prepare repository mock
 				flg := flagResults.Flags[0]
 				ruleRedisRepo := redis_repo.NewRuleRepository(redisClient, ruleStoreRepo, flagStoreRepo, segmentStoreRepo)
-				ruleStoreRepo.EXPECT().DeleteFlagRule(gomock.AssignableToTypeOf(ctxInterface), "2", "1").
+				ruleStoreRepo.EXPECT().DeleteFlagRule(gomock.AssignableToTypeOf(ctxInterface), "2", nil, "1").
 					Times(1).Return(nil)
 				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
 					Times(1).Return(flg, nil)
 
 				// call redis repository
-				err = ruleRedisRepo.DeleteFlagRule(ctx, "2", "1")
+				err = ruleRedisRepo.DeleteFlagRule(ctx, "2", nil, "1")
 				assert.NoError(t, err)
 
 				// check cached keys are cleared
@@ -429,6 +429,8 @@ func TestRuleRepository_CreateSegmentRule(t *testing.T) {
 				ruleRedisRepo := redis_repo.NewRuleRepository(redisClient, ruleStoreRepo, flagStoreRepo, segmentStoreRepo)
 				ruleStoreRepo.EXPECT().CreateSegmentRule(gomock.AssignableToTypeOf(ctxInterface), "1", flaggio.NewSegmentRule{}).
 					Times(1).Return(srl.ID, nil)
+				segmentStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return(&flaggio.Segment{ID: "1"}, nil)
 
 				// call redis repository
 				id, err := ruleRedisRepo.CreateSegmentRule(ctx, "1", flaggio.NewSegmentRule{})
@@ -461,6 +463,8 @@ func TestRuleRepository_CreateSegmentRule(t *testing.T) {
 				ruleRedisRepo := redis_repo.NewRuleRepository(redisClient, ruleStoreRepo, flagStoreRepo, segmentStoreRepo)
 				ruleStoreRepo.EXPECT().CreateSegmentRule(gomock.AssignableToTypeOf(ctxInterface), "1", flaggio.NewSegmentRule{}).
 					Times(1).Return(srl.ID, nil)
+				segmentStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return(&flaggio.Segment{ID: "1"}, nil)
 
 				// call redis repository
 				id, err := ruleRedisRepo.CreateSegmentRule(ctx, "1", flaggio.NewSegmentRule{})
@@ -520,6 +524,8 @@ func TestRuleRepository_UpdateSegmentRule(t *testing.T) {
 				ruleRedisRepo := redis_repo.NewRuleRepository(redisClient, ruleStoreRepo, flagStoreRepo, segmentStoreRepo)
 				ruleStoreRepo.EXPECT().UpdateSegmentRule(gomock.AssignableToTypeOf(ctxInterface), "1", "1", flaggio.UpdateSegmentRule{}).
 					Times(1).Return(nil)
+				segmentStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return(&flaggio.Segment{ID: "1"}, nil)
 
 				// call redis repository
 				err = ruleRedisRepo.UpdateSegmentRule(ctx, "1", "1", flaggio.UpdateSegmentRule{})
@@ -551,6 +557,8 @@ func TestRuleRepository_UpdateSegmentRule(t *testing.T) {
 				ruleRedisRepo := redis_repo.NewRuleRepository(redisClient, ruleStoreRepo, flagStoreRepo, segmentStoreRepo)
 				ruleStoreRepo.EXPECT().UpdateSegmentRule(gomock.AssignableToTypeOf(ctxInterface), "1", "1", flaggio.UpdateSegmentRule{}).
 					Times(1).Return(nil)
+				segmentStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return(&flaggio.Segment{ID: "1"}, nil)
 
 				// call redis repository
 				err = ruleRedisRepo.UpdateSegmentRule(ctx, "1", "1", flaggio.UpdateSegmentRule{})
@@ -609,6 +617,8 @@ func DeleteSegmentRule(t *testing.T) {
 				ruleRedisRepo := redis_repo.NewRuleRepository(redisClient, ruleStoreRepo, flagStoreRepo, segmentStoreRepo)
 				ruleStoreRepo.EXPECT().DeleteSegmentRule(gomock.AssignableToTypeOf(ctxInterface), "1", "1").
 					Times(1).Return(nil)
+				segmentStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return(&flaggio.Segment{ID: "1"}, nil)
 
 				// call redis repository
 				err = ruleRedisRepo.DeleteSegmentRule(ctx, "1", "1")
@@ -640,6 +650,8 @@ func DeleteSegmentRule(t *testing.T) {
 				ruleRedisRepo := redis_repo.NewRuleRepository(redisClient, ruleStoreRepo, flagStoreRepo, segmentStoreRepo)
 				ruleStoreRepo.EXPECT().DeleteSegmentRule(gomock.AssignableToTypeOf(ctxInterface), "1", "1").
 					Times(1).Return(nil)
+				segmentStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return(&flaggio.Segment{ID: "1"}, nil)
 
 				// call redis repository
 				err = ruleRedisRepo.DeleteSegmentRule(ctx, "1", "1")
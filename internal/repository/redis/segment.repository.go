@@ -21,13 +21,14 @@ type SegmentRepository struct {
 	ttl   time.Duration
 }
 
-// FindAll returns a list of segments, based on an optional offset and limit.
-func (r *SegmentRepository) FindAll(ctx context.Context, offset, limit *int64) ([]*flaggio.Segment, error) {
+// FindAll returns a list of segments in a project, based on an optional offset and limit.
+// If no project ID is given, only segments that don't belong to a project are returned.
+func (r *SegmentRepository) FindAll(ctx context.Context, projectID *string, offset, limit *int64) ([]*flaggio.Segment, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisSegmentRepository.FindAll")
 	defer span.Finish()
 
 	shouldCache := shouldCacheFindAll(nil, offset, limit)
-	cacheKey := flaggio.SegmentCacheKey("*")
+	cacheKey := segmentsCacheKey(projectID)
 
 	if shouldCache {
 		// fetch results from cache
@@ -47,7 +48,7 @@ func (r *SegmentRepository) FindAll(ctx context.Context, offset, limit *int64) (
 	}
 
 	// cache miss or disabled, fetch from store
-	res, err := r.store.FindAll(ctx, offset, limit)
+	res, err := r.store.FindAll(ctx, projectID, offset, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +118,7 @@ func (r *SegmentRepository) Create(ctx context.Context, input flaggio.NewSegment
 	}
 
 	// invalidate all relevant keys
-	return id, r.invalidateRelevantCacheKeys(ctx, id)
+	return id, r.invalidateRelevantCacheKeys(ctx, &flaggio.Segment{ID: id, ProjectID: input.ProjectID})
 }
 
 // Update updates a segment.
@@ -125,12 +126,18 @@ func (r *SegmentRepository) Update(ctx context.Context, id string, input flaggio
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisSegmentRepository.Update")
 	defer span.Finish()
 
+	// find the segment so we can get the segment project
+	s, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	if err := r.store.Update(ctx, id, input); err != nil {
 		return err
 	}
 
 	// invalidate all relevant keys
-	return r.invalidateRelevantCacheKeys(ctx, id)
+	return r.invalidateRelevantCacheKeys(ctx, s)
 }
 
 // Delete deletes a segment.
@@ -138,15 +145,21 @@ func (r *SegmentRepository) Delete(ctx context.Context, id string) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisSegmentRepository.Delete")
 	defer span.Finish()
 
+	// find the segment so we can get the segment project
+	s, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	if err := r.store.Delete(ctx, id); err != nil {
 		return err
 	}
 
 	// invalidate all relevant keys
-	return r.invalidateRelevantCacheKeys(ctx, id)
+	return r.invalidateRelevantCacheKeys(ctx, s)
 }
 
-func (r *SegmentRepository) invalidateRelevantCacheKeys(ctx context.Context, segmentID string) error {
+func (r *SegmentRepository) invalidateRelevantCacheKeys(ctx context.Context, s *flaggio.Segment) error {
 	redisCtx := r.redis.WithContext(ctx)
 
 	// invalidate all relevant keys
@@ -154,15 +167,12 @@ func (r *SegmentRepository) invalidateRelevantCacheKeys(ctx context.Context, seg
 	if err != nil {
 		return err
 	}
-	keysToInvalidate = append(
-		[]string{
-			flaggio.SegmentCacheKey("*"),
-			flaggio.SegmentCacheKey(segmentID),
-		},
-		keysToInvalidate...,
-	)
+	keysToInvalidate = append(segmentCacheKeys(s), keysToInvalidate...)
 
-	return redisCtx.Del(keysToInvalidate...).Err()
+	if err := redisCtx.Del(keysToInvalidate...).Err(); err != nil {
+		return err
+	}
+	return publishChange(redisCtx, segmentChangeEvent(s))
 }
 
 // NewSegmentRepository returns a new segment repository that uses redis
@@ -36,10 +36,10 @@ func TestSegmentRepository_FindAll(t *testing.T) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 				segmentRedisRepo := redis_repo.NewSegmentRepository(redisClient, segmmentStoreRepo)
-				segmmentStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil).
+				segmmentStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil).
 					Times(1).Return(segmentResults, nil)
 
-				res, err := segmentRedisRepo.FindAll(ctx, nil, nil)
+				res, err := segmentRedisRepo.FindAll(ctx, nil, nil, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, segmentResults, res)
 			},
@@ -50,10 +50,10 @@ func TestSegmentRepository_FindAll(t *testing.T) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 				segmentRedisRepo := redis_repo.NewSegmentRepository(redisClient, segmmentStoreRepo)
-				segmmentStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil).
+				segmmentStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil).
 					Times(0)
 
-				res, err := segmentRedisRepo.FindAll(ctx, nil, nil)
+				res, err := segmentRedisRepo.FindAll(ctx, nil, nil, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, segmentResults, res)
 			},
@@ -64,10 +64,10 @@ func TestSegmentRepository_FindAll(t *testing.T) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 				segmentRedisRepo := redis_repo.NewSegmentRepository(redisClient, segmmentStoreRepo)
-				segmmentStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), int64Ptr(1), nil).
+				segmmentStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, int64Ptr(1), nil).
 					Times(1).Return(segmentResults, nil)
 
-				res, err := segmentRedisRepo.FindAll(ctx, int64Ptr(1), nil)
+				res, err := segmentRedisRepo.FindAll(ctx, nil, int64Ptr(1), nil)
 				assert.NoError(t, err)
 				assert.Equal(t, segmentResults, res)
 			},
@@ -78,10 +78,10 @@ func TestSegmentRepository_FindAll(t *testing.T) {
 				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 				defer cancel()
 				segmentRedisRepo := redis_repo.NewSegmentRepository(redisClient, segmmentStoreRepo)
-				segmmentStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, int64Ptr(10)).
+				segmmentStoreRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, int64Ptr(10)).
 					Times(1).Return(segmentResults, nil)
 
-				res, err := segmentRedisRepo.FindAll(ctx, nil, int64Ptr(10))
+				res, err := segmentRedisRepo.FindAll(ctx, nil, nil, int64Ptr(10))
 				assert.NoError(t, err)
 				assert.Equal(t, segmentResults, res)
 			},
@@ -275,6 +275,8 @@ func TestSegmentRepository_Update(t *testing.T) {
 
 				// prepare repository mock
 				segmentRedisRepo := redis_repo.NewSegmentRepository(redisClient, segmentStoreRepo)
+				segmentStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return(segmentResults[0], nil)
 				segmentStoreRepo.EXPECT().Update(gomock.AssignableToTypeOf(ctxInterface), "1", flaggio.UpdateSegment{Name: stringPtr("s1")}).
 					Times(1).Return(nil)
 
@@ -306,6 +308,8 @@ func TestSegmentRepository_Update(t *testing.T) {
 
 				// prepare repository mock
 				segmentRedisRepo := redis_repo.NewSegmentRepository(redisClient, segmentStoreRepo)
+				segmentStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return(segmentResults[0], nil)
 				segmentStoreRepo.EXPECT().Update(gomock.AssignableToTypeOf(ctxInterface), "1", flaggio.UpdateSegment{Name: stringPtr("s1")}).
 					Times(1).Return(nil)
 
@@ -362,6 +366,8 @@ func TestSegmentRepository_Delete(t *testing.T) {
 
 				// prepare repository mock
 				segmentRedisRepo := redis_repo.NewSegmentRepository(redisClient, segmentStoreRepo)
+				segmentStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return(segmentResults[0], nil)
 				segmentStoreRepo.EXPECT().Delete(gomock.AssignableToTypeOf(ctxInterface), "1").
 					Times(1).Return(nil)
 
@@ -393,6 +399,8 @@ func TestSegmentRepository_Delete(t *testing.T) {
 
 				// prepare repository mock
 				segmentRedisRepo := redis_repo.NewSegmentRepository(redisClient, segmentStoreRepo)
+				segmentStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "1").
+					Times(1).Return(segmentResults[0], nil)
 				segmentStoreRepo.EXPECT().Delete(gomock.AssignableToTypeOf(ctxInterface), "1").
 					Times(1).Return(nil)
 
@@ -0,0 +1,105 @@
+package redis
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v7"
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+)
+
+var _ repository.Target = (*TargetRepository)(nil)
+
+// TargetRepository implements repository.Target interface using redis.
+type TargetRepository struct {
+	redis     *redis.Client
+	store     repository.Target
+	flagStore repository.Flag
+}
+
+// FindAllByFlagID returns the targets of a flag, one for each targeted variant.
+func (r *TargetRepository) FindAllByFlagID(ctx context.Context, flagID string) ([]*flaggio.FlagTarget, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisTargetRepository.FindAllByFlagID")
+	defer span.Finish()
+
+	// no caching for targets
+	return r.store.FindAllByFlagID(ctx, flagID)
+}
+
+// FindAllByFlagIDs returns the targets of all the given flags, one for each
+// targeted variant of each flag.
+func (r *TargetRepository) FindAllByFlagIDs(ctx context.Context, flagIDs []string) ([]*flaggio.FlagTarget, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisTargetRepository.FindAllByFlagIDs")
+	defer span.Finish()
+
+	// no caching for targets
+	return r.store.FindAllByFlagIDs(ctx, flagIDs)
+}
+
+// FindAllByUserID returns the targets that include a given user, across all flags.
+func (r *TargetRepository) FindAllByUserID(ctx context.Context, userID string) ([]*flaggio.FlagTarget, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisTargetRepository.FindAllByUserID")
+	defer span.Finish()
+
+	// no caching for targets, evaluations are cached instead
+	return r.store.FindAllByUserID(ctx, userID)
+}
+
+// AddUsers adds users to the target of a flag variant.
+func (r *TargetRepository) AddUsers(ctx context.Context, flagID, variantID string, users []string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisTargetRepository.AddUsers")
+	defer span.Finish()
+
+	if err := r.store.AddUsers(ctx, flagID, variantID, users); err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys
+	return r.invalidateRelevantCacheKeys(ctx, flagID)
+}
+
+// RemoveUsers removes users from the target of a flag variant.
+func (r *TargetRepository) RemoveUsers(ctx context.Context, flagID, variantID string, users []string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisTargetRepository.RemoveUsers")
+	defer span.Finish()
+
+	if err := r.store.RemoveUsers(ctx, flagID, variantID, users); err != nil {
+		return err
+	}
+
+	// invalidate all relevant keys
+	return r.invalidateRelevantCacheKeys(ctx, flagID)
+}
+
+func (r *TargetRepository) invalidateRelevantCacheKeys(ctx context.Context, flagID string) error {
+	// find the flag so we can get the flag key and project
+	f, err := r.flagStore.FindByID(ctx, flagID)
+	if err != nil {
+		return err
+	}
+
+	redisCtx := r.redis.WithContext(ctx)
+
+	// invalidate all relevant keys
+	keysToInvalidate, err := redisCtx.Keys(flaggio.EvalCacheKey("*")).Result()
+	if err != nil {
+		return err
+	}
+	keysToInvalidate = append(flagCacheKeys(f), keysToInvalidate...)
+
+	if err := redisCtx.Del(keysToInvalidate...).Err(); err != nil {
+		return err
+	}
+	return publishChange(redisCtx, flagChangeEvent(f))
+}
+
+// NewTargetRepository returns a new target repository that uses redis
+// as underlying storage.
+func NewTargetRepository(redisClient *redis.Client, store repository.Target, flagStore repository.Flag) repository.Target {
+	return &TargetRepository{
+		redis:     redisClient,
+		store:     store,
+		flagStore: flagStore,
+	}
+}
@@ -0,0 +1,295 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	repository_mock "github.com/victorkt/flaggio/internal/repository/mocks"
+	redis_repo "github.com/victorkt/flaggio/internal/repository/redis"
+)
+
+var (
+	tgts = []*flaggio.FlagTarget{
+		{FlagID: "2", VariantID: "1", Users: []string{"user1"}},
+	}
+)
+
+func TestTargetRepository_FindAllByUserID(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockTarget, *repository_mock.MockFlag)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "calls underlying repository",
+			run: func(t *testing.T, targetStoreRepo *repository_mock.MockTarget, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				targetRedisRepo := redis_repo.NewTargetRepository(redisClient, targetStoreRepo, flagStoreRepo)
+				targetStoreRepo.EXPECT().FindAllByUserID(gomock.AssignableToTypeOf(ctxInterface), "user1").
+					Times(2).Return(tgts, nil)
+
+				res, err := targetRedisRepo.FindAllByUserID(ctx, "user1")
+				assert.NoError(t, err)
+				assert.Equal(t, tgts, res)
+
+				res2, err2 := targetRedisRepo.FindAllByUserID(ctx, "user1")
+				assert.NoError(t, err2)
+				assert.Equal(t, tgts, res2)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+			targetStoreRepo := repository_mock.NewMockTarget(mockCtrl)
+
+			tt.run(t, targetStoreRepo, flagStoreRepo)
+		})
+	}
+}
+
+func TestTargetRepository_FindAllByFlagIDs(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockTarget, *repository_mock.MockFlag)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "calls underlying repository",
+			run: func(t *testing.T, targetStoreRepo *repository_mock.MockTarget, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				targetRedisRepo := redis_repo.NewTargetRepository(redisClient, targetStoreRepo, flagStoreRepo)
+				targetStoreRepo.EXPECT().FindAllByFlagIDs(gomock.AssignableToTypeOf(ctxInterface), []string{"1", "2"}).
+					Times(2).Return(tgts, nil)
+
+				res, err := targetRedisRepo.FindAllByFlagIDs(ctx, []string{"1", "2"})
+				assert.NoError(t, err)
+				assert.Equal(t, tgts, res)
+
+				res2, err2 := targetRedisRepo.FindAllByFlagIDs(ctx, []string{"1", "2"})
+				assert.NoError(t, err2)
+				assert.Equal(t, tgts, res2)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+			targetStoreRepo := repository_mock.NewMockTarget(mockCtrl)
+
+			tt.run(t, targetStoreRepo, flagStoreRepo)
+		})
+	}
+}
+
+func TestTargetRepository_AddUsers(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockTarget, *repository_mock.MockFlag)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "clears all cached evaluations",
+			run: func(t *testing.T, targetStoreRepo *repository_mock.MockTarget, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache an evaluation
+				err := redisCtx.Set(flaggio.EvalCacheKey("test"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// verify there is a cached evaluation key
+				cachedKeys, err := redisCtx.Keys(flaggio.EvalCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 1)
+
+				// prepare repository mock
+				flg := flagResults.Flags[0]
+				targetRedisRepo := redis_repo.NewTargetRepository(redisClient, targetStoreRepo, flagStoreRepo)
+				targetStoreRepo.EXPECT().AddUsers(gomock.AssignableToTypeOf(ctxInterface), "2", "1", []string{"user1", "user2"}).
+					Times(1).Return(nil)
+				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
+					Times(1).Return(flg, nil)
+
+				// call redis repository
+				err = targetRedisRepo.AddUsers(ctx, "2", "1", []string{"user1", "user2"})
+				assert.NoError(t, err)
+
+				// check cached keys are cleared
+				cachedKeys, err = redisCtx.Keys(flaggio.EvalCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 0)
+			},
+		},
+		{
+			name: "clears relevant cached flags",
+			run: func(t *testing.T, targetStoreRepo *repository_mock.MockTarget, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache a flag
+				err := redisCtx.Set(flaggio.FlagCacheKey("key", "f1"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// verify there is a cached flag key
+				cachedKeys, err := redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 1)
+
+				// prepare repository mock
+				flg := flagResults.Flags[0]
+				targetRedisRepo := redis_repo.NewTargetRepository(redisClient, targetStoreRepo, flagStoreRepo)
+				targetStoreRepo.EXPECT().AddUsers(gomock.AssignableToTypeOf(ctxInterface), "2", "1", []string{"user3"}).
+					Times(1).Return(nil)
+				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
+					Times(1).Return(flg, nil)
+
+				// call redis repository
+				err = targetRedisRepo.AddUsers(ctx, "2", "1", []string{"user3"})
+				assert.NoError(t, err)
+
+				// check cached keys are cleared
+				cachedKeys, err = redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+			targetStoreRepo := repository_mock.NewMockTarget(mockCtrl)
+
+			tt.run(t, targetStoreRepo, flagStoreRepo)
+		})
+	}
+}
+
+func TestTargetRepository_RemoveUsers(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T, *repository_mock.MockTarget, *repository_mock.MockFlag)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "clears all cached evaluations",
+			run: func(t *testing.T, targetStoreRepo *repository_mock.MockTarget, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache an evaluation
+				err := redisCtx.Set(flaggio.EvalCacheKey("test"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// verify there is a cached evaluation key
+				cachedKeys, err := redisCtx.Keys(flaggio.EvalCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 1)
+
+				// prepare repository mock
+				flg := flagResults.Flags[0]
+				targetRedisRepo := redis_repo.NewTargetRepository(redisClient, targetStoreRepo, flagStoreRepo)
+				targetStoreRepo.EXPECT().RemoveUsers(gomock.AssignableToTypeOf(ctxInterface), "2", "1", []string{"user1", "user2"}).
+					Times(1).Return(nil)
+				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
+					Times(1).Return(flg, nil)
+
+				// call redis repository
+				err = targetRedisRepo.RemoveUsers(ctx, "2", "1", []string{"user1", "user2"})
+				assert.NoError(t, err)
+
+				// check cached keys are cleared
+				cachedKeys, err = redisCtx.Keys(flaggio.EvalCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 0)
+			},
+		},
+		{
+			name: "clears relevant cached flags",
+			run: func(t *testing.T, targetStoreRepo *repository_mock.MockTarget, flagStoreRepo *repository_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				redisCtx := redisClient.WithContext(ctx)
+
+				// cache a flag
+				err := redisCtx.Set(flaggio.FlagCacheKey("key", "f1"), "whatever", 10*time.Minute).Err()
+				assert.NoError(t, err)
+
+				// verify there is a cached flag key
+				cachedKeys, err := redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 1)
+
+				// prepare repository mock
+				flg := flagResults.Flags[0]
+				targetRedisRepo := redis_repo.NewTargetRepository(redisClient, targetStoreRepo, flagStoreRepo)
+				targetStoreRepo.EXPECT().RemoveUsers(gomock.AssignableToTypeOf(ctxInterface), "2", "1", []string{"user3"}).
+					Times(1).Return(nil)
+				flagStoreRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "2").
+					Times(1).Return(flg, nil)
+
+				// call redis repository
+				err = targetRedisRepo.RemoveUsers(ctx, "2", "1", []string{"user3"})
+				assert.NoError(t, err)
+
+				// check cached keys are cleared
+				cachedKeys, err = redisCtx.Keys(flaggio.FlagCacheKey("*")).Result()
+				assert.NoError(t, err)
+				assert.Len(t, cachedKeys, 0)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			flagStoreRepo := repository_mock.NewMockFlag(mockCtrl)
+			targetStoreRepo := repository_mock.NewMockTarget(mockCtrl)
+
+			tt.run(t, targetStoreRepo, flagStoreRepo)
+		})
+	}
+}
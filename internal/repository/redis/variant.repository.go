@@ -71,7 +71,7 @@ func (r *VariantRepository) Delete(ctx context.Context, flagID, id string) error
 }
 
 func (r *VariantRepository) invalidateRelevantCacheKeys(ctx context.Context, flagID string) error {
-	// find the flag so we can get the flag key
+	// find the flag so we can get the flag key and project
 	f, err := r.flagStore.FindByID(ctx, flagID)
 	if err != nil {
 		return err
@@ -84,16 +84,12 @@ func (r *VariantRepository) invalidateRelevantCacheKeys(ctx context.Context, fla
 	if err != nil {
 		return err
 	}
-	keysToInvalidate = append(
-		[]string{
-			flaggio.FlagCacheKey("*"),
-			flaggio.FlagCacheKey(flagID),
-			flaggio.FlagCacheKey("key", f.Key),
-		},
-		keysToInvalidate...,
-	)
-
-	return redisCtx.Del(keysToInvalidate...).Err()
+	keysToInvalidate = append(flagCacheKeys(f), keysToInvalidate...)
+
+	if err := redisCtx.Del(keysToInvalidate...).Err(); err != nil {
+		return err
+	}
+	return publishChange(redisCtx, flagChangeEvent(f))
 }
 
 // NewVariantRepository returns a new variant repository that uses redis
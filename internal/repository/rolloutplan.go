@@ -0,0 +1,32 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/rolloutplan_mock.go -package=repository_mock github.com/victorkt/flaggio/internal/repository RolloutPlan
+
+import (
+	"context"
+	"time"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// RolloutPlan represents a set of operations available to manage the plans
+// that gradually roll out a variant in a flag rule.
+type RolloutPlan interface {
+	// FindAll returns a list of rollout plans that match an optional filter,
+	// newest first, based on an optional offset and limit.
+	FindAll(ctx context.Context, filter *flaggio.RolloutPlanFilter, offset, limit *int64) ([]*flaggio.RolloutPlan, error)
+	// FindByID returns a rollout plan that has a given ID.
+	FindByID(ctx context.Context, id string) (*flaggio.RolloutPlan, error)
+	// FindDue returns up to limit active plans that have a step that should be
+	// applied at or before a given time.
+	FindDue(ctx context.Context, now time.Time, limit int64) ([]*flaggio.RolloutPlan, error)
+	// Create creates a new active rollout plan. A rule can only have one active
+	// plan at a time.
+	Create(ctx context.Context, plan flaggio.RolloutPlan) (string, error)
+	// Cancel cancels an active rollout plan.
+	Cancel(ctx context.Context, id string) error
+	// Advance records the number of steps applied to an active rollout plan.
+	// The plan is completed once all steps are applied, and marked as failed
+	// when execErr is not nil.
+	Advance(ctx context.Context, id string, appliedSteps int, execErr error) error
+}
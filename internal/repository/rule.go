@@ -10,14 +10,15 @@ import (
 
 // Rule represents a set of operations available to list and manage rules.
 type Rule interface {
-	// FindFlagRuleByID returns a flag rule that has a given ID.
-	FindFlagRuleByID(ctx context.Context, flagIDHex, idHex string) (*flaggio.FlagRule, error)
-	// CreateFlagRule creates a new rule under a flag.
-	CreateFlagRule(ctx context.Context, flagID string, input flaggio.NewFlagRule) (string, error)
-	// UpdateFlagRule updates a rule under a flag.
-	UpdateFlagRule(ctx context.Context, flagID, id string, input flaggio.UpdateFlagRule) error
-	// DeleteFlagRule deletes a rule under a flag.
-	DeleteFlagRule(ctx context.Context, flagID, id string) error
+	// FindFlagRuleByID returns a flag rule that has a given ID. When an environment ID is
+	// given, the rule is searched in the flag settings for that environment.
+	FindFlagRuleByID(ctx context.Context, flagIDHex string, environmentIDHex *string, idHex string) (*flaggio.FlagRule, error)
+	// CreateFlagRule creates a new rule under a flag, optionally for an environment.
+	CreateFlagRule(ctx context.Context, flagID string, environmentID *string, input flaggio.NewFlagRule) (string, error)
+	// UpdateFlagRule updates a rule under a flag, optionally for an environment.
+	UpdateFlagRule(ctx context.Context, flagID string, environmentID *string, id string, input flaggio.UpdateFlagRule) error
+	// DeleteFlagRule deletes a rule under a flag, optionally for an environment.
+	DeleteFlagRule(ctx context.Context, flagID string, environmentID *string, id string) error
 	// FindSegmentRuleByID returns a segment rule that has a given ID.
 	FindSegmentRuleByID(ctx context.Context, segmentIDHex, idHex string) (*flaggio.SegmentRule, error)
 	// CreateSegmentRule creates a new rule under a segment.
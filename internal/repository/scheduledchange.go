@@ -0,0 +1,34 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/scheduledchange_mock.go -package=repository_mock github.com/victorkt/flaggio/internal/repository ScheduledChange
+
+import (
+	"context"
+	"time"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// ScheduledChange represents a set of operations available to manage the
+// changes to flags that are applied at a later time.
+type ScheduledChange interface {
+	// FindAll returns a list of scheduled changes that match an optional filter,
+	// sorted by execution time, based on an optional offset and limit.
+	FindAll(ctx context.Context, filter *flaggio.ScheduledChangeFilter, offset, limit *int64) ([]*flaggio.ScheduledChange, error)
+	// FindByID returns a scheduled change that has a given ID.
+	FindByID(ctx context.Context, id string) (*flaggio.ScheduledChange, error)
+	// FindDue returns up to limit pending changes that should be executed at
+	// or before a given time, sorted by execution time.
+	FindDue(ctx context.Context, now time.Time, limit int64) ([]*flaggio.ScheduledChange, error)
+	// Create creates a new pending scheduled change.
+	Create(ctx context.Context, change flaggio.ScheduledChange) (string, error)
+	// Cancel cancels a pending scheduled change.
+	Cancel(ctx context.Context, id string) error
+	// Claim marks a pending scheduled change as running, so that it can't be
+	// cancelled or applied by anyone else. It returns false if the change is
+	// no longer pending.
+	Claim(ctx context.Context, id string) (bool, error)
+	// Complete marks a running scheduled change as executed. The change is
+	// marked as failed when execErr is not nil.
+	Complete(ctx context.Context, id string, executedAt time.Time, execErr error) error
+}
@@ -10,8 +10,9 @@ import (
 
 // Segment represents a set of operations available to list and manage segments.
 type Segment interface {
-	// FindAll returns a list of segments, based on an optional offset and limit.
-	FindAll(ctx context.Context, offset, limit *int64) ([]*flaggio.Segment, error)
+	// FindAll returns a list of segments in a project, based on an optional offset and limit.
+	// If no project ID is given, only segments that don't belong to a project are returned.
+	FindAll(ctx context.Context, projectID *string, offset, limit *int64) ([]*flaggio.Segment, error)
 	// FindByID returns a segment that has a given ID.
 	FindByID(ctx context.Context, id string) (*flaggio.Segment, error)
 	// Create creates a new segment.
@@ -0,0 +1,21 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/session_mock.go -package=repository_mock github.com/victorkt/flaggio/internal/repository Session
+
+import (
+	"context"
+	"time"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// Session represents a set of operations available to manage user sessions.
+type Session interface {
+	// FindByTokenHash returns a session that has a given token hash and
+	// is not expired.
+	FindByTokenHash(ctx context.Context, tokenHash string) (*flaggio.Session, error)
+	// Create creates a new session for a user.
+	Create(ctx context.Context, userID, tokenHash string, expiresAt time.Time) (string, error)
+	// Delete deletes the session that has a given token hash.
+	Delete(ctx context.Context, tokenHash string) error
+}
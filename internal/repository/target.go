@@ -0,0 +1,27 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/target_mock.go -package=repository_mock github.com/victorkt/flaggio/internal/repository Target
+
+import (
+	"context"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// Target represents a set of operations available to list and manage
+// the users individually targeted by flags.
+type Target interface {
+	// FindAllByFlagID returns the targets of a flag, one for each targeted variant.
+	FindAllByFlagID(ctx context.Context, flagID string) ([]*flaggio.FlagTarget, error)
+	// FindAllByFlagIDs returns the targets of all the given flags, one for each
+	// targeted variant of each flag.
+	FindAllByFlagIDs(ctx context.Context, flagIDs []string) ([]*flaggio.FlagTarget, error)
+	// FindAllByUserID returns the targets that include a given user, across all flags.
+	// Only the given user is listed in the users of each target.
+	FindAllByUserID(ctx context.Context, userID string) ([]*flaggio.FlagTarget, error)
+	// AddUsers adds users to the target of a flag variant. Users already targeted
+	// by another variant of the same flag are moved to the given variant.
+	AddUsers(ctx context.Context, flagID, variantID string, users []string) error
+	// RemoveUsers removes users from the target of a flag variant.
+	RemoveUsers(ctx context.Context, flagID, variantID string, users []string) error
+}
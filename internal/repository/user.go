@@ -0,0 +1,25 @@
+package repository
+
+//go:generate mockgen -destination=./mocks/user_mock.go -package=repository_mock github.com/victorkt/flaggio/internal/repository User
+
+import (
+	"context"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// User represents a set of operations available to list and manage local users.
+type User interface {
+	// FindAll returns a list of users.
+	FindAll(ctx context.Context) ([]*flaggio.User, error)
+	// FindByID returns a user that has a given ID.
+	FindByID(ctx context.Context, id string) (*flaggio.User, error)
+	// FindByEmail returns a user that has a given email.
+	FindByEmail(ctx context.Context, email string) (*flaggio.User, error)
+	// Create creates a new user with the given password hash.
+	Create(ctx context.Context, input flaggio.NewUser, passwordHash string) (string, error)
+	// Update updates a user. The password is only changed if passwordHash is not nil.
+	Update(ctx context.Context, id string, input flaggio.UpdateUser, passwordHash *string) error
+	// Delete deletes a user, along with their sessions.
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,213 @@
+// Package scheduler applies scheduled changes and rollout plan steps to
+// flags once their execution time is reached.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+)
+
+const (
+	// leaseName is the name of the lease that makes sure only one
+	// scheduler applies changes when several admin processes are running.
+	leaseName = "scheduler"
+	// batchSize is the maximum number of changes applied in a single tick.
+	batchSize = 100
+	// operation is the operation recorded in the audit log for applied changes.
+	operation = "applyScheduledChange"
+	// rolloutOperation is the operation recorded in the audit log for applied
+	// rollout plan steps.
+	rolloutOperation = "applyRolloutStep"
+	// releaseTimeout is how long to wait for the lease to be released once
+	// the scheduler stops.
+	releaseTimeout = 5 * time.Second
+)
+
+// Scheduler periodically applies the scheduled changes and rollout plan steps
+// that are due. Changes are applied through the repositories, so they are
+// recorded in the audit log as if they were made by the user that scheduled
+// them.
+type Scheduler struct {
+	changesRepo  repository.ScheduledChange
+	plansRepo    repository.RolloutPlan
+	leasesRepo   repository.Lease
+	flagsRepo    repository.Flag
+	rulesRepo    repository.Rule
+	auditLogRepo repository.AuditLog
+	holder       string
+	interval     time.Duration
+}
+
+// NewScheduler returns a new scheduler that checks for due changes every
+// interval. Holder identifies the process running the scheduler, and must be
+// unique among all processes sharing the same database.
+func NewScheduler(changesRepo repository.ScheduledChange, plansRepo repository.RolloutPlan,
+	leasesRepo repository.Lease, flagsRepo repository.Flag, rulesRepo repository.Rule,
+	auditLogRepo repository.AuditLog, holder string, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		changesRepo:  changesRepo,
+		plansRepo:    plansRepo,
+		leasesRepo:   leasesRepo,
+		flagsRepo:    flagsRepo,
+		rulesRepo:    rulesRepo,
+		auditLogRepo: auditLogRepo,
+		holder:       holder,
+		interval:     interval,
+	}
+}
+
+// Run applies the due changes every interval until the context is cancelled.
+// The lease is released before returning, so that another process can take
+// over right away. Errors are passed to onError.
+func (s *Scheduler) Run(ctx context.Context, onError func(err error)) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// release with a fresh context, as the one given is already cancelled
+			releaseCtx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+			defer cancel()
+			if err := s.leasesRepo.Release(releaseCtx, leaseName, s.holder); err != nil {
+				onError(err)
+			}
+			return
+		case now := <-ticker.C:
+			if err := s.Tick(ctx, now); err != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// Tick applies the changes and rollout plan steps that are due at a given
+// time, if the lease can be acquired. Each change is claimed before being
+// applied, so that it's applied at most once, even when it's cancelled or
+// this process stops in the meantime. Changes and plans that fail to be
+// applied are marked as failed, and errors don't stop the others from being
+// applied.
+func (s *Scheduler) Tick(ctx context.Context, now time.Time) error {
+	held, err := s.renewLease(ctx)
+	if err != nil || !held {
+		return err
+	}
+	changes, err := s.changesRepo.FindDue(ctx, now, batchSize)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, change := range changes {
+		// stop as soon as the lease is lost, as another process may be
+		// applying the same changes
+		if held, err := s.renewLease(ctx); err != nil || !held {
+			return errors.Join(append(errs, err)...)
+		}
+		claimed, err := s.changesRepo.Claim(ctx, change.ID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !claimed {
+			// the change was cancelled after it was found
+			continue
+		}
+		applyErr := s.apply(ctx, change)
+		if err := s.changesRepo.Complete(ctx, change.ID, time.Now(), applyErr); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	plans, err := s.plansRepo.FindDue(ctx, now, batchSize)
+	if err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+	for _, plan := range plans {
+		step := plan.DueStep(now)
+		if step < 0 {
+			continue
+		}
+		if held, err := s.renewLease(ctx); err != nil || !held {
+			return errors.Join(append(errs, err)...)
+		}
+		applyErr := s.applyRollout(ctx, plan, plan.Steps[step].Percentage)
+		if err := s.plansRepo.Advance(ctx, plan.ID, step+1, applyErr); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// renewLease acquires or renews the lease. The lease outlives a few ticks, so
+// that it's not lost between two of them while this process is running.
+func (s *Scheduler) renewLease(ctx context.Context) (bool, error) {
+	return s.leasesRepo.Acquire(ctx, leaseName, s.holder, 3*s.interval)
+}
+
+// apply applies a change to its flag and records it in the audit log.
+func (s *Scheduler) apply(ctx context.Context, change *flaggio.ScheduledChange) error {
+	if err := change.Validate(); err != nil {
+		return err
+	}
+	before, err := s.flagsRepo.FindByID(ctx, change.FlagID)
+	if err != nil {
+		return err
+	}
+	switch change.Type {
+	case flaggio.ScheduledChangeTypeUpdateFlag:
+		err = s.flagsRepo.Update(ctx, change.FlagID, *change.UpdateFlag)
+	case flaggio.ScheduledChangeTypeUpdateFlagEnvironment:
+		err = s.flagsRepo.UpdateEnvironment(ctx, change.FlagID, *change.EnvironmentID, *change.UpdateFlagEnvironment)
+	case flaggio.ScheduledChangeTypeCreateFlagRule:
+		_, err = s.rulesRepo.CreateFlagRule(ctx, change.FlagID, change.EnvironmentID, *change.NewFlagRule)
+	case flaggio.ScheduledChangeTypeUpdateFlagRule:
+		err = s.rulesRepo.UpdateFlagRule(ctx, change.FlagID, change.EnvironmentID, *change.RuleID, *change.UpdateFlagRule)
+	case flaggio.ScheduledChangeTypeDeleteFlagRule:
+		err = s.rulesRepo.DeleteFlagRule(ctx, change.FlagID, change.EnvironmentID, *change.RuleID)
+	}
+	if err != nil {
+		return err
+	}
+	return s.audit(ctx, change.FlagID, operation, change.CreatedBy, before)
+}
+
+// applyRollout gives the variant of a rollout plan to a percentage of users
+// and records it in the audit log.
+func (s *Scheduler) applyRollout(ctx context.Context, plan *flaggio.RolloutPlan, percentage int) error {
+	before, err := s.flagsRepo.FindByID(ctx, plan.FlagID)
+	if err != nil {
+		return err
+	}
+	rl, err := s.rulesRepo.FindFlagRuleByID(ctx, plan.FlagID, plan.EnvironmentID, plan.RuleID)
+	if err != nil {
+		return err
+	}
+	input, err := rl.Rollout(plan.VariantID, percentage)
+	if err != nil {
+		return err
+	}
+	if err := s.rulesRepo.UpdateFlagRule(ctx, plan.FlagID, plan.EnvironmentID, plan.RuleID, input); err != nil {
+		return err
+	}
+	return s.audit(ctx, plan.FlagID, rolloutOperation, plan.CreatedBy, before)
+}
+
+// audit records a change to a flag, comparing the flag before the change
+// with how it is now.
+func (s *Scheduler) audit(ctx context.Context, flagID, op string, actor *flaggio.AuditActor, before *flaggio.Flag) error {
+	after, err := s.flagsRepo.FindByID(ctx, flagID)
+	if err != nil {
+		return err
+	}
+	entry, err := flaggio.NewAuditEntry(flaggio.AuditEntityTypeFlag, flagID, flaggio.AuditActionUpdate, before, after)
+	if err != nil {
+		return err
+	}
+	entry.Operation = op
+	entry.Actor = actor
+	_, err = s.auditLogRepo.Create(ctx, entry)
+	return err
+}
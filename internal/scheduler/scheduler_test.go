@@ -0,0 +1,307 @@
+package scheduler_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	repository_mock "github.com/victorkt/flaggio/internal/repository/mocks"
+	"github.com/victorkt/flaggio/internal/scheduler"
+)
+
+var (
+	ctxInterface = reflect.TypeOf((*context.Context)(nil)).Elem()
+)
+
+type mocks struct {
+	changesRepo  *repository_mock.MockScheduledChange
+	plansRepo    *repository_mock.MockRolloutPlan
+	leasesRepo   *repository_mock.MockLease
+	flagsRepo    *repository_mock.MockFlag
+	rulesRepo    *repository_mock.MockRule
+	auditLogRepo *repository_mock.MockAuditLog
+}
+
+func newScheduler(mockCtrl *gomock.Controller) (*scheduler.Scheduler, mocks) {
+	m := mocks{
+		changesRepo:  repository_mock.NewMockScheduledChange(mockCtrl),
+		plansRepo:    repository_mock.NewMockRolloutPlan(mockCtrl),
+		leasesRepo:   repository_mock.NewMockLease(mockCtrl),
+		flagsRepo:    repository_mock.NewMockFlag(mockCtrl),
+		rulesRepo:    repository_mock.NewMockRule(mockCtrl),
+		auditLogRepo: repository_mock.NewMockAuditLog(mockCtrl),
+	}
+	schdlr := scheduler.NewScheduler(m.changesRepo, m.plansRepo, m.leasesRepo, m.flagsRepo, m.rulesRepo, m.auditLogRepo,
+		"holder1", time.Minute)
+	return schdlr, m
+}
+
+func TestScheduler_Tick(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2020, 5, 4, 9, 0, 0, 0, time.UTC)
+	enabled := true
+	envID := "e1"
+	ruleID := "r1"
+	actor := &flaggio.AuditActor{ID: "u1", Email: "jane@example.com", Name: "Jane"}
+
+	t.Run("does nothing when the lease is held by another process", func(t *testing.T) {
+		t.Parallel()
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		schdlr, m := newScheduler(mockCtrl)
+
+		m.leasesRepo.EXPECT().Acquire(gomock.AssignableToTypeOf(ctxInterface), "scheduler", "holder1", 3*time.Minute).
+			Times(1).Return(false, nil)
+
+		assert.NoError(t, schdlr.Tick(context.Background(), now))
+	})
+
+	t.Run("applies due changes and records them", func(t *testing.T) {
+		t.Parallel()
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		schdlr, m := newScheduler(mockCtrl)
+		input := flaggio.UpdateFlag{Enabled: &enabled}
+
+		m.leasesRepo.EXPECT().Acquire(gomock.AssignableToTypeOf(ctxInterface), "scheduler", "holder1", 3*time.Minute).
+			Times(3).Return(true, nil)
+		m.changesRepo.EXPECT().FindDue(gomock.AssignableToTypeOf(ctxInterface), now, int64(100)).Times(1).
+			Return([]*flaggio.ScheduledChange{
+				{
+					ID: "s1", FlagID: "f1", Type: flaggio.ScheduledChangeTypeUpdateFlag, ExecuteAt: now,
+					UpdateFlag: &input, CreatedBy: actor,
+				},
+				{
+					ID: "s2", FlagID: "f1", Type: flaggio.ScheduledChangeTypeDeleteFlagRule, ExecuteAt: now,
+					EnvironmentID: &envID, RuleID: &ruleID, CreatedBy: actor,
+				},
+			}, nil)
+		gomock.InOrder(
+			m.changesRepo.EXPECT().Claim(gomock.AssignableToTypeOf(ctxInterface), "s1").Return(true, nil),
+			m.flagsRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "f1").
+				Return(&flaggio.Flag{ID: "f1", Enabled: false}, nil),
+			m.flagsRepo.EXPECT().Update(gomock.AssignableToTypeOf(ctxInterface), "f1", input).Return(nil),
+			m.flagsRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "f1").
+				Return(&flaggio.Flag{ID: "f1", Enabled: true}, nil),
+			m.auditLogRepo.EXPECT().Create(gomock.AssignableToTypeOf(ctxInterface), gomock.Any()).
+				DoAndReturn(func(_ context.Context, entry flaggio.AuditEntry) (string, error) {
+					assert.Equal(t, "f1", entry.EntityID)
+					assert.Equal(t, flaggio.AuditActionUpdate, entry.Action)
+					assert.Equal(t, "applyScheduledChange", entry.Operation)
+					assert.Equal(t, actor, entry.Actor)
+					assert.Equal(t, []*flaggio.AuditChange{{Path: "Enabled", Before: false, After: true}}, entry.Changes)
+					return "a1", nil
+				}),
+			m.changesRepo.EXPECT().Complete(gomock.AssignableToTypeOf(ctxInterface), "s1", gomock.Any(), nil).
+				Return(nil),
+			m.changesRepo.EXPECT().Claim(gomock.AssignableToTypeOf(ctxInterface), "s2").Return(true, nil),
+			m.flagsRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "f1").
+				Return(&flaggio.Flag{ID: "f1", Enabled: true}, nil),
+			m.rulesRepo.EXPECT().DeleteFlagRule(gomock.AssignableToTypeOf(ctxInterface), "f1", &envID, "r1").
+				Return(nil),
+			m.flagsRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "f1").
+				Return(&flaggio.Flag{ID: "f1", Enabled: true}, nil),
+			m.auditLogRepo.EXPECT().Create(gomock.AssignableToTypeOf(ctxInterface), gomock.Any()).
+				Return("a2", nil),
+			m.changesRepo.EXPECT().Complete(gomock.AssignableToTypeOf(ctxInterface), "s2", gomock.Any(), nil).
+				Return(nil),
+		)
+
+		m.plansRepo.EXPECT().FindDue(gomock.AssignableToTypeOf(ctxInterface), now, int64(100)).Times(1).
+			Return([]*flaggio.RolloutPlan{}, nil)
+
+		assert.NoError(t, schdlr.Tick(context.Background(), now))
+	})
+
+	t.Run("marks changes that can't be applied as failed", func(t *testing.T) {
+		t.Parallel()
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		schdlr, m := newScheduler(mockCtrl)
+		input := flaggio.UpdateFlagRule{}
+
+		m.leasesRepo.EXPECT().Acquire(gomock.AssignableToTypeOf(ctxInterface), "scheduler", "holder1", 3*time.Minute).
+			Times(3).Return(true, nil)
+		m.changesRepo.EXPECT().FindDue(gomock.AssignableToTypeOf(ctxInterface), now, int64(100)).Times(1).
+			Return([]*flaggio.ScheduledChange{
+				{
+					ID: "s1", FlagID: "f1", Type: flaggio.ScheduledChangeTypeUpdateFlagRule, ExecuteAt: now,
+					RuleID: &ruleID, UpdateFlagRule: &input,
+				},
+				{
+					ID: "s2", FlagID: "f2", Type: flaggio.ScheduledChangeTypeUpdateFlag, ExecuteAt: now,
+				},
+			}, nil)
+		gomock.InOrder(
+			m.changesRepo.EXPECT().Claim(gomock.AssignableToTypeOf(ctxInterface), "s1").Return(true, nil),
+			m.flagsRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "f1").
+				Return(&flaggio.Flag{ID: "f1"}, nil),
+			m.rulesRepo.EXPECT().UpdateFlagRule(gomock.AssignableToTypeOf(ctxInterface), "f1", nil, "r1", input).
+				Return(errors.NotFound("rule")),
+			m.changesRepo.EXPECT().Complete(gomock.AssignableToTypeOf(ctxInterface), "s1", gomock.Any(), errors.NotFound("rule")).
+				Return(nil),
+			m.changesRepo.EXPECT().Claim(gomock.AssignableToTypeOf(ctxInterface), "s2").Return(true, nil),
+			m.changesRepo.EXPECT().Complete(gomock.AssignableToTypeOf(ctxInterface), "s2", gomock.Any(), errors.BadRequest("input is required")).
+				Return(nil),
+		)
+
+		m.plansRepo.EXPECT().FindDue(gomock.AssignableToTypeOf(ctxInterface), now, int64(100)).Times(1).
+			Return([]*flaggio.RolloutPlan{}, nil)
+
+		assert.NoError(t, schdlr.Tick(context.Background(), now))
+	})
+
+	t.Run("skips changes that were cancelled after they were found", func(t *testing.T) {
+		t.Parallel()
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		schdlr, m := newScheduler(mockCtrl)
+
+		m.leasesRepo.EXPECT().Acquire(gomock.AssignableToTypeOf(ctxInterface), "scheduler", "holder1", 3*time.Minute).
+			Times(2).Return(true, nil)
+		m.changesRepo.EXPECT().FindDue(gomock.AssignableToTypeOf(ctxInterface), now, int64(100)).Times(1).
+			Return([]*flaggio.ScheduledChange{
+				{ID: "s1", FlagID: "f1", Type: flaggio.ScheduledChangeTypeUpdateFlag, ExecuteAt: now},
+			}, nil)
+		m.changesRepo.EXPECT().Claim(gomock.AssignableToTypeOf(ctxInterface), "s1").Times(1).Return(false, nil)
+		m.plansRepo.EXPECT().FindDue(gomock.AssignableToTypeOf(ctxInterface), now, int64(100)).Times(1).
+			Return([]*flaggio.RolloutPlan{}, nil)
+
+		assert.NoError(t, schdlr.Tick(context.Background(), now))
+	})
+
+	t.Run("keeps going when a change can't be completed", func(t *testing.T) {
+		t.Parallel()
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		schdlr, m := newScheduler(mockCtrl)
+
+		m.leasesRepo.EXPECT().Acquire(gomock.AssignableToTypeOf(ctxInterface), "scheduler", "holder1", 3*time.Minute).
+			Times(3).Return(true, nil)
+		m.changesRepo.EXPECT().FindDue(gomock.AssignableToTypeOf(ctxInterface), now, int64(100)).Times(1).
+			Return([]*flaggio.ScheduledChange{
+				{ID: "s1", FlagID: "f1", Type: flaggio.ScheduledChangeTypeUpdateFlag, ExecuteAt: now},
+				{ID: "s2", FlagID: "f2", Type: flaggio.ScheduledChangeTypeUpdateFlag, ExecuteAt: now},
+			}, nil)
+		gomock.InOrder(
+			m.changesRepo.EXPECT().Claim(gomock.AssignableToTypeOf(ctxInterface), "s1").Return(true, nil),
+			m.changesRepo.EXPECT().Complete(gomock.AssignableToTypeOf(ctxInterface), "s1", gomock.Any(), gomock.Any()).
+				Return(errors.NotFound("scheduled change")),
+			m.changesRepo.EXPECT().Claim(gomock.AssignableToTypeOf(ctxInterface), "s2").Return(true, nil),
+			m.changesRepo.EXPECT().Complete(gomock.AssignableToTypeOf(ctxInterface), "s2", gomock.Any(), gomock.Any()).
+				Return(nil),
+		)
+		m.plansRepo.EXPECT().FindDue(gomock.AssignableToTypeOf(ctxInterface), now, int64(100)).Times(1).
+			Return([]*flaggio.RolloutPlan{}, nil)
+
+		assert.EqualError(t, schdlr.Tick(context.Background(), now), errors.NotFound("scheduled change").Error())
+	})
+
+	t.Run("stops when the lease is lost", func(t *testing.T) {
+		t.Parallel()
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		schdlr, m := newScheduler(mockCtrl)
+
+		gomock.InOrder(
+			m.leasesRepo.EXPECT().Acquire(gomock.AssignableToTypeOf(ctxInterface), "scheduler", "holder1", 3*time.Minute).
+				Return(true, nil),
+			m.changesRepo.EXPECT().FindDue(gomock.AssignableToTypeOf(ctxInterface), now, int64(100)).
+				Return([]*flaggio.ScheduledChange{
+					{ID: "s1", FlagID: "f1", Type: flaggio.ScheduledChangeTypeUpdateFlag, ExecuteAt: now},
+				}, nil),
+			m.leasesRepo.EXPECT().Acquire(gomock.AssignableToTypeOf(ctxInterface), "scheduler", "holder1", 3*time.Minute).
+				Return(false, nil),
+		)
+
+		assert.NoError(t, schdlr.Tick(context.Background(), now))
+	})
+}
+
+func TestScheduler_TickRollout(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	schdlr, m := newScheduler(mockCtrl)
+	now := time.Date(2020, 5, 5, 9, 30, 0, 0, time.UTC)
+	day := time.Date(2020, 5, 4, 9, 0, 0, 0, time.UTC)
+	envID := "e1"
+	actor := &flaggio.AuditActor{ID: "u1", Email: "jane@example.com", Name: "Jane"}
+	vOn := &flaggio.Variant{ID: "on"}
+	vOff := &flaggio.Variant{ID: "off"}
+
+	m.leasesRepo.EXPECT().Acquire(gomock.AssignableToTypeOf(ctxInterface), "scheduler", "holder1", 3*time.Minute).
+		Times(3).Return(true, nil)
+	m.changesRepo.EXPECT().FindDue(gomock.AssignableToTypeOf(ctxInterface), now, int64(100)).Times(1).
+		Return([]*flaggio.ScheduledChange{}, nil)
+	m.plansRepo.EXPECT().FindDue(gomock.AssignableToTypeOf(ctxInterface), now, int64(100)).Times(1).
+		Return([]*flaggio.RolloutPlan{
+			{
+				ID: "p1", FlagID: "f1", EnvironmentID: &envID, RuleID: "r1", VariantID: "on", CreatedBy: actor,
+				Steps: []*flaggio.RolloutStep{
+					{ExecuteAt: day, Percentage: 1},
+					{ExecuteAt: day.AddDate(0, 0, 1), Percentage: 10},
+					{ExecuteAt: day.AddDate(0, 0, 2), Percentage: 100},
+				},
+				AppliedSteps: 1,
+			},
+			{
+				ID: "p2", FlagID: "f2", RuleID: "r2", VariantID: "on",
+				Steps:        []*flaggio.RolloutStep{{ExecuteAt: day, Percentage: 50}},
+				AppliedSteps: 0,
+			},
+		}, nil)
+	gomock.InOrder(
+		m.flagsRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "f1").
+			Return(&flaggio.Flag{ID: "f1"}, nil),
+		m.rulesRepo.EXPECT().FindFlagRuleByID(gomock.AssignableToTypeOf(ctxInterface), "f1", &envID, "r1").
+			Return(&flaggio.FlagRule{
+				Rule: flaggio.Rule{ID: "r1"},
+				Distributions: []*flaggio.Distribution{
+					{ID: "d1", Variant: vOn, Percentage: 1},
+					{ID: "d2", Variant: vOff, Percentage: 99},
+				},
+			}, nil),
+		m.rulesRepo.EXPECT().UpdateFlagRule(gomock.AssignableToTypeOf(ctxInterface), "f1", &envID, "r1", flaggio.UpdateFlagRule{
+			Constraints: []*flaggio.NewConstraint{},
+			Distributions: []*flaggio.NewDistribution{
+				{VariantID: "on", Percentage: 10},
+				{VariantID: "off", Percentage: 90},
+			},
+		}).Return(nil),
+		m.flagsRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "f1").
+			Return(&flaggio.Flag{ID: "f1"}, nil),
+		m.auditLogRepo.EXPECT().Create(gomock.AssignableToTypeOf(ctxInterface), gomock.Any()).
+			DoAndReturn(func(_ context.Context, entry flaggio.AuditEntry) (string, error) {
+				assert.Equal(t, "applyRolloutStep", entry.Operation)
+				assert.Equal(t, actor, entry.Actor)
+				return "a1", nil
+			}),
+		m.plansRepo.EXPECT().Advance(gomock.AssignableToTypeOf(ctxInterface), "p1", 2, nil).Return(nil),
+		m.flagsRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), "f2").
+			Return(nil, errors.NotFound("flag")),
+		m.plansRepo.EXPECT().Advance(gomock.AssignableToTypeOf(ctxInterface), "p2", 1, errors.NotFound("flag")).
+			Return(nil),
+	)
+
+	assert.NoError(t, schdlr.Tick(context.Background(), now))
+}
+
+func TestScheduler_Run(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	schdlr, m := newScheduler(mockCtrl)
+
+	m.leasesRepo.EXPECT().Release(gomock.AssignableToTypeOf(ctxInterface), "scheduler", "holder1").
+		Times(1).Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	schdlr.Run(ctx, func(err error) { t.Errorf("unexpected error: %s", err) })
+}
@@ -0,0 +1,92 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/victorkt/flaggio/internal/auth"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// audit records a change made through the admin API, along with the user
+// that made it. Before and after are the entity before and after the change,
+// nil when it didn't exist.
+func (r *Resolver) audit(ctx context.Context, entityType flaggio.AuditEntityType, entityID string,
+	action flaggio.AuditAction, before, after interface{}) error {
+	entry, err := flaggio.NewAuditEntry(entityType, entityID, action, before, after)
+	if err != nil {
+		return err
+	}
+	if fc := graphql.GetFieldContext(ctx); fc != nil {
+		entry.Operation = fc.Field.Name
+	}
+	if usr := auth.UserFromContext(ctx); usr != nil {
+		entry.Actor = &flaggio.AuditActor{ID: usr.ID, Email: usr.Email, Name: usr.Name}
+	}
+	_, err = r.AuditLogRepo.Create(ctx, entry)
+	return err
+}
+
+// changeFlag applies a change to a flag and records it. The flag is
+// returned as it is after the change.
+func (r *Resolver) changeFlag(ctx context.Context, id string, change func() error) (*flaggio.Flag, error) {
+	return r.recordFlagChange(ctx, id, r.FlagRepo.FindByID, change)
+}
+
+// changeFlagTargets applies a change to the targets of a flag and records it.
+// The targets are part of the recorded snapshots, as they are not stored
+// with the flag. The flag is returned as it is after the change.
+func (r *Resolver) changeFlagTargets(ctx context.Context, id string, change func() error) (*flaggio.Flag, error) {
+	return r.recordFlagChange(ctx, id, r.findFlagWithTargets, change)
+}
+
+func (r *Resolver) recordFlagChange(ctx context.Context, id string,
+	find func(ctx context.Context, id string) (*flaggio.Flag, error), change func() error) (*flaggio.Flag, error) {
+	before, err := find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := change(); err != nil {
+		return nil, err
+	}
+	after, err := find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.audit(ctx, flaggio.AuditEntityTypeFlag, id, flaggio.AuditActionUpdate, before, after); err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+// findFlagWithTargets returns a flag along with its targets.
+func (r *Resolver) findFlagWithTargets(ctx context.Context, id string) (*flaggio.Flag, error) {
+	flg, err := r.FlagRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if flg.Targets, err = r.TargetRepo.FindAllByFlagID(ctx, id); err != nil {
+		return nil, err
+	}
+	return flg, nil
+}
+
+// changeSegment applies a change to a segment and records it. The segment
+// is returned as it is after the change.
+func (r *Resolver) changeSegment(ctx context.Context, id string, change func() error) (*flaggio.Segment, error) {
+	before, err := r.SegmentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := change(); err != nil {
+		return nil, err
+	}
+	after, err := r.SegmentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.audit(ctx, flaggio.AuditEntityTypeSegment, id, flaggio.AuditActionUpdate, before, after); err != nil {
+		return nil, err
+	}
+	return after, nil
+}
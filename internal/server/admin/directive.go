@@ -0,0 +1,28 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/victorkt/flaggio/internal/auth"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// HasRole implements the @hasRole directive. Only authenticated users that
+// have at least the given role are allowed through. All requests are
+// allowed when authentication is disabled.
+func (r *Resolver) HasRole(ctx context.Context, _ interface{}, next graphql.Resolver, role flaggio.UserRole) (interface{}, error) {
+	if r.Authenticator == nil {
+		return next(ctx)
+	}
+	usr := auth.UserFromContext(ctx)
+	if usr == nil {
+		return nil, errors.ErrUnauthenticated
+	}
+	if !usr.Role.Includes(role) {
+		return nil, errors.Forbidden(fmt.Sprintf("requires %s role", role))
+	}
+	return next(ctx)
+}
@@ -0,0 +1,24 @@
+package admin
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/victorkt/flaggio/internal/errors"
+)
+
+// ErrorPresenter adds the app code of application errors to the GraphQL
+// error extensions, so that clients can tell them apart.
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	var appErr errors.Err
+	if stderrors.As(err, &appErr) {
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = map[string]interface{}{}
+		}
+		gqlErr.Extensions["code"] = appErr.AppCode()
+	}
+	return gqlErr
+}
@@ -0,0 +1,84 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+var _ FlagResolver = &flagResolver{}
+
+type flagResolver struct{ *Resolver }
+
+func (r *flagResolver) Targets(ctx context.Context, obj *flaggio.Flag) ([]*flaggio.FlagTarget, error) {
+	return r.TargetRepo.FindAllByFlagID(ctx, obj.ID)
+}
+
+func (r *flagResolver) History(ctx context.Context, obj *flaggio.Flag, offset, limit *int) (*flaggio.AuditLogResults, error) {
+	entityType := flaggio.AuditEntityTypeFlag
+	filter := &flaggio.AuditLogFilter{EntityType: &entityType, EntityID: &obj.ID}
+	return r.AuditLogRepo.FindAll(ctx, filter, int64Ptr(offset), int64Ptr(limit))
+}
+
+func (r *flagResolver) Usage(ctx context.Context, obj *flaggio.Flag, from, to time.Time, granularity *flaggio.UsageGranularity,
+	environmentID *string) ([]*flaggio.FlagUsage, error) {
+	if !from.Before(to) {
+		return nil, errors.BadRequest("from must be before to")
+	}
+	filter := flaggio.UsageFilter{
+		FlagKey:     obj.Key,
+		From:        from,
+		To:          to,
+		Granularity: flaggio.UsageGranularityHour,
+	}
+	if granularity != nil {
+		filter.Granularity = *granularity
+	}
+	// evaluations are counted by project and environment keys
+	var err error
+	if filter.Project, err = r.projectKey(ctx, obj.ProjectID); err != nil {
+		return nil, err
+	}
+	if environmentID != nil {
+		env, err := r.EnvironmentRepo.FindByID(ctx, *environmentID)
+		if err != nil {
+			return nil, err
+		}
+		filter.Environment = &env.Key
+	}
+	return r.EvaluationCountRepo.FindUsage(ctx, filter)
+}
+
+func (r *flagResolver) LastEvaluatedAt(ctx context.Context, obj *flaggio.Flag) (*time.Time, error) {
+	project, err := r.projectKey(ctx, obj.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.EvaluationCountRepo.FindLastEvaluatedAt(ctx, project, obj.Key)
+}
+
+// projectKey returns the key of the project with the given ID, if any.
+func (r *flagResolver) projectKey(ctx context.Context, projectID *string) (*string, error) {
+	if projectID == nil {
+		return nil, nil
+	}
+	prj, err := r.ProjectRepo.FindByID(ctx, *projectID)
+	if err != nil {
+		return nil, err
+	}
+	return &prj.Key, nil
+}
+
+func (r *flagResolver) ScheduledChanges(ctx context.Context, obj *flaggio.Flag) ([]*flaggio.ScheduledChange, error) {
+	status := flaggio.ScheduledChangeStatusPending
+	filter := &flaggio.ScheduledChangeFilter{FlagID: &obj.ID, Status: &status}
+	return r.ScheduledChangeRepo.FindAll(ctx, filter, nil, nil)
+}
+
+func (r *flagResolver) RolloutPlans(ctx context.Context, obj *flaggio.Flag) ([]*flaggio.RolloutPlan, error) {
+	status := flaggio.RolloutPlanStatusActive
+	filter := &flaggio.RolloutPlanFilter{FlagID: &obj.ID, Status: &status}
+	return r.RolloutPlanRepo.FindAll(ctx, filter, nil, nil)
+}
@@ -2,7 +2,10 @@ package admin
 
 import (
 	"context"
+	"time"
 
+	"github.com/victorkt/flaggio/internal/auth"
+	"github.com/victorkt/flaggio/internal/errors"
 	"github.com/victorkt/flaggio/internal/flaggio"
 )
 
@@ -19,23 +22,52 @@ func (r *mutationResolver) CreateFlag(ctx context.Context, input flaggio.NewFlag
 	if err != nil {
 		return nil, err
 	}
-	return r.FlagRepo.FindByID(ctx, id)
+	flg, err := r.FlagRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.audit(ctx, flaggio.AuditEntityTypeFlag, id, flaggio.AuditActionCreate, nil, flg); err != nil {
+		return nil, err
+	}
+	return flg, nil
 }
 
 func (r *mutationResolver) UpdateFlag(ctx context.Context, id string, input flaggio.UpdateFlag) (*flaggio.Flag, error) {
-	if err := r.FlagRepo.Update(ctx, id, input); err != nil {
-		return nil, err
-	}
-	return r.FlagRepo.FindByID(ctx, id)
+	return r.changeFlag(ctx, id, func() error {
+		return r.FlagRepo.Update(ctx, id, input)
+	})
 }
 
 func (r *mutationResolver) DeleteFlag(ctx context.Context, id string) (string, error) {
-	err := r.FlagRepo.Delete(ctx, id)
+	flg, err := r.FlagRepo.FindByID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if err := r.FlagRepo.Delete(ctx, id); err != nil {
+		return "", err
+	}
+	err = r.audit(ctx, flaggio.AuditEntityTypeFlag, id, flaggio.AuditActionDelete, flg, nil)
 	return id, err
 }
 
+func (r *mutationResolver) UpdateFlagEnvironment(ctx context.Context, flagID, environmentID string, input flaggio.UpdateFlagEnvironment) (*flaggio.Flag, error) {
+	return r.changeFlag(ctx, flagID, func() error {
+		return r.FlagRepo.UpdateEnvironment(ctx, flagID, environmentID, input)
+	})
+}
+
+func (r *mutationResolver) RollbackFlag(ctx context.Context, flagID string, version int) (*flaggio.Flag, error) {
+	return r.changeFlag(ctx, flagID, func() error {
+		return r.FlagRepo.Rollback(ctx, flagID, version)
+	})
+}
+
 func (r *mutationResolver) CreateVariant(ctx context.Context, flagID string, input flaggio.NewVariant) (*flaggio.Variant, error) {
-	id, err := r.VariantRepo.Create(ctx, flagID, input)
+	var id string
+	_, err := r.changeFlag(ctx, flagID, func() (err error) {
+		id, err = r.VariantRepo.Create(ctx, flagID, input)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -43,39 +75,202 @@ func (r *mutationResolver) CreateVariant(ctx context.Context, flagID string, inp
 }
 
 func (r *mutationResolver) UpdateVariant(ctx context.Context, flagID, id string, input flaggio.UpdateVariant) (*flaggio.Variant, error) {
-	if err := r.VariantRepo.Update(ctx, flagID, id, input); err != nil {
+	_, err := r.changeFlag(ctx, flagID, func() error {
+		return r.VariantRepo.Update(ctx, flagID, id, input)
+	})
+	if err != nil {
 		return nil, err
 	}
 	return r.VariantRepo.FindByID(ctx, flagID, id)
 }
 
 func (r *mutationResolver) DeleteVariant(ctx context.Context, flagID, id string) (string, error) {
-	err := r.VariantRepo.Delete(ctx, flagID, id)
+	_, err := r.changeFlag(ctx, flagID, func() error {
+		return r.VariantRepo.Delete(ctx, flagID, id)
+	})
 	return id, err
 }
 
-func (r *mutationResolver) CreateFlagRule(ctx context.Context, flagID string, input flaggio.NewFlagRule) (*flaggio.FlagRule, error) {
-	id, err := r.RuleRepo.CreateFlagRule(ctx, flagID, input)
+func (r *mutationResolver) AddFlagTargetUsers(ctx context.Context, flagID, variantID string, users []string) (*flaggio.Flag, error) {
+	return r.changeFlagTargets(ctx, flagID, func() error {
+		return r.TargetRepo.AddUsers(ctx, flagID, variantID, users)
+	})
+}
+
+func (r *mutationResolver) RemoveFlagTargetUsers(ctx context.Context, flagID, variantID string, users []string) (*flaggio.Flag, error) {
+	return r.changeFlagTargets(ctx, flagID, func() error {
+		return r.TargetRepo.RemoveUsers(ctx, flagID, variantID, users)
+	})
+}
+
+func (r *mutationResolver) CreateFlagRule(ctx context.Context, flagID string, input flaggio.NewFlagRule, environmentID *string) (*flaggio.FlagRule, error) {
+	var id string
+	_, err := r.changeFlag(ctx, flagID, func() (err error) {
+		id, err = r.RuleRepo.CreateFlagRule(ctx, flagID, environmentID, input)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	return r.RuleRepo.FindFlagRuleByID(ctx, flagID, id)
+	return r.RuleRepo.FindFlagRuleByID(ctx, flagID, environmentID, id)
 }
 
-func (r *mutationResolver) UpdateFlagRule(ctx context.Context, flagID, id string, input flaggio.UpdateFlagRule) (*flaggio.FlagRule, error) {
-	if err := r.RuleRepo.UpdateFlagRule(ctx, flagID, id, input); err != nil {
+func (r *mutationResolver) UpdateFlagRule(ctx context.Context, flagID, id string, input flaggio.UpdateFlagRule, environmentID *string) (*flaggio.FlagRule, error) {
+	_, err := r.changeFlag(ctx, flagID, func() error {
+		return r.RuleRepo.UpdateFlagRule(ctx, flagID, environmentID, id, input)
+	})
+	if err != nil {
 		return nil, err
 	}
-	return r.RuleRepo.FindFlagRuleByID(ctx, flagID, id)
+	return r.RuleRepo.FindFlagRuleByID(ctx, flagID, environmentID, id)
 }
 
-func (r *mutationResolver) DeleteFlagRule(ctx context.Context, flagID, id string) (string, error) {
-	err := r.RuleRepo.DeleteFlagRule(ctx, flagID, id)
+func (r *mutationResolver) DeleteFlagRule(ctx context.Context, flagID, id string, environmentID *string) (string, error) {
+	_, err := r.changeFlag(ctx, flagID, func() error {
+		return r.RuleRepo.DeleteFlagRule(ctx, flagID, environmentID, id)
+	})
 	return id, err
 }
 
+func (r *mutationResolver) ScheduleFlagUpdate(ctx context.Context, flagID string, input flaggio.UpdateFlag, executeAt time.Time) (*flaggio.ScheduledChange, error) {
+	return r.schedule(ctx, flaggio.ScheduledChange{
+		FlagID:     flagID,
+		Type:       flaggio.ScheduledChangeTypeUpdateFlag,
+		UpdateFlag: &input,
+		ExecuteAt:  executeAt,
+	})
+}
+
+func (r *mutationResolver) ScheduleFlagEnvironmentUpdate(ctx context.Context, flagID, environmentID string, input flaggio.UpdateFlagEnvironment,
+	executeAt time.Time) (*flaggio.ScheduledChange, error) {
+	return r.schedule(ctx, flaggio.ScheduledChange{
+		FlagID:                flagID,
+		Type:                  flaggio.ScheduledChangeTypeUpdateFlagEnvironment,
+		EnvironmentID:         &environmentID,
+		UpdateFlagEnvironment: &input,
+		ExecuteAt:             executeAt,
+	})
+}
+
+func (r *mutationResolver) ScheduleFlagRuleCreation(ctx context.Context, flagID string, input flaggio.NewFlagRule, environmentID *string,
+	executeAt time.Time) (*flaggio.ScheduledChange, error) {
+	return r.schedule(ctx, flaggio.ScheduledChange{
+		FlagID:        flagID,
+		Type:          flaggio.ScheduledChangeTypeCreateFlagRule,
+		EnvironmentID: environmentID,
+		NewFlagRule:   &input,
+		ExecuteAt:     executeAt,
+	})
+}
+
+func (r *mutationResolver) ScheduleFlagRuleUpdate(ctx context.Context, flagID, id string, input flaggio.UpdateFlagRule, environmentID *string,
+	executeAt time.Time) (*flaggio.ScheduledChange, error) {
+	return r.schedule(ctx, flaggio.ScheduledChange{
+		FlagID:         flagID,
+		Type:           flaggio.ScheduledChangeTypeUpdateFlagRule,
+		EnvironmentID:  environmentID,
+		RuleID:         &id,
+		UpdateFlagRule: &input,
+		ExecuteAt:      executeAt,
+	})
+}
+
+func (r *mutationResolver) ScheduleFlagRuleDeletion(ctx context.Context, flagID, id string, environmentID *string,
+	executeAt time.Time) (*flaggio.ScheduledChange, error) {
+	return r.schedule(ctx, flaggio.ScheduledChange{
+		FlagID:        flagID,
+		Type:          flaggio.ScheduledChangeTypeDeleteFlagRule,
+		EnvironmentID: environmentID,
+		RuleID:        &id,
+		ExecuteAt:     executeAt,
+	})
+}
+
+func (r *mutationResolver) CancelScheduledChange(ctx context.Context, id string) (*flaggio.ScheduledChange, error) {
+	before, err := r.ScheduledChangeRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.ScheduledChangeRepo.Cancel(ctx, id); err != nil {
+		return nil, err
+	}
+	after, err := r.ScheduledChangeRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.audit(ctx, flaggio.AuditEntityTypeScheduledChange, id, flaggio.AuditActionUpdate, before, after); err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+func (r *mutationResolver) CreateRolloutPlan(ctx context.Context, input flaggio.NewRolloutPlan) (*flaggio.RolloutPlan, error) {
+	steps := make([]*flaggio.RolloutStep, len(input.Steps))
+	for idx, step := range input.Steps {
+		steps[idx] = &flaggio.RolloutStep{ExecuteAt: step.ExecuteAt, Percentage: step.Percentage}
+	}
+	plan := flaggio.RolloutPlan{
+		FlagID:        input.FlagID,
+		EnvironmentID: input.EnvironmentID,
+		RuleID:        input.RuleID,
+		VariantID:     input.VariantID,
+		Steps:         steps,
+	}
+	if err := plan.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := r.VariantRepo.FindByID(ctx, input.FlagID, input.VariantID); err != nil {
+		return nil, err
+	}
+	rl, err := r.RuleRepo.FindFlagRuleByID(ctx, input.FlagID, input.EnvironmentID, input.RuleID)
+	if err != nil {
+		return nil, err
+	}
+	// make sure the steps can be applied to the rule
+	if _, err := rl.Rollout(input.VariantID, steps[0].Percentage); err != nil {
+		return nil, err
+	}
+	if usr := auth.UserFromContext(ctx); usr != nil {
+		plan.CreatedBy = &flaggio.AuditActor{ID: usr.ID, Email: usr.Email, Name: usr.Name}
+	}
+	id, err := r.RolloutPlanRepo.Create(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+	created, err := r.RolloutPlanRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.audit(ctx, flaggio.AuditEntityTypeRolloutPlan, id, flaggio.AuditActionCreate, nil, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (r *mutationResolver) CancelRolloutPlan(ctx context.Context, id string) (*flaggio.RolloutPlan, error) {
+	before, err := r.RolloutPlanRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.RolloutPlanRepo.Cancel(ctx, id); err != nil {
+		return nil, err
+	}
+	after, err := r.RolloutPlanRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.audit(ctx, flaggio.AuditEntityTypeRolloutPlan, id, flaggio.AuditActionUpdate, before, after); err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
 func (r *mutationResolver) CreateSegmentRule(ctx context.Context, segmentID string, input flaggio.NewSegmentRule) (*flaggio.SegmentRule, error) {
-	id, err := r.RuleRepo.CreateSegmentRule(ctx, segmentID, input)
+	var id string
+	_, err := r.changeSegment(ctx, segmentID, func() (err error) {
+		id, err = r.RuleRepo.CreateSegmentRule(ctx, segmentID, input)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -83,14 +278,19 @@ func (r *mutationResolver) CreateSegmentRule(ctx context.Context, segmentID stri
 }
 
 func (r *mutationResolver) UpdateSegmentRule(ctx context.Context, segmentID, id string, input flaggio.UpdateSegmentRule) (*flaggio.SegmentRule, error) {
-	if err := r.RuleRepo.UpdateSegmentRule(ctx, segmentID, id, input); err != nil {
+	_, err := r.changeSegment(ctx, segmentID, func() error {
+		return r.RuleRepo.UpdateSegmentRule(ctx, segmentID, id, input)
+	})
+	if err != nil {
 		return nil, err
 	}
 	return r.RuleRepo.FindSegmentRuleByID(ctx, segmentID, id)
 }
 
 func (r *mutationResolver) DeleteSegmentRule(ctx context.Context, segmentID, id string) (string, error) {
-	err := r.RuleRepo.DeleteSegmentRule(ctx, segmentID, id)
+	_, err := r.changeSegment(ctx, segmentID, func() error {
+		return r.RuleRepo.DeleteSegmentRule(ctx, segmentID, id)
+	})
 	return id, err
 }
 
@@ -99,17 +299,242 @@ func (r *mutationResolver) CreateSegment(ctx context.Context, input flaggio.NewS
 	if err != nil {
 		return nil, err
 	}
-	return r.SegmentRepo.FindByID(ctx, id)
+	sgmnt, err := r.SegmentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.audit(ctx, flaggio.AuditEntityTypeSegment, id, flaggio.AuditActionCreate, nil, sgmnt); err != nil {
+		return nil, err
+	}
+	return sgmnt, nil
 }
 
 func (r *mutationResolver) UpdateSegment(ctx context.Context, id string, input flaggio.UpdateSegment) (*flaggio.Segment, error) {
-	if err := r.SegmentRepo.Update(ctx, id, input); err != nil {
+	return r.changeSegment(ctx, id, func() error {
+		return r.SegmentRepo.Update(ctx, id, input)
+	})
+}
+
+func (r *mutationResolver) DeleteSegment(ctx context.Context, id string) (string, error) {
+	sgmnt, err := r.SegmentRepo.FindByID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if err := r.SegmentRepo.Delete(ctx, id); err != nil {
+		return "", err
+	}
+	err = r.audit(ctx, flaggio.AuditEntityTypeSegment, id, flaggio.AuditActionDelete, sgmnt, nil)
+	return id, err
+}
+
+func (r *mutationResolver) CreateProject(ctx context.Context, input flaggio.NewProject) (*flaggio.Project, error) {
+	id, err := r.ProjectRepo.Create(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	prj, err := r.ProjectRepo.FindByID(ctx, id)
+	if err != nil {
 		return nil, err
 	}
-	return r.SegmentRepo.FindByID(ctx, id)
+	if err := r.audit(ctx, flaggio.AuditEntityTypeProject, id, flaggio.AuditActionCreate, nil, prj); err != nil {
+		return nil, err
+	}
+	return prj, nil
 }
 
-func (r *mutationResolver) DeleteSegment(ctx context.Context, id string) (string, error) {
-	err := r.SegmentRepo.Delete(ctx, id)
+func (r *mutationResolver) UpdateProject(ctx context.Context, id string, input flaggio.UpdateProject) (*flaggio.Project, error) {
+	before, err := r.ProjectRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.ProjectRepo.Update(ctx, id, input); err != nil {
+		return nil, err
+	}
+	after, err := r.ProjectRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.audit(ctx, flaggio.AuditEntityTypeProject, id, flaggio.AuditActionUpdate, before, after); err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+func (r *mutationResolver) DeleteProject(ctx context.Context, id string) (string, error) {
+	prj, err := r.ProjectRepo.FindByID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if err := r.ProjectRepo.Delete(ctx, id); err != nil {
+		return "", err
+	}
+	err = r.audit(ctx, flaggio.AuditEntityTypeProject, id, flaggio.AuditActionDelete, prj, nil)
+	return id, err
+}
+
+func (r *mutationResolver) CreateEnvironment(ctx context.Context, input flaggio.NewEnvironment) (*flaggio.Environment, error) {
+	id, err := r.EnvironmentRepo.Create(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	env, err := r.EnvironmentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.audit(ctx, flaggio.AuditEntityTypeEnvironment, id, flaggio.AuditActionCreate, nil, env); err != nil {
+		return nil, err
+	}
+	return env, nil
+}
+
+func (r *mutationResolver) UpdateEnvironment(ctx context.Context, id string, input flaggio.UpdateEnvironment) (*flaggio.Environment, error) {
+	before, err := r.EnvironmentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.EnvironmentRepo.Update(ctx, id, input); err != nil {
+		return nil, err
+	}
+	after, err := r.EnvironmentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.audit(ctx, flaggio.AuditEntityTypeEnvironment, id, flaggio.AuditActionUpdate, before, after); err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+func (r *mutationResolver) DeleteEnvironment(ctx context.Context, id string) (string, error) {
+	env, err := r.EnvironmentRepo.FindByID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if err := r.EnvironmentRepo.Delete(ctx, id); err != nil {
+		return "", err
+	}
+	err = r.audit(ctx, flaggio.AuditEntityTypeEnvironment, id, flaggio.AuditActionDelete, env, nil)
+	return id, err
+}
+
+func (r *mutationResolver) CreateAPIKey(ctx context.Context, input flaggio.NewAPIKey) (*flaggio.CreatedAPIKey, error) {
+	key, err := flaggio.GenerateAPIKey(input.Kind)
+	if err != nil {
+		return nil, err
+	}
+	id, err := r.APIKeyRepo.Create(ctx, input, flaggio.HashAPIKey(key))
+	if err != nil {
+		return nil, err
+	}
+	apiKey, err := r.APIKeyRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.audit(ctx, flaggio.AuditEntityTypeAPIKey, id, flaggio.AuditActionCreate, nil, apiKey); err != nil {
+		return nil, err
+	}
+	// this is the only time the key is available, since only its hash is stored
+	return &flaggio.CreatedAPIKey{APIKey: apiKey, Key: key}, nil
+}
+
+func (r *mutationResolver) RevokeAPIKey(ctx context.Context, id string) (*flaggio.APIKey, error) {
+	before, err := r.APIKeyRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.APIKeyRepo.Revoke(ctx, id); err != nil {
+		return nil, err
+	}
+	after, err := r.APIKeyRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.audit(ctx, flaggio.AuditEntityTypeAPIKey, id, flaggio.AuditActionUpdate, before, after); err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+func (r *mutationResolver) CreateUser(ctx context.Context, input flaggio.NewUser) (*flaggio.User, error) {
+	hash, err := auth.HashPassword(input.Password)
+	if err != nil {
+		return nil, err
+	}
+	id, err := r.UserRepo.Create(ctx, input, hash)
+	if err != nil {
+		return nil, err
+	}
+	usr, err := r.UserRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.audit(ctx, flaggio.AuditEntityTypeUser, id, flaggio.AuditActionCreate, nil, usr); err != nil {
+		return nil, err
+	}
+	return usr, nil
+}
+
+func (r *mutationResolver) UpdateUser(ctx context.Context, id string, input flaggio.UpdateUser) (*flaggio.User, error) {
+	if usr := auth.UserFromContext(ctx); usr != nil && usr.ID == id && input.Role != nil && *input.Role != usr.Role {
+		return nil, errors.BadRequest("cannot change your own role")
+	}
+	var hash *string
+	if input.Password != nil {
+		h, err := auth.HashPassword(*input.Password)
+		if err != nil {
+			return nil, err
+		}
+		hash = &h
+	}
+	before, err := r.UserRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.UserRepo.Update(ctx, id, input, hash); err != nil {
+		return nil, err
+	}
+	after, err := r.UserRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.audit(ctx, flaggio.AuditEntityTypeUser, id, flaggio.AuditActionUpdate, before, after); err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+func (r *mutationResolver) DeleteUser(ctx context.Context, id string) (string, error) {
+	if usr := auth.UserFromContext(ctx); usr != nil && usr.ID == id {
+		return "", errors.BadRequest("cannot delete your own user")
+	}
+	usr, err := r.UserRepo.FindByID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if err := r.UserRepo.Delete(ctx, id); err != nil {
+		return "", err
+	}
+	err = r.audit(ctx, flaggio.AuditEntityTypeUser, id, flaggio.AuditActionDelete, usr, nil)
 	return id, err
 }
+
+func (r *mutationResolver) Login(ctx context.Context, email string, password string) (*flaggio.LoginResult, error) {
+	pwdAuth, ok := r.Authenticator.(auth.PasswordAuthenticator)
+	if !ok {
+		return nil, errors.BadRequest("password login is not enabled")
+	}
+	return pwdAuth.Login(ctx, email, password)
+}
+
+func (r *mutationResolver) Logout(ctx context.Context) (bool, error) {
+	token := auth.TokenFromContext(ctx)
+	if token == "" {
+		return false, errors.ErrUnauthenticated
+	}
+	if pwdAuth, ok := r.Authenticator.(auth.PasswordAuthenticator); ok {
+		if err := pwdAuth.Logout(ctx, token); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
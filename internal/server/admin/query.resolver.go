@@ -2,7 +2,10 @@ package admin
 
 import (
 	"context"
+	"time"
 
+	"github.com/victorkt/flaggio/internal/auth"
+	"github.com/victorkt/flaggio/internal/errors"
 	"github.com/victorkt/flaggio/internal/flaggio"
 )
 
@@ -14,36 +17,89 @@ func (r *queryResolver) Ping(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
-func (r *queryResolver) Flags(ctx context.Context, search *string, offset, limit *int) (*flaggio.FlagResults, error) {
-	var ofst, lmt *int64
-	if offset != nil {
-		v := int64(*offset)
-		ofst = &v
-	}
-	if limit != nil {
-		v := int64(*limit)
-		lmt = &v
-	}
-	return r.FlagRepo.FindAll(ctx, search, ofst, lmt)
+func (r *queryResolver) Flags(ctx context.Context, search *string, offset, limit *int, projectID *string) (*flaggio.FlagResults, error) {
+	return r.FlagRepo.FindAll(ctx, projectID, search, int64Ptr(offset), int64Ptr(limit))
 }
 
 func (r *queryResolver) Flag(ctx context.Context, id string) (*flaggio.Flag, error) {
 	return r.FlagRepo.FindByID(ctx, id)
 }
 
-func (r *queryResolver) Segments(ctx context.Context, offset, limit *int) ([]*flaggio.Segment, error) {
-	var ofst, lmt *int64
-	if offset != nil {
-		v := int64(*offset)
-		ofst = &v
-	}
-	if limit != nil {
-		v := int64(*limit)
-		lmt = &v
-	}
-	return r.SegmentRepo.FindAll(ctx, ofst, lmt)
+func (r *queryResolver) FlagVersions(ctx context.Context, flagID string, offset, limit *int) ([]*flaggio.FlagVersion, error) {
+	return r.FlagVersionRepo.FindAll(ctx, flagID, int64Ptr(offset), int64Ptr(limit))
+}
+
+func (r *queryResolver) FlagVersion(ctx context.Context, flagID string, version int) (*flaggio.FlagVersion, error) {
+	return r.FlagVersionRepo.FindByVersion(ctx, flagID, version)
+}
+
+func (r *queryResolver) Segments(ctx context.Context, offset, limit *int, projectID *string) ([]*flaggio.Segment, error) {
+	return r.SegmentRepo.FindAll(ctx, projectID, int64Ptr(offset), int64Ptr(limit))
 }
 
 func (r *queryResolver) Segment(ctx context.Context, id string) (*flaggio.Segment, error) {
 	return r.SegmentRepo.FindByID(ctx, id)
 }
+
+func (r *queryResolver) Projects(ctx context.Context) ([]*flaggio.Project, error) {
+	return r.ProjectRepo.FindAll(ctx)
+}
+
+func (r *queryResolver) Project(ctx context.Context, id string) (*flaggio.Project, error) {
+	return r.ProjectRepo.FindByID(ctx, id)
+}
+
+func (r *queryResolver) Environments(ctx context.Context) ([]*flaggio.Environment, error) {
+	return r.EnvironmentRepo.FindAll(ctx)
+}
+
+func (r *queryResolver) Environment(ctx context.Context, id string) (*flaggio.Environment, error) {
+	return r.EnvironmentRepo.FindByID(ctx, id)
+}
+
+func (r *queryResolver) APIKeys(ctx context.Context) ([]*flaggio.APIKey, error) {
+	return r.APIKeyRepo.FindAll(ctx)
+}
+
+func (r *queryResolver) Me(ctx context.Context) (*flaggio.User, error) {
+	return auth.UserFromContext(ctx), nil
+}
+
+func (r *queryResolver) Users(ctx context.Context) ([]*flaggio.User, error) {
+	return r.UserRepo.FindAll(ctx)
+}
+
+func (r *queryResolver) AuditLog(ctx context.Context, filter *flaggio.AuditLogFilter, offset, limit *int) (*flaggio.AuditLogResults, error) {
+	return r.AuditLogRepo.FindAll(ctx, filter, int64Ptr(offset), int64Ptr(limit))
+}
+
+func (r *queryResolver) StaleReport(ctx context.Context, days *int, projectID *string) (*flaggio.StaleReport, error) {
+	if days == nil || *days < 1 {
+		return nil, errors.BadRequest("days must be at least 1")
+	}
+	return r.ReportService.Stale(ctx, projectID, time.Now().AddDate(0, 0, -*days))
+}
+
+func (r *queryResolver) ScheduledChanges(ctx context.Context, filter *flaggio.ScheduledChangeFilter, offset, limit *int) ([]*flaggio.ScheduledChange, error) {
+	return r.ScheduledChangeRepo.FindAll(ctx, filter, int64Ptr(offset), int64Ptr(limit))
+}
+
+func (r *queryResolver) ScheduledChange(ctx context.Context, id string) (*flaggio.ScheduledChange, error) {
+	return r.ScheduledChangeRepo.FindByID(ctx, id)
+}
+
+func (r *queryResolver) RolloutPlans(ctx context.Context, filter *flaggio.RolloutPlanFilter, offset, limit *int) ([]*flaggio.RolloutPlan, error) {
+	return r.RolloutPlanRepo.FindAll(ctx, filter, int64Ptr(offset), int64Ptr(limit))
+}
+
+func (r *queryResolver) RolloutPlan(ctx context.Context, id string) (*flaggio.RolloutPlan, error) {
+	return r.RolloutPlanRepo.FindByID(ctx, id)
+}
+
+func int64Ptr(v *int) *int64 {
+	if v == nil {
+		return nil
+	}
+	i := int64(*v)
+	return &i
+}
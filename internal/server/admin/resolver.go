@@ -1,15 +1,46 @@
 package admin
 
 import (
+	"github.com/victorkt/flaggio/internal/auth"
 	"github.com/victorkt/flaggio/internal/repository"
+	"github.com/victorkt/flaggio/internal/service"
 )
 
 // Resolver is the root resolver for the GraphQL server.
 type Resolver struct {
-	FlagRepo    repository.Flag
-	VariantRepo repository.Variant
-	RuleRepo    repository.Rule
-	SegmentRepo repository.Segment
+	FlagRepo        repository.Flag
+	FlagVersionRepo repository.FlagVersion
+	VariantRepo     repository.Variant
+	RuleRepo        repository.Rule
+	SegmentRepo     repository.Segment
+	TargetRepo      repository.Target
+	EnvironmentRepo repository.Environment
+	ProjectRepo     repository.Project
+	APIKeyRepo      repository.APIKey
+	UserRepo        repository.User
+	AuditLogRepo    repository.AuditLog
+	// EvaluationCountRepo holds the flag evaluations recorded by the API.
+	EvaluationCountRepo repository.EvaluationCount
+	ReportService       service.Report
+	ScheduledChangeRepo repository.ScheduledChange
+	RolloutPlanRepo     repository.RolloutPlan
+	// Authenticator is nil when authentication is disabled.
+	Authenticator auth.Authenticator
+}
+
+// Flag returns the flag resolver.
+func (r *Resolver) Flag() FlagResolver {
+	return &flagResolver{r}
+}
+
+// Segment returns the segment resolver.
+func (r *Resolver) Segment() SegmentResolver {
+	return &segmentResolver{r}
+}
+
+// ScheduledChange returns the scheduled change resolver.
+func (r *Resolver) ScheduledChange() ScheduledChangeResolver {
+	return &scheduledChangeResolver{r}
 }
 
 // Mutation returns the mutation resolver.
@@ -0,0 +1,57 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/victorkt/flaggio/internal/auth"
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+var _ ScheduledChangeResolver = &scheduledChangeResolver{}
+
+type scheduledChangeResolver struct{ *Resolver }
+
+func (r *scheduledChangeResolver) Input(ctx context.Context, obj *flaggio.ScheduledChange) (interface{}, error) {
+	snapshot, err := flaggio.Snapshot(obj.Input())
+	if err != nil || snapshot == nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// schedule creates a scheduled change for the user that made the request,
+// after checking that the flag, and the environment and rule the change
+// refers to, exist. The change is returned as it was created.
+func (r *Resolver) schedule(ctx context.Context, change flaggio.ScheduledChange) (*flaggio.ScheduledChange, error) {
+	if err := change.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := r.FlagRepo.FindByID(ctx, change.FlagID); err != nil {
+		return nil, err
+	}
+	if change.EnvironmentID != nil {
+		if _, err := r.EnvironmentRepo.FindByID(ctx, *change.EnvironmentID); err != nil {
+			return nil, err
+		}
+	}
+	if change.RuleID != nil {
+		if _, err := r.RuleRepo.FindFlagRuleByID(ctx, change.FlagID, change.EnvironmentID, *change.RuleID); err != nil {
+			return nil, err
+		}
+	}
+	if usr := auth.UserFromContext(ctx); usr != nil {
+		change.CreatedBy = &flaggio.AuditActor{ID: usr.ID, Email: usr.Email, Name: usr.Name}
+	}
+	id, err := r.ScheduledChangeRepo.Create(ctx, change)
+	if err != nil {
+		return nil, err
+	}
+	sc, err := r.ScheduledChangeRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.audit(ctx, flaggio.AuditEntityTypeScheduledChange, id, flaggio.AuditActionCreate, nil, sc); err != nil {
+		return nil, err
+	}
+	return sc, nil
+}
@@ -0,0 +1,17 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+var _ SegmentResolver = &segmentResolver{}
+
+type segmentResolver struct{ *Resolver }
+
+func (r *segmentResolver) History(ctx context.Context, obj *flaggio.Segment, offset, limit *int) (*flaggio.AuditLogResults, error) {
+	entityType := flaggio.AuditEntityTypeSegment
+	filter := &flaggio.AuditLogFilter{EntityType: &entityType, EntityID: &obj.ID}
+	return r.AuditLogRepo.FindAll(ctx, filter, int64Ptr(offset), int64Ptr(limit))
+}
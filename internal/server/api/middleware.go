@@ -0,0 +1,55 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"github.com/victorkt/flaggio/internal/auth"
+	"github.com/victorkt/flaggio/internal/service"
+)
+
+// authenticate validates the API key sent in the Authorization header,
+// as a bearer token. Requests with a missing, unknown or revoked key are
+// rejected.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		creds, err := s.apiKeys.Authenticate(r.Context(), key)
+		if err != nil {
+			_ = render.Render(w, r, formatErr(err))
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.NewCredentialsContext(r.Context(), creds)))
+	})
+}
+
+// scopeRequest sets the project and environment of the evaluation request
+// from the URL and from the API key used to authenticate the request. Client
+// keys can't be used for debugging.
+func scopeRequest(r *http.Request, er *service.EvaluationRequest) error {
+	prj, env, err := requestScope(r)
+	if err != nil {
+		return err
+	}
+	er.Project = prj
+	er.Environment = env
+	if isClientKey(r) {
+		// client keys are public, so the flag configuration
+		// can't be exposed through debugging
+		er.Debug = nil
+	}
+	return nil
+}
+
+// requestScope returns the keys of the project and environment of a request,
+// from the URL and from the API key used to authenticate the request.
+func requestScope(r *http.Request) (project, environment string, err error) {
+	return auth.CredentialsFromContext(r.Context()).Scope(chi.URLParam(r, "project"), chi.URLParam(r, "environment"))
+}
+
+// isClientKey returns whether the request was authenticated with a client key.
+func isClientKey(r *http.Request) bool {
+	return auth.CredentialsFromContext(r.Context()).IsClient()
+}
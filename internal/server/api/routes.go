@@ -1,9 +1,12 @@
 package api
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi"
 	"github.com/go-chi/render"
@@ -13,8 +16,19 @@ import (
 	"github.com/victorkt/flaggio/internal/service"
 )
 
+const (
+	// maxBatchSize is the maximum number of requests in a batch evaluation.
+	maxBatchSize = 100000
+	// maxBatchBodySize is the maximum size, in bytes, of the body of a batch
+	// evaluation, so that a batch can't take all the memory of the server.
+	maxBatchBodySize = 32 << 20
+)
+
 // POST /evaluate/{id}
-// Evaluates a given flag for the user
+// POST /environments/{environment}/evaluate/{id}
+// POST /projects/{project}/evaluate/{id}
+// POST /projects/{project}/environments/{environment}/evaluate/{id}
+// Evaluates a given flag for the user, optionally in a project and environment
 func (s *Server) handleEvaluate(w http.ResponseWriter, r *http.Request) {
 	span, ctx := opentracing.StartSpanFromContext(r.Context(), "POST /evaluate/{id}")
 	defer span.Finish()
@@ -31,6 +45,10 @@ func (s *Server) handleEvaluate(w http.ResponseWriter, r *http.Request) {
 		_ = render.Render(w, r, formatErr(badRequest))
 		return
 	}
+	if err := scopeRequest(r, er); err != nil {
+		_ = render.Render(w, r, formatErr(err))
+		return
+	}
 
 	// evaluate flag
 	eval, err := s.flagsService.Evaluate(ctx, flagKey, er)
@@ -48,7 +66,10 @@ func (s *Server) handleEvaluate(w http.ResponseWriter, r *http.Request) {
 }
 
 // POST /evaluate
-// Evaluates all flags for the user
+// POST /environments/{environment}/evaluate
+// POST /projects/{project}/evaluate
+// POST /projects/{project}/environments/{environment}/evaluate
+// Evaluates all flags for the user, optionally in a project and environment
 func (s *Server) handleEvaluateAll(w http.ResponseWriter, r *http.Request) {
 	span, ctx := opentracing.StartSpanFromContext(r.Context(), "POST /evaluate")
 	defer span.Finish()
@@ -64,6 +85,10 @@ func (s *Server) handleEvaluateAll(w http.ResponseWriter, r *http.Request) {
 		_ = render.Render(w, r, formatErr(badRequest))
 		return
 	}
+	if err := scopeRequest(r, er); err != nil {
+		_ = render.Render(w, r, formatErr(err))
+		return
+	}
 
 	// evaluate flags
 	eval, err := s.flagsService.EvaluateAll(ctx, er)
@@ -80,6 +105,133 @@ func (s *Server) handleEvaluateAll(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// POST /batch/evaluate
+// POST /environments/{environment}/batch/evaluate
+// POST /projects/{project}/batch/evaluate
+// POST /projects/{project}/environments/{environment}/batch/evaluate
+// Evaluates flags for many users at once, optionally in a project and
+// environment. The evaluations of each user are streamed as a line of
+// newline delimited JSON, as soon as they are ready.
+func (s *Server) handleEvaluateBatch(w http.ResponseWriter, r *http.Request) {
+	span, ctx := opentracing.StartSpanFromContext(r.Context(), "POST /batch/evaluate")
+	defer span.Finish()
+
+	br := &service.BatchEvaluationRequest{}
+	r.Body = http.MaxBytesReader(w, r.Body, maxBatchBodySize)
+	defer r.Body.Close()
+
+	// unmarshal JSON request
+	if err := render.Bind(r, br); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			err = fmt.Errorf("request body can't be larger than %d bytes", maxBatchBodySize)
+		}
+		badRequest := internalerrors.BadRequest(err.Error())
+		_ = render.Render(w, r, formatErr(badRequest))
+		return
+	}
+	if len(br.Requests) > maxBatchSize {
+		badRequest := internalerrors.BadRequest(fmt.Sprintf("at most %d requests can be evaluated at once", maxBatchSize))
+		_ = render.Render(w, r, formatErr(badRequest))
+		return
+	}
+	var err error
+	if br.Project, br.Environment, err = requestScope(r); err != nil {
+		_ = render.Render(w, r, formatErr(err))
+		return
+	}
+	if isClientKey(r) {
+		// client keys are public, so the flag configuration
+		// can't be exposed through debugging
+		for _, er := range br.Requests {
+			er.Debug = nil
+		}
+	}
+
+	// a large batch can take longer to be written than the server
+	// write timeout, which is only meant for the other requests
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	// evaluate flags, streaming the results
+	started := false
+	enc := json.NewEncoder(w)
+	err = s.flagsService.EvaluateBatch(ctx, br, func(res *service.BatchEvaluationResult) error {
+		if !started {
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+		return enc.Encode(res)
+	})
+	switch {
+	case err != nil && !started:
+		_ = render.Render(w, r, formatErr(err))
+	case err != nil:
+		// results can only fail to be written once the client is gone
+	case !started:
+		// no requests were given
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// GET /ruleset
+// GET /environments/{environment}/ruleset
+// GET /projects/{project}/ruleset
+// GET /projects/{project}/environments/{environment}/ruleset
+// Returns all flags and segments, optionally in a project and environment,
+// so that flags can be evaluated locally. The ruleset version is sent as
+// the ETag, and nothing is sent if it matches the If-None-Match header.
+func (s *Server) handleRuleset(w http.ResponseWriter, r *http.Request) {
+	span, ctx := opentracing.StartSpanFromContext(r.Context(), "GET /ruleset")
+	defer span.Finish()
+
+	if isClientKey(r) {
+		// client keys are public, so the flag configuration can't be exposed
+		_ = render.Render(w, r, formatErr(internalerrors.Forbidden("client API keys can't be used to fetch the ruleset")))
+		return
+	}
+	rr := &service.RulesetRequest{}
+	var err error
+	if rr.Project, rr.Environment, err = requestScope(r); err != nil {
+		_ = render.Render(w, r, formatErr(err))
+		return
+	}
+
+	// fetch ruleset
+	rs, err := s.flagsService.Ruleset(ctx, rr)
+	if err != nil {
+		_ = render.Render(w, r, formatErr(err))
+		return
+	}
+
+	etag := fmt.Sprintf("%q", rs.Version)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	// render response
+	if err = render.Render(w, r, rs); err != nil {
+		cannotRender := fmt.Errorf("%w: %s", internalerrors.ErrCannotRenderResponse, err)
+		_ = render.Render(w, r, formatErr(cannotRender))
+		return
+	}
+}
+
+// etagMatches returns whether the value of an If-None-Match header matches the given ETag.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == etag || tag == "*" {
+			return true
+		}
+	}
+	return false
+}
+
 type errResponse struct {
 	Err        error  `json:"-"`               // low-level runtime error
 	StatusCode int    `json:"-"`               // http response status code
@@ -4,17 +4,31 @@ import (
 	"net/http"
 
 	"github.com/go-chi/chi"
+	"github.com/victorkt/flaggio/internal/auth"
+	"github.com/victorkt/flaggio/internal/repository"
 	"github.com/victorkt/flaggio/internal/service"
+	"github.com/victorkt/flaggio/internal/stream"
 )
 
-// NewServer returns a new server object
+// NewServer returns a new server object. Requests are authenticated with
+// API keys, unless apiKeysRepo is nil. Changes are streamed to clients,
+// unless broker is nil.
 func NewServer(
 	router chi.Router,
 	flagsService service.Flag,
+	apiKeysRepo repository.APIKey,
+	projectsRepo repository.Project,
+	environmentsRepo repository.Environment,
+	broker *stream.Broker,
 ) *Server {
 	srv := &Server{
 		router:       router,
 		flagsService: flagsService,
+		projectsRepo: projectsRepo,
+		broker:       broker,
+	}
+	if apiKeysRepo != nil {
+		srv.apiKeys = auth.NewAPIKeys(apiKeysRepo, projectsRepo, environmentsRepo)
 	}
 	srv.routes()
 	return srv
@@ -24,6 +38,9 @@ func NewServer(
 type Server struct {
 	router       chi.Router
 	flagsService service.Flag
+	apiKeys      *auth.APIKeys
+	projectsRepo repository.Project
+	broker       *stream.Broker
 }
 
 // ServeHTTP responds to an HTTP request
@@ -35,7 +52,30 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 func (s *Server) routes() {
 	// API version 1
 	s.router.Route("/v1", func(r chi.Router) {
+		if s.apiKeys != nil {
+			r.Use(s.authenticate)
+		}
 		r.Post("/evaluate", s.handleEvaluateAll)
 		r.Post("/evaluate/{key}", s.handleEvaluate)
+		r.Post("/environments/{environment}/evaluate", s.handleEvaluateAll)
+		r.Post("/environments/{environment}/evaluate/{key}", s.handleEvaluate)
+		r.Post("/projects/{project}/evaluate", s.handleEvaluateAll)
+		r.Post("/projects/{project}/evaluate/{key}", s.handleEvaluate)
+		r.Post("/projects/{project}/environments/{environment}/evaluate", s.handleEvaluateAll)
+		r.Post("/projects/{project}/environments/{environment}/evaluate/{key}", s.handleEvaluate)
+		r.Post("/batch/evaluate", s.handleEvaluateBatch)
+		r.Post("/environments/{environment}/batch/evaluate", s.handleEvaluateBatch)
+		r.Post("/projects/{project}/batch/evaluate", s.handleEvaluateBatch)
+		r.Post("/projects/{project}/environments/{environment}/batch/evaluate", s.handleEvaluateBatch)
+		r.Get("/ruleset", s.handleRuleset)
+		r.Get("/environments/{environment}/ruleset", s.handleRuleset)
+		r.Get("/projects/{project}/ruleset", s.handleRuleset)
+		r.Get("/projects/{project}/environments/{environment}/ruleset", s.handleRuleset)
+		if s.broker != nil {
+			r.Get("/stream", s.handleStream)
+			r.Get("/environments/{environment}/stream", s.handleStream)
+			r.Get("/projects/{project}/stream", s.handleStream)
+			r.Get("/projects/{project}/environments/{environment}/stream", s.handleStream)
+		}
 	})
 }
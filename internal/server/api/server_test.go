@@ -0,0 +1,179 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	internalerrors "github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	repository_mock "github.com/victorkt/flaggio/internal/repository/mocks"
+	"github.com/victorkt/flaggio/internal/server/api"
+	"github.com/victorkt/flaggio/internal/service"
+	service_mock "github.com/victorkt/flaggio/internal/service/mocks"
+)
+
+var (
+	ctxInterface = reflect.TypeOf((*context.Context)(nil)).Elem()
+)
+
+func TestServer_Authentication(t *testing.T) {
+	t.Parallel()
+	prjID, envID, deletedPrjID, deletedEnvID := "p1", "e1", "p2", "e2"
+	revokedAt := time.Now()
+
+	tests := []struct {
+		name               string
+		key                string
+		path               string
+		prepare            func(t *testing.T, apiKeysRepo *repository_mock.MockAPIKey, projectsRepo *repository_mock.MockProject, environmentsRepo *repository_mock.MockEnvironment, flagsService *service_mock.MockFlag)
+		expectedStatusCode int
+	}{
+		{
+			name: "rejects requests without API key",
+			key:  "",
+			path: "/v1/evaluate/f1",
+			prepare: func(*testing.T, *repository_mock.MockAPIKey, *repository_mock.MockProject, *repository_mock.MockEnvironment, *service_mock.MockFlag) {
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "rejects unknown API keys",
+			key:  "srv-unknown",
+			path: "/v1/evaluate/f1",
+			prepare: func(_ *testing.T, apiKeysRepo *repository_mock.MockAPIKey, _ *repository_mock.MockProject, _ *repository_mock.MockEnvironment, _ *service_mock.MockFlag) {
+				apiKeysRepo.EXPECT().FindByHash(gomock.AssignableToTypeOf(ctxInterface), flaggio.HashAPIKey("srv-unknown")).
+					Times(1).Return(nil, internalerrors.NotFound("apiKey"))
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "rejects revoked API keys",
+			key:  "srv-revoked",
+			path: "/v1/evaluate/f1",
+			prepare: func(_ *testing.T, apiKeysRepo *repository_mock.MockAPIKey, _ *repository_mock.MockProject, _ *repository_mock.MockEnvironment, _ *service_mock.MockFlag) {
+				apiKeysRepo.EXPECT().FindByHash(gomock.AssignableToTypeOf(ctxInterface), flaggio.HashAPIKey("srv-revoked")).
+					Times(1).Return(&flaggio.APIKey{ID: "1", Kind: flaggio.APIKeyKindServer, RevokedAt: &revokedAt}, nil)
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "rejects API keys of deleted projects",
+			key:  "srv-orphan",
+			path: "/v1/evaluate/f1",
+			prepare: func(_ *testing.T, apiKeysRepo *repository_mock.MockAPIKey, projectsRepo *repository_mock.MockProject, _ *repository_mock.MockEnvironment, _ *service_mock.MockFlag) {
+				apiKeysRepo.EXPECT().FindByHash(gomock.AssignableToTypeOf(ctxInterface), flaggio.HashAPIKey("srv-orphan")).
+					Times(1).Return(&flaggio.APIKey{ID: "1", Kind: flaggio.APIKeyKindServer, ProjectID: &deletedPrjID}, nil)
+				projectsRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), deletedPrjID).
+					Times(1).Return(nil, internalerrors.NotFound("project"))
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "rejects API keys of deleted environments",
+			key:  "srv-orphan",
+			path: "/v1/evaluate/f1",
+			prepare: func(_ *testing.T, apiKeysRepo *repository_mock.MockAPIKey, _ *repository_mock.MockProject, environmentsRepo *repository_mock.MockEnvironment, _ *service_mock.MockFlag) {
+				apiKeysRepo.EXPECT().FindByHash(gomock.AssignableToTypeOf(ctxInterface), flaggio.HashAPIKey("srv-orphan")).
+					Times(1).Return(&flaggio.APIKey{ID: "1", Kind: flaggio.APIKeyKindServer, EnvironmentID: &deletedEnvID}, nil)
+				environmentsRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), deletedEnvID).
+					Times(1).Return(nil, internalerrors.NotFound("environment"))
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name: "rejects API keys restricted to another project",
+			key:  "srv-website",
+			path: "/v1/projects/blog/evaluate/f1",
+			prepare: func(_ *testing.T, apiKeysRepo *repository_mock.MockAPIKey, projectsRepo *repository_mock.MockProject, _ *repository_mock.MockEnvironment, _ *service_mock.MockFlag) {
+				apiKeysRepo.EXPECT().FindByHash(gomock.AssignableToTypeOf(ctxInterface), flaggio.HashAPIKey("srv-website")).
+					Times(1).Return(&flaggio.APIKey{ID: "1", Kind: flaggio.APIKeyKindServer, ProjectID: &prjID}, nil)
+				projectsRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), prjID).
+					Times(1).Return(&flaggio.Project{ID: prjID, Key: "website"}, nil)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "rejects API keys restricted to another environment",
+			key:  "srv-production",
+			path: "/v1/environments/staging/evaluate",
+			prepare: func(_ *testing.T, apiKeysRepo *repository_mock.MockAPIKey, _ *repository_mock.MockProject, environmentsRepo *repository_mock.MockEnvironment, _ *service_mock.MockFlag) {
+				apiKeysRepo.EXPECT().FindByHash(gomock.AssignableToTypeOf(ctxInterface), flaggio.HashAPIKey("srv-production")).
+					Times(1).Return(&flaggio.APIKey{ID: "1", Kind: flaggio.APIKeyKindServer, EnvironmentID: &envID}, nil)
+				environmentsRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), envID).
+					Times(1).Return(&flaggio.Environment{ID: envID, Key: "production"}, nil)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "scopes requests to the project and environment of the API key",
+			key:  "srv-website",
+			path: "/v1/evaluate/f1",
+			prepare: func(t *testing.T, apiKeysRepo *repository_mock.MockAPIKey, projectsRepo *repository_mock.MockProject, environmentsRepo *repository_mock.MockEnvironment, flagsService *service_mock.MockFlag) {
+				apiKeysRepo.EXPECT().FindByHash(gomock.AssignableToTypeOf(ctxInterface), flaggio.HashAPIKey("srv-website")).
+					Times(1).Return(&flaggio.APIKey{ID: "1", Kind: flaggio.APIKeyKindServer, ProjectID: &prjID, EnvironmentID: &envID}, nil)
+				projectsRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), prjID).
+					Times(1).Return(&flaggio.Project{ID: prjID, Key: "website"}, nil)
+				environmentsRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), envID).
+					Times(1).Return(&flaggio.Environment{ID: envID, Key: "production"}, nil)
+				flagsService.EXPECT().Evaluate(gomock.AssignableToTypeOf(ctxInterface), "f1", gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, _ string, er *service.EvaluationRequest) (*service.EvaluationResponse, error) {
+						assert.Equal(t, "website", er.Project)
+						assert.Equal(t, "production", er.Environment)
+						// server keys can be used for debugging
+						assert.True(t, er.IsDebug())
+						return &service.EvaluationResponse{Evaluation: &flaggio.Evaluation{FlagKey: "f1", Value: true}}, nil
+					})
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "doesnt allow debugging with client keys",
+			key:  "cli-key",
+			path: "/v1/evaluate",
+			prepare: func(t *testing.T, apiKeysRepo *repository_mock.MockAPIKey, _ *repository_mock.MockProject, _ *repository_mock.MockEnvironment, flagsService *service_mock.MockFlag) {
+				apiKeysRepo.EXPECT().FindByHash(gomock.AssignableToTypeOf(ctxInterface), flaggio.HashAPIKey("cli-key")).
+					Times(1).Return(&flaggio.APIKey{ID: "1", Kind: flaggio.APIKeyKindClient}, nil)
+				flagsService.EXPECT().EvaluateAll(gomock.AssignableToTypeOf(ctxInterface), gomock.Any()).Times(1).
+					DoAndReturn(func(_ context.Context, er *service.EvaluationRequest) (*service.EvaluationsResponse, error) {
+						// client keys are public, so the flag configuration
+						// can't be exposed through debugging
+						assert.Nil(t, er.Debug)
+						return &service.EvaluationsResponse{Evaluations: flaggio.EvaluationList{}}, nil
+					})
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			apiKeysRepo := repository_mock.NewMockAPIKey(mockCtrl)
+			projectsRepo := repository_mock.NewMockProject(mockCtrl)
+			environmentsRepo := repository_mock.NewMockEnvironment(mockCtrl)
+			flagsService := service_mock.NewMockFlag(mockCtrl)
+			tt.prepare(t, apiKeysRepo, projectsRepo, environmentsRepo, flagsService)
+			srv := api.NewServer(chi.NewRouter(), flagsService, apiKeysRepo, projectsRepo, environmentsRepo, nil)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(`{"userId":"123","debug":true}`))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.key != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.key)
+			}
+			res := httptest.NewRecorder()
+			srv.ServeHTTP(res, req)
+			assert.Equal(t, tt.expectedStatusCode, res.Code, res.Body.String())
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/opentracing/opentracing-go"
+	internalerrors "github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/service"
+	"github.com/victorkt/flaggio/internal/stream"
+)
+
+// keepAliveInterval is how often a comment is sent to idle streams, so
+// that proxies don't close the connection.
+const keepAliveInterval = 15 * time.Second
+
+// GET /stream
+// GET /environments/{environment}/stream
+// GET /projects/{project}/stream
+// GET /projects/{project}/environments/{environment}/stream
+// Streams changes to flags and segments as server-sent events. If a user
+// is given, with the userId and context (as JSON) query parameters, all
+// flags are evaluated for the user when connecting and again after every
+// change, and the evaluations are streamed instead.
+func (s *Server) handleStream(w http.ResponseWriter, r *http.Request) {
+	span, ctx := opentracing.StartSpanFromContext(r.Context(), "GET /stream")
+	defer span.Finish()
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		_ = render.Render(w, r, formatErr(fmt.Errorf("%w: streaming is not supported", internalerrors.ErrCannotRenderResponse)))
+		return
+	}
+
+	query := r.URL.Query()
+	er := &service.EvaluationRequest{
+		UserID:      query.Get("userId"),
+		UserContext: make(flaggio.UserContext),
+	}
+	evaluate := er.UserID != "" || query.Get("context") != ""
+	if query.Get("context") != "" {
+		if err := json.Unmarshal([]byte(query.Get("context")), &er.UserContext); err != nil {
+			_ = render.Render(w, r, formatErr(internalerrors.BadRequest("invalid user context: "+err.Error())))
+			return
+		}
+	}
+	_ = er.Bind(r)
+	if err := scopeRequest(r, er); err != nil {
+		_ = render.Render(w, r, formatErr(err))
+		return
+	}
+
+	// find the project, so that changes in other projects can be skipped
+	var projectID *string
+	if er.Project != "" {
+		prj, err := s.projectsRepo.FindByKey(ctx, er.Project)
+		if err != nil {
+			_ = render.Render(w, r, formatErr(err))
+			return
+		}
+		projectID = &prj.ID
+	}
+
+	// subscribe before the first evaluation, so no changes are missed
+	changes, unsubscribe := s.broker.Subscribe(stream.InProject(projectID))
+	defer unsubscribe()
+
+	var eval *service.EvaluationsResponse
+	if evaluate {
+		var err error
+		if eval, err = s.flagsService.EvaluateAll(ctx, er); err != nil {
+			_ = render.Render(w, r, formatErr(err))
+			return
+		}
+	}
+
+	// streams are long lived, so writes can't have the deadline of the
+	// server write timeout, which is only meant for the other requests
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if eval != nil {
+		if err := writeEvent(w, "evaluations", eval); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case evt, ok := <-changes:
+			if !ok {
+				// the client is too slow or the server is shutting
+				// down, it has to reconnect
+				return
+			}
+			if !evaluate {
+				if err := writeEvent(w, "change", evt); err != nil {
+					return
+				}
+				break
+			}
+			// evaluate once for all the changes received so far
+			stream.SkipPending(changes)
+			eval, err := s.flagsService.EvaluateAll(ctx, er)
+			if err != nil {
+				_ = writeEvent(w, "error", formatErr(err))
+				return
+			}
+			if err := writeEvent(w, "evaluations", eval); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+func writeEvent(w http.ResponseWriter, event string, data interface{}) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, b)
+	return err
+}
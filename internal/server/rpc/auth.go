@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/victorkt/flaggio/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// authenticateUnary validates the API key of unary calls.
+func (s *Server) authenticateUnary(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	creds, err := s.credentials(ctx)
+	if err != nil {
+		return nil, formatErr(err)
+	}
+	return handler(auth.NewCredentialsContext(ctx, creds), req)
+}
+
+// authenticateStream validates the API key of streaming calls.
+func (s *Server) authenticateStream(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	creds, err := s.credentials(ss.Context())
+	if err != nil {
+		return formatErr(err)
+	}
+	return handler(srv, &authenticatedStream{
+		ServerStream: ss,
+		ctx:          auth.NewCredentialsContext(ss.Context(), creds),
+	})
+}
+
+// credentials validates the API key sent in the authorization metadata,
+// as a bearer token. Requests with a missing, unknown or revoked key are
+// rejected.
+func (s *Server) credentials(ctx context.Context) (*auth.Credentials, error) {
+	var key string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			key = strings.TrimPrefix(values[0], "Bearer ")
+		}
+	}
+	return s.apiKeys.Authenticate(ctx, key)
+}
+
+// authenticatedStream is a server stream with the request credentials
+// in its context.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the stream.
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,178 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/golang/protobuf/jsonpb"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/victorkt/flaggio/internal/auth"
+	internalerrors "github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/service"
+	"github.com/victorkt/flaggio/pkg/flaggiopb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// newEvaluationRequest returns an evaluation request for the given user,
+// scoped by the API key used to authenticate the request. As in the REST
+// API, $userId and $ip are added to the user context, and client keys
+// can't be used for debugging.
+func newEvaluationRequest(ctx context.Context, project, environment string, user *flaggiopb.User, debug bool) (*service.EvaluationRequest, error) {
+	er := &service.EvaluationRequest{
+		UserContext: make(flaggio.UserContext),
+	}
+	if user != nil {
+		er.UserID = user.Id
+		if err := fromStruct(user.Context, &er.UserContext); err != nil {
+			return nil, internalerrors.BadRequest("invalid user context: " + err.Error())
+		}
+	}
+	er.UserContext["$userId"] = er.UserID
+	if p, ok := peer.FromContext(ctx); ok {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		er.UserContext["$ip"] = host
+	}
+
+	creds := auth.CredentialsFromContext(ctx)
+	var err error
+	if er.Project, er.Environment, err = creds.Scope(project, environment); err != nil {
+		return nil, err
+	}
+	if debug && !creds.IsClient() {
+		// client keys are public, so the flag configuration
+		// can't be exposed through debugging
+		er.Debug = &debug
+	}
+	return er, nil
+}
+
+func toEvaluateAllResponse(eval *service.EvaluationsResponse) (*flaggiopb.EvaluateAllResponse, error) {
+	res := &flaggiopb.EvaluateAllResponse{
+		Evaluations: make([]*flaggiopb.Evaluation, len(eval.Evaluations)),
+	}
+	var err error
+	for i, e := range eval.Evaluations {
+		if res.Evaluations[i], err = toEvaluation(e); err != nil {
+			return nil, err
+		}
+	}
+	if res.Context, err = toStruct(eval.UserContext); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func toEvaluation(e *flaggio.Evaluation) (*flaggiopb.Evaluation, error) {
+	value, err := toValue(e.Value)
+	if err != nil {
+		return nil, err
+	}
+	eval := &flaggiopb.Evaluation{
+		FlagKey:   e.FlagKey,
+		Value:     value,
+		VariantId: e.VariantID,
+		Reason:    string(e.Reason),
+		Error:     e.Error,
+	}
+	for _, st := range e.StackTrace {
+		answer, err := toValue(st.Answer)
+		if err != nil {
+			return nil, err
+		}
+		trace := &flaggiopb.StackTrace{Type: st.Type, Answer: answer, Error: st.Error}
+		if st.ID != nil {
+			trace.Id = *st.ID
+		}
+		eval.StackTrace = append(eval.StackTrace, trace)
+	}
+	return eval, nil
+}
+
+func toChangeEvent(evt *flaggio.ChangeEvent) *flaggiopb.ChangeEvent {
+	change := &flaggiopb.ChangeEvent{
+		Type: string(evt.Type),
+		Id:   evt.ID,
+		Key:  evt.Key,
+	}
+	if evt.ProjectID != nil {
+		change.ProjectId = *evt.ProjectID
+	}
+	return change
+}
+
+// toValue converts any JSON value to a protobuf value.
+func toValue(v interface{}) (*_struct.Value, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	value := &_struct.Value{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(b), value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// toStruct converts a user context to a protobuf struct.
+// A nil user context is converted to a nil struct.
+func toStruct(usrContext *flaggio.UserContext) (*_struct.Struct, error) {
+	if usrContext == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(usrContext)
+	if err != nil {
+		return nil, err
+	}
+	s := &_struct.Struct{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(b), s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// fromStruct decodes a protobuf struct into a user context.
+func fromStruct(s *_struct.Struct, usrContext *flaggio.UserContext) error {
+	if s == nil {
+		return nil
+	}
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, s); err != nil {
+		return err
+	}
+	return json.Unmarshal(buf.Bytes(), usrContext)
+}
+
+// formatErr converts an error to a gRPC status error, with a code that
+// matches the HTTP status code of application errors.
+func formatErr(err error) error {
+	var e internalerrors.Err
+	if !errors.As(err, &e) {
+		return status.Error(codes.Internal, err.Error())
+	}
+	code := codes.Internal
+	switch e.StatusCode() {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusUnauthorized:
+		code = codes.Unauthenticated
+	case http.StatusForbidden:
+		code = codes.PermissionDenied
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusUnprocessableEntity:
+		code = codes.FailedPrecondition
+	case http.StatusNotImplemented:
+		code = codes.Unimplemented
+	}
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,184 @@
+// Package rpc is the gRPC counterpart of the API server. It evaluates
+// flags and streams changes using the same services as the REST API.
+package rpc
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/auth"
+	"github.com/victorkt/flaggio/internal/repository"
+	"github.com/victorkt/flaggio/internal/service"
+	"github.com/victorkt/flaggio/internal/stream"
+	"github.com/victorkt/flaggio/pkg/flaggiopb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var _ flaggiopb.EvaluatorServer = (*Server)(nil)
+
+// NewServer returns a new gRPC server with the Evaluator service registered.
+// Requests are authenticated with API keys, unless apiKeysRepo is nil.
+// Changes are streamed to clients, unless broker is nil.
+func NewServer(
+	flagsService service.Flag,
+	apiKeysRepo repository.APIKey,
+	projectsRepo repository.Project,
+	environmentsRepo repository.Environment,
+	broker *stream.Broker,
+) *grpc.Server {
+	srv := &Server{
+		flagsService: flagsService,
+		projectsRepo: projectsRepo,
+		broker:       broker,
+	}
+	if apiKeysRepo != nil {
+		srv.apiKeys = auth.NewAPIKeys(apiKeysRepo, projectsRepo, environmentsRepo)
+	}
+	var opts []grpc.ServerOption
+	if srv.apiKeys != nil {
+		opts = append(opts,
+			grpc.UnaryInterceptor(srv.authenticateUnary),
+			grpc.StreamInterceptor(srv.authenticateStream),
+		)
+	}
+	grpcSrv := grpc.NewServer(opts...)
+	flaggiopb.RegisterEvaluatorServer(grpcSrv, srv)
+	return grpcSrv
+}
+
+// Server handles evaluation requests
+type Server struct {
+	flagsService service.Flag
+	apiKeys      *auth.APIKeys
+	projectsRepo repository.Project
+	broker       *stream.Broker
+}
+
+// Evaluate evaluates a given flag for the user, optionally in a project and environment
+func (s *Server) Evaluate(ctx context.Context, req *flaggiopb.EvaluateRequest) (*flaggiopb.EvaluateResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Evaluator/Evaluate")
+	defer span.Finish()
+
+	er, err := newEvaluationRequest(ctx, req.Project, req.Environment, req.User, req.Debug)
+	if err != nil {
+		return nil, formatErr(err)
+	}
+	eval, err := s.flagsService.Evaluate(ctx, req.FlagKey, er)
+	if err != nil {
+		return nil, formatErr(err)
+	}
+	res := &flaggiopb.EvaluateResponse{}
+	if res.Evaluation, err = toEvaluation(eval.Evaluation); err != nil {
+		return nil, formatErr(err)
+	}
+	if res.Context, err = toStruct(eval.UserContext); err != nil {
+		return nil, formatErr(err)
+	}
+	return res, nil
+}
+
+// EvaluateAll evaluates all flags for the user, or only the ones with the
+// given keys, optionally in a project and environment
+func (s *Server) EvaluateAll(ctx context.Context, req *flaggiopb.EvaluateAllRequest) (*flaggiopb.EvaluateAllResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Evaluator/EvaluateAll")
+	defer span.Finish()
+
+	er, err := newEvaluationRequest(ctx, req.Project, req.Environment, req.User, req.Debug)
+	if err != nil {
+		return nil, formatErr(err)
+	}
+	er.Keys = req.Keys
+	eval, err := s.flagsService.EvaluateAll(ctx, er)
+	if err != nil {
+		return nil, formatErr(err)
+	}
+	res, err := toEvaluateAllResponse(eval)
+	if err != nil {
+		return nil, formatErr(err)
+	}
+	return res, nil
+}
+
+// Watch streams changes to flags and segments, optionally in a project and
+// environment. If a user is given, all flags are evaluated for the user when
+// connecting and again after every change, and the evaluations are streamed
+// instead.
+func (s *Server) Watch(req *flaggiopb.WatchRequest, srv flaggiopb.Evaluator_WatchServer) error {
+	span, ctx := opentracing.StartSpanFromContext(srv.Context(), "Evaluator/Watch")
+	defer span.Finish()
+
+	if s.broker == nil {
+		return status.Error(codes.Unimplemented, "streaming is disabled")
+	}
+	er, err := newEvaluationRequest(ctx, req.Project, req.Environment, req.User, false)
+	if err != nil {
+		return formatErr(err)
+	}
+	evaluate := req.User != nil
+
+	// find the project, so that changes in other projects can be skipped
+	var projectID *string
+	if er.Project != "" {
+		prj, err := s.projectsRepo.FindByKey(ctx, er.Project)
+		if err != nil {
+			return formatErr(err)
+		}
+		projectID = &prj.ID
+	}
+
+	// subscribe before the first evaluation, so no changes are missed
+	changes, unsubscribe := s.broker.Subscribe(stream.InProject(projectID))
+	defer unsubscribe()
+
+	sendEvaluations := func() error {
+		eval, err := s.flagsService.EvaluateAll(ctx, er)
+		if err != nil {
+			return formatErr(err)
+		}
+		res, err := toEvaluateAllResponse(eval)
+		if err != nil {
+			return formatErr(err)
+		}
+		return srv.Send(&flaggiopb.WatchResponse{
+			Event: &flaggiopb.WatchResponse_Evaluations{Evaluations: res},
+		})
+	}
+	if evaluate {
+		if err := sendEvaluations(); err != nil {
+			return err
+		}
+	} else if err := srv.SendHeader(metadata.MD{}); err != nil {
+		// the headers are sent right away, so that clients
+		// know when the changes start being streamed
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case evt, ok := <-changes:
+			if !ok {
+				// the client is too slow or the server is shutting
+				// down, it has to reconnect
+				return status.Error(codes.ResourceExhausted, "too many pending changes")
+			}
+			if !evaluate {
+				if err := srv.Send(&flaggiopb.WatchResponse{
+					Event: &flaggiopb.WatchResponse_Change{Change: toChangeEvent(evt)},
+				}); err != nil {
+					return err
+				}
+				continue
+			}
+			// evaluate once for all the changes received so far
+			stream.SkipPending(changes)
+			if err := sendEvaluations(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/stretchr/testify/assert"
+	internalerrors "github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+	repository_mock "github.com/victorkt/flaggio/internal/repository/mocks"
+	"github.com/victorkt/flaggio/internal/server/rpc"
+	"github.com/victorkt/flaggio/internal/service"
+	service_mock "github.com/victorkt/flaggio/internal/service/mocks"
+	"github.com/victorkt/flaggio/internal/stream"
+	"github.com/victorkt/flaggio/pkg/flaggiopb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var (
+	ctxInterface = reflect.TypeOf((*context.Context)(nil)).Elem()
+)
+
+// newClient starts a gRPC server in memory and returns a client connected
+// to it, along with a function that stops both.
+func newClient(t *testing.T, flagsService service.Flag, apiKeysRepo repository.APIKey, projectsRepo repository.Project, broker *stream.Broker) (flaggiopb.EvaluatorClient, func()) {
+	lis := bufconn.Listen(1024 * 1024)
+	srv := rpc.NewServer(flagsService, apiKeysRepo, projectsRepo, nil, broker)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial server: %s", err)
+	}
+	return flaggiopb.NewEvaluatorClient(conn), func() {
+		_ = conn.Close()
+		srv.Stop()
+	}
+}
+
+func TestServer_Evaluate(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagsService := service_mock.NewMockFlag(mockCtrl)
+	client, stop := newClient(t, flagsService, nil, nil, nil)
+	defer stop()
+
+	flagsService.EXPECT().Evaluate(gomock.AssignableToTypeOf(ctxInterface), "f1", gomock.Any()).Times(1).
+		DoAndReturn(func(_ context.Context, _ string, er *service.EvaluationRequest) (*service.EvaluationResponse, error) {
+			assert.Equal(t, "website", er.Project)
+			assert.Equal(t, "production", er.Environment)
+			assert.Equal(t, "123", er.UserID)
+			assert.Equal(t, "br", er.UserContext["country"])
+			assert.Equal(t, int64(30), er.UserContext["age"])
+			assert.Equal(t, "123", er.UserContext["$userId"])
+			assert.True(t, er.IsDebug())
+			return &service.EvaluationResponse{
+				Evaluation: &flaggio.Evaluation{
+					FlagKey:   "f1",
+					Value:     map[string]interface{}{"a": []interface{}{1, "b"}},
+					VariantID: "v1",
+					Reason:    flaggio.ReasonTargetingMatch,
+				},
+			}, nil
+		})
+	flagsService.EXPECT().Evaluate(gomock.AssignableToTypeOf(ctxInterface), "f2", gomock.Any()).Times(1).
+		Return(nil, internalerrors.NotFound("flag"))
+
+	res, err := client.Evaluate(context.Background(), &flaggiopb.EvaluateRequest{
+		FlagKey:     "f1",
+		Project:     "website",
+		Environment: "production",
+		User: &flaggiopb.User{
+			Id: "123",
+			Context: &_struct.Struct{Fields: map[string]*_struct.Value{
+				"country": {Kind: &_struct.Value_StringValue{StringValue: "br"}},
+				"age":     {Kind: &_struct.Value_NumberValue{NumberValue: 30}},
+			}},
+		},
+		Debug: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "f1", res.Evaluation.FlagKey)
+	assert.Equal(t, "v1", res.Evaluation.VariantId)
+	assert.Equal(t, "TARGETING_MATCH", res.Evaluation.Reason)
+	list := res.Evaluation.Value.GetStructValue().Fields["a"].GetListValue().Values
+	assert.Equal(t, float64(1), list[0].GetNumberValue())
+	assert.Equal(t, "b", list[1].GetStringValue())
+	assert.Nil(t, res.Context)
+
+	_, err = client.Evaluate(context.Background(), &flaggiopb.EvaluateRequest{FlagKey: "f2"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestServer_EvaluateAll(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagsService := service_mock.NewMockFlag(mockCtrl)
+	client, stop := newClient(t, flagsService, nil, nil, nil)
+	defer stop()
+
+	flagsService.EXPECT().EvaluateAll(gomock.AssignableToTypeOf(ctxInterface), gomock.Any()).Times(1).
+		DoAndReturn(func(_ context.Context, er *service.EvaluationRequest) (*service.EvaluationsResponse, error) {
+			assert.Equal(t, []string{"f1", "f2"}, er.Keys)
+			return &service.EvaluationsResponse{
+				Evaluations: flaggio.EvaluationList{
+					{FlagKey: "f1", Value: true, VariantID: "v1", Reason: flaggio.ReasonDefault},
+					{FlagKey: "f2", Error: "no default variant defined for flag", Reason: flaggio.ReasonError},
+				},
+			}, nil
+		})
+
+	res, err := client.EvaluateAll(context.Background(), &flaggiopb.EvaluateAllRequest{
+		User: &flaggiopb.User{Id: "123"},
+		Keys: []string{"f1", "f2"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res.Evaluations, 2)
+	assert.True(t, res.Evaluations[0].Value.GetBoolValue())
+	assert.Equal(t, "DEFAULT", res.Evaluations[0].Reason)
+	assert.Equal(t, "no default variant defined for flag", res.Evaluations[1].Error)
+	assert.Equal(t, "ERROR", res.Evaluations[1].Reason)
+}
+
+func TestServer_Authentication(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagsService := service_mock.NewMockFlag(mockCtrl)
+	apiKeysRepo := repository_mock.NewMockAPIKey(mockCtrl)
+	projectsRepo := repository_mock.NewMockProject(mockCtrl)
+	client, stop := newClient(t, flagsService, apiKeysRepo, projectsRepo, nil)
+	defer stop()
+
+	prjID, deletedPrjID := "p1", "p2"
+	apiKeysRepo.EXPECT().FindByHash(gomock.AssignableToTypeOf(ctxInterface), flaggio.HashAPIKey("clt-key")).
+		Return(&flaggio.APIKey{ID: "1", Kind: flaggio.APIKeyKindClient, ProjectID: &prjID}, nil).AnyTimes()
+	apiKeysRepo.EXPECT().FindByHash(gomock.AssignableToTypeOf(ctxInterface), flaggio.HashAPIKey("orphan-key")).
+		Return(&flaggio.APIKey{ID: "2", Kind: flaggio.APIKeyKindServer, ProjectID: &deletedPrjID}, nil).AnyTimes()
+	projectsRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), deletedPrjID).
+		Return(nil, internalerrors.NotFound("project")).AnyTimes()
+	apiKeysRepo.EXPECT().FindByHash(gomock.AssignableToTypeOf(ctxInterface), flaggio.HashAPIKey("unknown")).
+		Return(nil, internalerrors.NotFound("apiKey")).AnyTimes()
+	projectsRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), prjID).
+		Return(&flaggio.Project{ID: prjID, Key: "website"}, nil).AnyTimes()
+	flagsService.EXPECT().Evaluate(gomock.AssignableToTypeOf(ctxInterface), "f1", gomock.Any()).Times(1).
+		DoAndReturn(func(_ context.Context, _ string, er *service.EvaluationRequest) (*service.EvaluationResponse, error) {
+			// the project comes from the key and debugging is disabled for client keys
+			assert.Equal(t, "website", er.Project)
+			assert.False(t, er.IsDebug())
+			return &service.EvaluationResponse{Evaluation: &flaggio.Evaluation{FlagKey: "f1", Value: true}}, nil
+		})
+
+	withKey := func(key string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+key)
+	}
+	_, err := client.Evaluate(context.Background(), &flaggiopb.EvaluateRequest{FlagKey: "f1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.Evaluate(withKey("unknown"), &flaggiopb.EvaluateRequest{FlagKey: "f1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	// keys of deleted projects are no longer valid
+	_, err = client.Evaluate(withKey("orphan-key"), &flaggiopb.EvaluateRequest{FlagKey: "f1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.Evaluate(withKey("clt-key"), &flaggiopb.EvaluateRequest{FlagKey: "f1", Project: "other"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.Evaluate(withKey("clt-key"), &flaggiopb.EvaluateRequest{FlagKey: "f1", Debug: true})
+	assert.NoError(t, err)
+
+	// streams are authenticated too
+	watch, err := client.Watch(context.Background(), &flaggiopb.WatchRequest{})
+	assert.NoError(t, err)
+	_, err = watch.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestServer_Watch(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagsService := service_mock.NewMockFlag(mockCtrl)
+	projectsRepo := repository_mock.NewMockProject(mockCtrl)
+	feed := repository_mock.NewMockChangeFeed(mockCtrl)
+	broker := stream.NewBroker(feed)
+	client, stop := newClient(t, flagsService, nil, projectsRepo, broker)
+	defer stop()
+
+	publish := make(chan *flaggio.ChangeEvent)
+	feed.EXPECT().Listen(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, fn func(*flaggio.ChangeEvent)) error {
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case evt := <-publish:
+					fn(evt)
+				}
+			}
+		})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = broker.Run(ctx)
+	}()
+
+	prj1, prj2 := "p1", "p2"
+	projectsRepo.EXPECT().FindByKey(gomock.AssignableToTypeOf(ctxInterface), "website").
+		Return(&flaggio.Project{ID: prj1, Key: "website"}, nil).AnyTimes()
+
+	t.Run("streams changes", func(t *testing.T) {
+		watch, err := client.Watch(ctx, &flaggiopb.WatchRequest{Project: "website"})
+		assert.NoError(t, err)
+		// the headers are sent once the server has subscribed to the changes
+		_, err = watch.Header()
+		assert.NoError(t, err)
+
+		publish <- &flaggio.ChangeEvent{Type: flaggio.ChangeTypeFlag, ID: "1", Key: "f1", ProjectID: &prj2}
+		publish <- &flaggio.ChangeEvent{Type: flaggio.ChangeTypeFlag, ID: "2", Key: "f2", ProjectID: &prj1}
+		res, err := watch.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, &flaggiopb.ChangeEvent{Type: "flag", Id: "2", Key: "f2", ProjectId: prj1}, res.GetChange())
+	})
+
+	t.Run("streams evaluations", func(t *testing.T) {
+		flagsService.EXPECT().EvaluateAll(gomock.AssignableToTypeOf(ctxInterface), gomock.Any()).Times(2).
+			Return(&service.EvaluationsResponse{
+				Evaluations: flaggio.EvaluationList{{FlagKey: "f1", Value: "a"}},
+			}, nil)
+		watch, err := client.Watch(ctx, &flaggiopb.WatchRequest{User: &flaggiopb.User{Id: "123"}})
+		assert.NoError(t, err)
+		res, err := watch.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, "a", res.GetEvaluations().Evaluations[0].Value.GetStringValue())
+
+		publish <- &flaggio.ChangeEvent{Type: flaggio.ChangeTypeSegment, ID: "3"}
+		res, err = watch.Recv()
+		assert.NoError(t, err)
+		assert.Len(t, res.GetEvaluations().Evaluations, 1)
+	})
+}
@@ -4,12 +4,18 @@ package service
 
 import (
 	"context"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
 )
 
 // Flag holds the logic for evaluating flags
 type Flag interface {
 	// Evaluate returns the result of an evaluation of a single flag.
 	Evaluate(ctx context.Context, flagKey string, req *EvaluationRequest) (*EvaluationResponse, error)
-	// EvaluateAll returns the results of the evaluation of all flags.
+	// EvaluateAll returns the results of the evaluation of all flags, or of the requested ones.
 	EvaluateAll(ctx context.Context, req *EvaluationRequest) (*EvaluationsResponse, error)
+	// EvaluateBatch evaluates flags for many users, calling fn with the results of each user.
+	EvaluateBatch(ctx context.Context, req *BatchEvaluationRequest, fn func(*BatchEvaluationResult) error) error
+	// Ruleset returns all flags and segments, so that flags can be evaluated locally.
+	Ruleset(ctx context.Context, req *RulesetRequest) (*flaggio.Ruleset, error)
 }
@@ -2,8 +2,12 @@ package service
 
 import (
 	"context"
+	"runtime"
+	"sync"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/errors"
 	"github.com/victorkt/flaggio/internal/flaggio"
 	"github.com/victorkt/flaggio/internal/repository"
 )
@@ -14,16 +18,25 @@ var _ Flag = (*flagService)(nil)
 func NewFlagService(
 	flagsRepo repository.Flag,
 	segmentsRepo repository.Segment,
+	targetsRepo repository.Target,
+	environmentsRepo repository.Environment,
+	projectsRepo repository.Project,
 ) Flag {
 	return &flagService{
-		flagsRepo:    flagsRepo,
-		segmentsRepo: segmentsRepo,
+		flagsRepo:        flagsRepo,
+		segmentsRepo:     segmentsRepo,
+		targetsRepo:      targetsRepo,
+		environmentsRepo: environmentsRepo,
+		projectsRepo:     projectsRepo,
 	}
 }
 
 type flagService struct {
-	flagsRepo    repository.Flag
-	segmentsRepo repository.Segment
+	flagsRepo        repository.Flag
+	segmentsRepo     repository.Segment
+	targetsRepo      repository.Target
+	environmentsRepo repository.Environment
+	projectsRepo     repository.Project
 }
 
 // Evaluate evaluates a flag by key, returning a value based on the user context
@@ -31,17 +44,48 @@ func (s *flagService) Evaluate(ctx context.Context, flagKey string, req *Evaluat
 	span, ctx := opentracing.StartSpanFromContext(ctx, "FlagService.Evaluate")
 	defer span.Finish()
 
-	flg, err := s.flagsRepo.FindByKey(ctx, flagKey)
+	prjID, err := s.findProjectID(ctx, req.Project)
 	if err != nil {
 		return nil, err
 	}
-	sgmts, err := segmentsAsIdentifiers(s.segmentsRepo.FindAll(ctx, nil, nil))
+	envID, err := s.findEnvironmentID(ctx, req.Environment)
 	if err != nil {
 		return nil, err
 	}
+	flg, err := s.flagsRepo.FindByKey(ctx, prjID, flagKey)
+	if err != nil {
+		return nil, err
+	}
+	if envID != "" {
+		flg = flg.InEnvironment(envID)
+	}
+	iders, err := segmentsAsIdentifiers(s.segmentsRepo.FindAll(ctx, prjID, nil, nil))
+	if err != nil {
+		return nil, err
+	}
+	tgts, err := s.findTargets(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(flg.Prerequisites) > 0 {
+		// prerequisites reference other flags, which in turn
+		// may reference segments
+		flgs, err := s.flagsRepo.FindAll(ctx, prjID, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		flgs.Flags = inEnvironment(envID, flgs.Flags...)
+		iders = append(iders, flagsAsIdentifiers(flgs.Flags)...)
+		for _, prereqFlg := range flgs.Flags {
+			prereqFlg.Populate(iders)
+			prereqFlg.Targets = tgts[prereqFlg.ID]
+		}
+	}
+	flg.Targets = tgts[flg.ID]
 
-	flg.Populate(sgmts)
+	flg.Populate(iders)
 
+	now := time.Now()
 	evalSpan, _ := opentracing.StartSpanFromContext(ctx, "flaggio.Evaluate")
 	res, err := flaggio.Evaluate(req.UserContext, flg)
 	evalSpan.Finish()
@@ -51,9 +95,13 @@ func (s *flagService) Evaluate(ctx context.Context, flagKey string, req *Evaluat
 
 	evalRes := &EvaluationResponse{
 		Evaluation: &flaggio.Evaluation{
-			FlagKey: flagKey,
-			Value:   res.Answer,
+			FlagKey:     flagKey,
+			FlagVersion: flg.Version,
+			Value:       res.Answer,
+			VariantID:   res.VariantID,
+			Reason:      res.Reason(),
 		},
+		ExpiresAt: nextClockChange(now, flg),
 	}
 
 	if req.Debug != nil && *req.Debug {
@@ -64,41 +112,49 @@ func (s *flagService) Evaluate(ctx context.Context, flagKey string, req *Evaluat
 	return evalRes, nil
 }
 
-// EvaluateAll evaluates all flags, returning a value or an error for each flag based on the user context
+// EvaluateAll evaluates all flags, returning a value or an error for each flag based on the user context.
+// If keys are given in the request, only the flags with those keys are evaluated.
 func (s *flagService) EvaluateAll(ctx context.Context, req *EvaluationRequest) (*EvaluationsResponse, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "FlagService.EvaluateAll")
 	defer span.Finish()
 
-	flgs, err := s.flagsRepo.FindAll(ctx, nil, nil, nil)
+	prjID, err := s.findProjectID(ctx, req.Project)
 	if err != nil {
 		return nil, err
 	}
-	sgmts, err := segmentsAsIdentifiers(s.segmentsRepo.FindAll(ctx, nil, nil))
+	envID, err := s.findEnvironmentID(ctx, req.Environment)
+	if err != nil {
+		return nil, err
+	}
+	flgs, allFlgs, err := s.findFlags(ctx, prjID, envID, req.Keys)
+	if err != nil {
+		return nil, err
+	}
+	iders, err := segmentsAsIdentifiers(s.segmentsRepo.FindAll(ctx, prjID, nil, nil))
+	if err != nil {
+		return nil, err
+	}
+	iders = append(iders, flagsAsIdentifiers(allFlgs)...)
+	tgts, err := s.findTargets(ctx, req.UserID)
 	if err != nil {
 		return nil, err
 	}
 
-	evals := make([]*flaggio.Evaluation, flgs.Total)
+	evals := make([]*flaggio.Evaluation, len(flgs))
+	now := time.Now()
 	evalSpan, _ := opentracing.StartSpanFromContext(ctx, "flaggio.Evaluate")
-	for idx, flg := range flgs.Flags {
-		flg.Populate(sgmts)
-
-		evltn := &flaggio.Evaluation{
-			FlagKey: flg.Key,
-		}
-		res, err := flaggio.Evaluate(req.UserContext, flg)
-		if err != nil {
-			evltn.Error = err.Error()
-		} else {
-			evltn.Value = res.Answer
-		}
-
-		evals[idx] = evltn
+	for _, flg := range allFlgs {
+		flg.Populate(iders)
+		flg.Targets = tgts[flg.ID]
+	}
+	for idx, flg := range flgs {
+		evals[idx] = evaluate(req.UserContext, flg)
 	}
 	evalSpan.Finish()
 
 	evalRes := &EvaluationsResponse{
 		Evaluations: evals,
+		ExpiresAt:   nextClockChange(now, flgs...),
 	}
 
 	if req.Debug != nil && *req.Debug {
@@ -108,6 +164,294 @@ func (s *flagService) EvaluateAll(ctx context.Context, req *EvaluationRequest) (
 	return evalRes, nil
 }
 
+// Ruleset returns all flags and segments, so that flags can be evaluated locally.
+// Flags are returned with the settings of the requested environment, if any.
+func (s *flagService) Ruleset(ctx context.Context, req *RulesetRequest) (*flaggio.Ruleset, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "FlagService.Ruleset")
+	defer span.Finish()
+
+	flgs, sgmnts, err := s.findRules(ctx, req.Project, req.Environment)
+	if err != nil {
+		return nil, err
+	}
+	return flaggio.NewRuleset(flgs, sgmnts)
+}
+
+// EvaluateBatch evaluates flags for many users. Flags and segments are loaded
+// only once, and users are evaluated in parallel. fn is called with the
+// results of each user as soon as they are evaluated, so not necessarily in
+// the order of the requests, but never concurrently. If fn returns an error,
+// the evaluation stops and the error is returned.
+func (s *flagService) EvaluateBatch(ctx context.Context, req *BatchEvaluationRequest, fn func(*BatchEvaluationResult) error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "FlagService.EvaluateBatch")
+	defer span.Finish()
+
+	flgs, sgmnts, err := s.findRules(ctx, req.Project, req.Environment)
+	if err != nil {
+		return err
+	}
+	iders := make([]flaggio.Identifier, 0, len(flgs)+len(sgmnts))
+	for _, sgmnt := range sgmnts {
+		iders = append(iders, sgmnt)
+	}
+	iders = append(iders, flagsAsIdentifiers(flgs)...)
+	for _, flg := range flgs {
+		flg.Populate(iders)
+	}
+	flgs, missing := selectFlags(flgs, req.FlagKeys)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	jobs := make(chan int)
+	results := make(chan *BatchEvaluationResult)
+	go func() {
+		defer close(jobs)
+		for idx := range req.Requests {
+			select {
+			case jobs <- idx:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				er := req.Requests[idx]
+				res := &BatchEvaluationResult{
+					Index:       idx,
+					UserID:      er.UserID,
+					Evaluations: make(flaggio.EvaluationList, 0, len(flgs)+len(missing)),
+				}
+				for _, flg := range flgs {
+					res.Evaluations = append(res.Evaluations, evaluate(er.UserContext, flg))
+				}
+				for _, flagKey := range missing {
+					res.Evaluations = append(res.Evaluations, &flaggio.Evaluation{
+						FlagKey: flagKey,
+						Reason:  flaggio.ReasonError,
+						Error:   errors.NotFound("flag").Error(),
+					})
+				}
+				if er.IsDebug() {
+					res.UserContext = &er.UserContext
+				}
+				select {
+				case results <- res:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for res := range results {
+		if err := fn(res); err != nil {
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
+// findProjectID returns the ID of the project with the given key.
+// If no key is given, a nil ID is returned.
+func (s *flagService) findProjectID(ctx context.Context, prjKey string) (*string, error) {
+	if prjKey == "" {
+		return nil, nil
+	}
+	prj, err := s.projectsRepo.FindByKey(ctx, prjKey)
+	if err != nil {
+		return nil, err
+	}
+	return &prj.ID, nil
+}
+
+// findFlags returns the flags with the given keys, or all flags if no keys are
+// given, along with the flags they may reference as prerequisites. Flags have
+// the settings of the given environment, if any. Flags with the given keys
+// are only fetched by key when they have no prerequisites, otherwise all
+// flags have to be fetched anyway.
+func (s *flagService) findFlags(ctx context.Context, prjID *string, envID string, keys []string) (flgs, allFlgs []*flaggio.Flag, err error) {
+	if len(keys) > 0 {
+		flgs, err := s.flagsRepo.FindByKeys(ctx, prjID, keys)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !hasPrerequisites(flgs) {
+			flgs = inEnvironment(envID, flgs...)
+			return flgs, flgs, nil
+		}
+	}
+	res, err := s.flagsRepo.FindAll(ctx, prjID, nil, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	allFlgs = inEnvironment(envID, res.Flags...)
+	if len(keys) == 0 {
+		return allFlgs, allFlgs, nil
+	}
+	flgs, _ = selectFlags(allFlgs, keys)
+	return flgs, allFlgs, nil
+}
+
+// findRules returns the flags, with their targets, and the segments in the
+// project with the given key. Flags have the settings of the environment
+// with the given key, if any.
+func (s *flagService) findRules(ctx context.Context, prjKey, envKey string) ([]*flaggio.Flag, []*flaggio.Segment, error) {
+	prjID, err := s.findProjectID(ctx, prjKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	envID, err := s.findEnvironmentID(ctx, envKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	flgs, err := s.flagsRepo.FindAll(ctx, prjID, nil, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	flgs.Flags = inEnvironment(envID, flgs.Flags...)
+	flagIDs := make([]string, len(flgs.Flags))
+	for idx, flg := range flgs.Flags {
+		flagIDs[idx] = flg.ID
+	}
+	// targets of all flags are loaded at once
+	tgts, err := s.targetsRepo.FindAllByFlagIDs(ctx, flagIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	tgtsByFlag := map[string][]*flaggio.FlagTarget{}
+	for _, tgt := range tgts {
+		tgtsByFlag[tgt.FlagID] = append(tgtsByFlag[tgt.FlagID], tgt)
+	}
+	for _, flg := range flgs.Flags {
+		// the settings of other environments are not needed
+		flg.Environments = nil
+		flg.Targets = tgtsByFlag[flg.ID]
+	}
+	sgmnts, err := s.segmentsRepo.FindAll(ctx, prjID, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return flgs.Flags, sgmnts, nil
+}
+
+// findEnvironmentID returns the ID of the environment with the given key.
+// If no key is given, an empty ID is returned.
+func (s *flagService) findEnvironmentID(ctx context.Context, envKey string) (string, error) {
+	if envKey == "" {
+		return "", nil
+	}
+	env, err := s.environmentsRepo.FindByKey(ctx, envKey)
+	if err != nil {
+		return "", err
+	}
+	return env.ID, nil
+}
+
+// inEnvironment replaces the flag settings with the settings for the given
+// environment. If no environment ID is given, the flags are left as they are.
+func inEnvironment(envID string, flgs ...*flaggio.Flag) []*flaggio.Flag {
+	if envID == "" {
+		return flgs
+	}
+	for idx, flg := range flgs {
+		flgs[idx] = flg.InEnvironment(envID)
+	}
+	return flgs
+}
+
+// findTargets returns the targets that include the given user, grouped by flag ID.
+func (s *flagService) findTargets(ctx context.Context, userID string) (map[string][]*flaggio.FlagTarget, error) {
+	if userID == "" {
+		return nil, nil
+	}
+	tgts, err := s.targetsRepo.FindAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	tgtsByFlag := make(map[string][]*flaggio.FlagTarget, len(tgts))
+	for _, tgt := range tgts {
+		tgtsByFlag[tgt.FlagID] = append(tgtsByFlag[tgt.FlagID], tgt)
+	}
+	return tgtsByFlag, nil
+}
+
+// selectFlags returns the flags with the given keys, in the same order, and
+// the keys that don't match any flag. Repeated keys are skipped. If no keys
+// are given, all flags are returned.
+func selectFlags(flgs []*flaggio.Flag, flagKeys []string) ([]*flaggio.Flag, []string) {
+	if len(flagKeys) == 0 {
+		return flgs, nil
+	}
+	flgsByKey := make(map[string]*flaggio.Flag, len(flgs))
+	for _, flg := range flgs {
+		flgsByKey[flg.Key] = flg
+	}
+	var selected []*flaggio.Flag
+	var missing []string
+	seen := make(map[string]bool, len(flagKeys))
+	for _, flagKey := range flagKeys {
+		if seen[flagKey] {
+			continue
+		}
+		seen[flagKey] = true
+		if flg, ok := flgsByKey[flagKey]; ok {
+			selected = append(selected, flg)
+		} else {
+			missing = append(missing, flagKey)
+		}
+	}
+	return selected, missing
+}
+
+func hasPrerequisites(flgs []*flaggio.Flag) bool {
+	for _, flg := range flgs {
+		if len(flg.Prerequisites) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluate evaluates a flag for the user, returning its value or the error.
+func evaluate(usrContext flaggio.UserContext, flg *flaggio.Flag) *flaggio.Evaluation {
+	evltn := &flaggio.Evaluation{
+		FlagKey:     flg.Key,
+		FlagVersion: flg.Version,
+	}
+	res, err := flaggio.Evaluate(usrContext, flg)
+	evltn.Reason = res.Reason()
+	if err != nil {
+		evltn.Error = err.Error()
+	} else {
+		evltn.Value = res.Answer
+		evltn.VariantID = res.VariantID
+	}
+	return evltn
+}
+
+// nextClockChange returns the earliest time after now at which the
+// evaluation of any of the flags can change only because time passed, or nil
+// if none of them depends on the current time.
+func nextClockChange(now time.Time, flgs ...*flaggio.Flag) *time.Time {
+	var next *time.Time
+	for _, flg := range flgs {
+		t := flg.NextClockChange(now)
+		if !t.IsZero() && (next == nil || t.Before(*next)) {
+			next = &t
+		}
+	}
+	return next
+}
+
 func segmentsAsIdentifiers(sgmts []*flaggio.Segment, err error) ([]flaggio.Identifier, error) {
 	if err != nil {
 		return nil, err
@@ -118,3 +462,11 @@ func segmentsAsIdentifiers(sgmts []*flaggio.Segment, err error) ([]flaggio.Ident
 	}
 	return iders, nil
 }
+
+func flagsAsIdentifiers(flgs []*flaggio.Flag) []flaggio.Identifier {
+	iders := make([]flaggio.Identifier, len(flgs))
+	for idx, flg := range flgs {
+		iders[idx] = flg
+	}
+	return iders
+}
@@ -2,11 +2,15 @@ package service_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	internalerrors "github.com/victorkt/flaggio/internal/errors"
 	"github.com/victorkt/flaggio/internal/flaggio"
 	repository_mock "github.com/victorkt/flaggio/internal/repository/mocks"
 	"github.com/victorkt/flaggio/internal/service"
@@ -25,40 +29,84 @@ func TestFlagService_Evaluate(t *testing.T) {
 	flags := []*flaggio.Flag{
 		{ID: "1", Key: "a", Enabled: false, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]},
 		{ID: "2", Key: "b", Enabled: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]},
+		{ID: "3", Key: "c", Enabled: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1],
+			Rules: []*flaggio.FlagRule{{
+				Rule:          flaggio.Rule{ID: "4"},
+				BucketBy:      "orgId",
+				Distributions: []*flaggio.Distribution{{ID: "5", Variant: variants[1], Percentage: 100}},
+			}},
+		},
+		{ID: "6", Key: "d", Enabled: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1],
+			Rules: []*flaggio.FlagRule{{
+				Rule: flaggio.Rule{ID: "7", Constraints: []*flaggio.Constraint{
+					{Operation: flaggio.OperationBetween, Values: []interface{}{"2000-01-01T00:00:00Z", "2999-01-01T00:00:00Z"}},
+				}},
+				Distributions: []*flaggio.Distribution{{ID: "8", Variant: variants[1], Percentage: 100}},
+			}},
+		},
 	}
+	windowEnd := time.Date(2999, 1, 1, 0, 0, 0, 0, time.UTC)
 	tests := []struct {
 		name               string
 		flagKey            string
+		flag               *flaggio.Flag
 		evaluationRequest  *service.EvaluationRequest
 		expectedEvaluation *service.EvaluationResponse
+		expectedError      error
 		run                func(t *testing.T, mockCtrl *gomock.Controller)
 	}{
 		{
 			name:    "return correct evaluation without debug option",
 			flagKey: "a",
+			flag:    flags[0],
 			evaluationRequest: &service.EvaluationRequest{
 				UserID:      "user1",
 				UserContext: flaggio.UserContext{"name": "John"},
 			},
 			expectedEvaluation: &service.EvaluationResponse{
-				Evaluation: &flaggio.Evaluation{FlagKey: "a", Value: 20},
+				Evaluation: &flaggio.Evaluation{FlagKey: "a", Value: 20, VariantID: "2", Reason: flaggio.ReasonDisabled},
 			},
 		},
 		{
 			name:    "return correct evaluation with debug option",
 			flagKey: "b",
+			flag:    flags[0],
 			evaluationRequest: &service.EvaluationRequest{
 				UserID:      "user2",
 				UserContext: flaggio.UserContext{"name": "John"},
 				Debug:       boolPtr(true),
 			},
 			expectedEvaluation: &service.EvaluationResponse{
-				Evaluation: &flaggio.Evaluation{FlagKey: "b", Value: 20, StackTrace: []*flaggio.StackTrace{
+				Evaluation: &flaggio.Evaluation{FlagKey: "b", Value: 20, VariantID: "2", Reason: flaggio.ReasonDisabled, StackTrace: []*flaggio.StackTrace{
 					{Type: "*Flag", ID: stringPtr("1"), Answer: 20},
 				}},
 				UserContext: &flaggio.UserContext{"name": "John"},
 			},
 		},
+		{
+			name:    "return error when bucketing property is missing",
+			flagKey: "c",
+			flag:    flags[2],
+			evaluationRequest: &service.EvaluationRequest{
+				UserID:      "user3",
+				UserContext: flaggio.UserContext{"name": "John"},
+				Debug:       boolPtr(true),
+			},
+			expectedError: internalerrors.MissingBucketingProperty("orgId"),
+		},
+		{
+			name:    "return when the evaluation can change with the current time",
+			flagKey: "d",
+			flag:    flags[3],
+			evaluationRequest: &service.EvaluationRequest{
+				UserID:      "user4",
+				UserContext: flaggio.UserContext{"$userId": "user4"},
+			},
+			expectedEvaluation: &service.EvaluationResponse{
+				Evaluation: &flaggio.Evaluation{FlagKey: "d", Value: 20, VariantID: "2", Reason: flaggio.ReasonTargetingMatch},
+				ExpiresAt:  &windowEnd,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -70,24 +118,210 @@ func TestFlagService_Evaluate(t *testing.T) {
 			ctx := context.Background()
 			flagRepo := repository_mock.NewMockFlag(mockCtrl)
 			segmentRepo := repository_mock.NewMockSegment(mockCtrl)
-			flagService := service.NewFlagService(flagRepo, segmentRepo)
-			flagResults := flags[0]
+			targetRepo := repository_mock.NewMockTarget(mockCtrl)
+			environmentRepo := repository_mock.NewMockEnvironment(mockCtrl)
+			projectRepo := repository_mock.NewMockProject(mockCtrl)
+			flagService := service.NewFlagService(flagRepo, segmentRepo, targetRepo, environmentRepo, projectRepo)
+			flagResults := tt.flag
 			segmentesults := make([]*flaggio.Segment, 0)
 
 			flagRepo.EXPECT().
-				FindByKey(gomock.AssignableToTypeOf(ctxInterface), tt.flagKey).
+				FindByKey(gomock.AssignableToTypeOf(ctxInterface), nil, tt.flagKey).
 				Times(1).Return(flagResults, nil)
 			segmentRepo.EXPECT().
-				FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil).
+				FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil).
 				Times(1).Return(segmentesults, nil)
+			targetRepo.EXPECT().
+				FindAllByUserID(gomock.AssignableToTypeOf(ctxInterface), tt.evaluationRequest.UserID).
+				Times(1).Return(nil, nil)
 
 			result, err := flagService.Evaluate(ctx, tt.flagKey, tt.evaluationRequest)
-			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedError, err)
 			assert.Equal(t, tt.expectedEvaluation, result)
 		})
 	}
 }
 
+func TestFlagService_EvaluateWithPrerequisites(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.Background()
+	flagRepo := repository_mock.NewMockFlag(mockCtrl)
+	segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+	targetRepo := repository_mock.NewMockTarget(mockCtrl)
+	environmentRepo := repository_mock.NewMockEnvironment(mockCtrl)
+	projectRepo := repository_mock.NewMockProject(mockCtrl)
+	flagService := service.NewFlagService(flagRepo, segmentRepo, targetRepo, environmentRepo, projectRepo)
+	variants := []*flaggio.Variant{
+		{ID: "1", Value: 10},
+		{ID: "2", Value: 20},
+	}
+	prereqFlg := &flaggio.Flag{ID: "1", Key: "a", Enabled: false, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]}
+	flg := &flaggio.Flag{ID: "2", Key: "b", Enabled: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1],
+		Prerequisites: []*flaggio.FlagPrerequisite{{FlagID: "1", VariantID: "1"}},
+	}
+
+	flagRepo.EXPECT().
+		FindByKey(gomock.AssignableToTypeOf(ctxInterface), nil, "b").
+		Times(1).Return(flg, nil)
+	flagRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, nil).
+		Times(1).Return(&flaggio.FlagResults{Flags: []*flaggio.Flag{prereqFlg, flg}, Total: 2}, nil)
+	segmentRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil).
+		Times(1).Return(nil, nil)
+	targetRepo.EXPECT().
+		FindAllByUserID(gomock.AssignableToTypeOf(ctxInterface), "user1").
+		Times(1).Return(nil, nil)
+
+	result, err := flagService.Evaluate(ctx, "b", &service.EvaluationRequest{
+		UserID:      "user1",
+		UserContext: flaggio.UserContext{"name": "John"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &service.EvaluationResponse{
+		Evaluation: &flaggio.Evaluation{FlagKey: "b", Value: 20, VariantID: "2", Reason: flaggio.ReasonTargetingMatch},
+	}, result)
+}
+
+func TestFlagService_EvaluateWithTargets(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.Background()
+	flagRepo := repository_mock.NewMockFlag(mockCtrl)
+	segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+	targetRepo := repository_mock.NewMockTarget(mockCtrl)
+	environmentRepo := repository_mock.NewMockEnvironment(mockCtrl)
+	projectRepo := repository_mock.NewMockProject(mockCtrl)
+	flagService := service.NewFlagService(flagRepo, segmentRepo, targetRepo, environmentRepo, projectRepo)
+	variants := []*flaggio.Variant{
+		{ID: "1", Value: 10},
+		{ID: "2", Value: 20},
+	}
+	flg := &flaggio.Flag{ID: "1", Key: "a", Enabled: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]}
+
+	flagRepo.EXPECT().
+		FindByKey(gomock.AssignableToTypeOf(ctxInterface), nil, "a").
+		Times(1).Return(flg, nil)
+	segmentRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil).
+		Times(1).Return(nil, nil)
+	targetRepo.EXPECT().
+		FindAllByUserID(gomock.AssignableToTypeOf(ctxInterface), "user1").
+		Times(1).Return([]*flaggio.FlagTarget{
+		{FlagID: "1", VariantID: "2", Users: []string{"user1"}},
+		{FlagID: "3", VariantID: "4", Users: []string{"user1"}},
+	}, nil)
+
+	result, err := flagService.Evaluate(ctx, "a", &service.EvaluationRequest{
+		UserID:      "user1",
+		UserContext: flaggio.UserContext{"name": "John", "$userId": "user1"},
+		Debug:       boolPtr(true),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &service.EvaluationResponse{
+		Evaluation: &flaggio.Evaluation{FlagKey: "a", Value: 20, VariantID: "2", Reason: flaggio.ReasonTargetingMatch, StackTrace: []*flaggio.StackTrace{
+			{Type: "FlagTarget", ID: stringPtr("2"), Answer: 20},
+			{Type: "*Flag", ID: stringPtr("1"), Answer: 10},
+		}},
+		UserContext: &flaggio.UserContext{"name": "John", "$userId": "user1"},
+	}, result)
+}
+
+func TestFlagService_EvaluateInEnvironment(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.Background()
+	flagRepo := repository_mock.NewMockFlag(mockCtrl)
+	segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+	targetRepo := repository_mock.NewMockTarget(mockCtrl)
+	environmentRepo := repository_mock.NewMockEnvironment(mockCtrl)
+	projectRepo := repository_mock.NewMockProject(mockCtrl)
+	flagService := service.NewFlagService(flagRepo, segmentRepo, targetRepo, environmentRepo, projectRepo)
+	variants := []*flaggio.Variant{
+		{ID: "1", Value: 10},
+		{ID: "2", Value: 20},
+		{ID: "3", Value: 30},
+	}
+	flags := []*flaggio.Flag{
+		{ID: "1", Key: "a", Enabled: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1],
+			Environments: []*flaggio.FlagEnvironment{{EnvironmentID: "env1", Enabled: true, DefaultVariantWhenOn: variants[2]}},
+		},
+		{ID: "2", Key: "b", Enabled: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]},
+	}
+
+	flagRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, nil).
+		Times(1).Return(&flaggio.FlagResults{Flags: flags, Total: len(flags)}, nil)
+	segmentRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil).
+		Times(1).Return(nil, nil)
+	targetRepo.EXPECT().
+		FindAllByUserID(gomock.AssignableToTypeOf(ctxInterface), "user1").
+		Times(1).Return(nil, nil)
+	environmentRepo.EXPECT().
+		FindByKey(gomock.AssignableToTypeOf(ctxInterface), "production").
+		Times(1).Return(&flaggio.Environment{ID: "env1", Key: "production"}, nil)
+
+	result, err := flagService.EvaluateAll(ctx, &service.EvaluationRequest{
+		UserID:      "user1",
+		UserContext: flaggio.UserContext{"name": "John"},
+		Environment: "production",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &service.EvaluationsResponse{
+		Evaluations: flaggio.EvaluationList{
+			{FlagKey: "a", Value: 30, VariantID: "3", Reason: flaggio.ReasonDefault},
+			{FlagKey: "b", Value: 20, VariantID: "2", Reason: flaggio.ReasonDisabled},
+		},
+	}, result)
+}
+
+func TestFlagService_EvaluateInProject(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.Background()
+	flagRepo := repository_mock.NewMockFlag(mockCtrl)
+	segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+	targetRepo := repository_mock.NewMockTarget(mockCtrl)
+	environmentRepo := repository_mock.NewMockEnvironment(mockCtrl)
+	projectRepo := repository_mock.NewMockProject(mockCtrl)
+	flagService := service.NewFlagService(flagRepo, segmentRepo, targetRepo, environmentRepo, projectRepo)
+	variants := []*flaggio.Variant{
+		{ID: "1", Value: 10},
+		{ID: "2", Value: 20},
+	}
+	flg := &flaggio.Flag{ID: "1", ProjectID: stringPtr("prj1"), Key: "a", Enabled: true,
+		Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]}
+
+	projectRepo.EXPECT().
+		FindByKey(gomock.AssignableToTypeOf(ctxInterface), "checkout").
+		Times(1).Return(&flaggio.Project{ID: "prj1", Key: "checkout"}, nil)
+	flagRepo.EXPECT().
+		FindByKey(gomock.AssignableToTypeOf(ctxInterface), stringPtr("prj1"), "a").
+		Times(1).Return(flg, nil)
+	segmentRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface), stringPtr("prj1"), nil, nil).
+		Times(1).Return(nil, nil)
+	targetRepo.EXPECT().
+		FindAllByUserID(gomock.AssignableToTypeOf(ctxInterface), "user1").
+		Times(1).Return(nil, nil)
+
+	result, err := flagService.Evaluate(ctx, "a", &service.EvaluationRequest{
+		UserID:      "user1",
+		UserContext: flaggio.UserContext{"name": "John"},
+		Project:     "checkout",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &service.EvaluationResponse{
+		Evaluation: &flaggio.Evaluation{FlagKey: "a", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault},
+	}, result)
+}
+
 func TestFlagService_EvaluateAll(t *testing.T) {
 	t.Parallel()
 	variants := []*flaggio.Variant{
@@ -112,8 +346,8 @@ func TestFlagService_EvaluateAll(t *testing.T) {
 			},
 			expectedEvaluation: &service.EvaluationsResponse{
 				Evaluations: flaggio.EvaluationList{
-					{FlagKey: "a", Value: 20},
-					{FlagKey: "b", Value: 10},
+					{FlagKey: "a", Value: 20, VariantID: "2", Reason: flaggio.ReasonDisabled},
+					{FlagKey: "b", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault},
 				},
 			},
 		},
@@ -126,8 +360,8 @@ func TestFlagService_EvaluateAll(t *testing.T) {
 			},
 			expectedEvaluation: &service.EvaluationsResponse{
 				Evaluations: flaggio.EvaluationList{
-					{FlagKey: "a", Value: 20},
-					{FlagKey: "b", Value: 10},
+					{FlagKey: "a", Value: 20, VariantID: "2", Reason: flaggio.ReasonDisabled},
+					{FlagKey: "b", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault},
 				},
 				UserContext: &flaggio.UserContext{"name": "John"},
 			},
@@ -143,16 +377,22 @@ func TestFlagService_EvaluateAll(t *testing.T) {
 			ctx := context.Background()
 			flagRepo := repository_mock.NewMockFlag(mockCtrl)
 			segmentRepo := repository_mock.NewMockSegment(mockCtrl)
-			flagService := service.NewFlagService(flagRepo, segmentRepo)
+			targetRepo := repository_mock.NewMockTarget(mockCtrl)
+			environmentRepo := repository_mock.NewMockEnvironment(mockCtrl)
+			projectRepo := repository_mock.NewMockProject(mockCtrl)
+			flagService := service.NewFlagService(flagRepo, segmentRepo, targetRepo, environmentRepo, projectRepo)
 			flagResults := &flaggio.FlagResults{Flags: flags, Total: len(flags)}
 			segmentesults := make([]*flaggio.Segment, 0)
 
 			flagRepo.EXPECT().
-				FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil).
+				FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, nil).
 				Times(1).Return(flagResults, nil)
 			segmentRepo.EXPECT().
-				FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil).
+				FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil).
 				Times(1).Return(segmentesults, nil)
+			targetRepo.EXPECT().
+				FindAllByUserID(gomock.AssignableToTypeOf(ctxInterface), tt.evaluationRequest.UserID).
+				Times(1).Return(nil, nil)
 
 			result, err := flagService.EvaluateAll(ctx, tt.evaluationRequest)
 			assert.NoError(t, err)
@@ -161,6 +401,239 @@ func TestFlagService_EvaluateAll(t *testing.T) {
 	}
 }
 
+func TestFlagService_EvaluateAllWithKeys(t *testing.T) {
+	t.Parallel()
+	variants := []*flaggio.Variant{
+		{ID: "1", Value: 10},
+		{ID: "2", Value: 20},
+	}
+	flgA := &flaggio.Flag{ID: "1", Key: "a", Enabled: false, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]}
+	flgB := &flaggio.Flag{ID: "2", Key: "b", Enabled: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]}
+	flgC := &flaggio.Flag{ID: "3", Key: "c", Enabled: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1],
+		Prerequisites: []*flaggio.FlagPrerequisite{{FlagID: "1", VariantID: "1"}},
+	}
+	tests := []struct {
+		name               string
+		keys               []string
+		expectedEvaluation *service.EvaluationsResponse
+		run                func(flagRepo *repository_mock.MockFlag)
+	}{
+		{
+			name: "fetches the flags by key",
+			keys: []string{"b", "a", "unknown"},
+			expectedEvaluation: &service.EvaluationsResponse{
+				Evaluations: flaggio.EvaluationList{
+					{FlagKey: "b", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault},
+					{FlagKey: "a", Value: 20, VariantID: "2", Reason: flaggio.ReasonDisabled},
+				},
+			},
+			run: func(flagRepo *repository_mock.MockFlag) {
+				flagRepo.EXPECT().
+					FindByKeys(gomock.AssignableToTypeOf(ctxInterface), nil, []string{"b", "a", "unknown"}).
+					Times(1).Return([]*flaggio.Flag{flgB, flgA}, nil)
+			},
+		},
+		{
+			name: "fetches all flags when there are prerequisites",
+			keys: []string{"c", "c"},
+			expectedEvaluation: &service.EvaluationsResponse{
+				Evaluations: flaggio.EvaluationList{
+					{FlagKey: "c", Value: 20, VariantID: "2", Reason: flaggio.ReasonTargetingMatch},
+				},
+			},
+			run: func(flagRepo *repository_mock.MockFlag) {
+				flagRepo.EXPECT().
+					FindByKeys(gomock.AssignableToTypeOf(ctxInterface), nil, []string{"c", "c"}).
+					Times(1).Return([]*flaggio.Flag{flgC}, nil)
+				flagRepo.EXPECT().
+					FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, nil).
+					Times(1).Return(&flaggio.FlagResults{Flags: []*flaggio.Flag{flgA, flgB, flgC}, Total: 3}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ctx := context.Background()
+			flagRepo := repository_mock.NewMockFlag(mockCtrl)
+			segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+			targetRepo := repository_mock.NewMockTarget(mockCtrl)
+			environmentRepo := repository_mock.NewMockEnvironment(mockCtrl)
+			projectRepo := repository_mock.NewMockProject(mockCtrl)
+			flagService := service.NewFlagService(flagRepo, segmentRepo, targetRepo, environmentRepo, projectRepo)
+
+			tt.run(flagRepo)
+			segmentRepo.EXPECT().
+				FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil).
+				Times(1).Return(nil, nil)
+			targetRepo.EXPECT().
+				FindAllByUserID(gomock.AssignableToTypeOf(ctxInterface), "user1").
+				Times(1).Return(nil, nil)
+
+			result, err := flagService.EvaluateAll(ctx, &service.EvaluationRequest{
+				UserID:      "user1",
+				UserContext: flaggio.UserContext{"name": "John"},
+				Keys:        tt.keys,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedEvaluation, result)
+		})
+	}
+}
+
+func TestFlagService_Ruleset(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.Background()
+	flagRepo := repository_mock.NewMockFlag(mockCtrl)
+	segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+	targetRepo := repository_mock.NewMockTarget(mockCtrl)
+	environmentRepo := repository_mock.NewMockEnvironment(mockCtrl)
+	projectRepo := repository_mock.NewMockProject(mockCtrl)
+	flagService := service.NewFlagService(flagRepo, segmentRepo, targetRepo, environmentRepo, projectRepo)
+	variants := []*flaggio.Variant{
+		{ID: "1", Value: 10},
+		{ID: "2", Value: 20},
+		{ID: "3", Value: 30},
+	}
+	flags := []*flaggio.Flag{
+		{ID: "1", Key: "a", Enabled: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1],
+			Environments: []*flaggio.FlagEnvironment{{EnvironmentID: "env1", Enabled: true, DefaultVariantWhenOn: variants[2]}},
+		},
+	}
+	targets := []*flaggio.FlagTarget{{FlagID: "1", VariantID: "2", Users: []string{"user1"}}}
+	segments := []*flaggio.Segment{{ID: "s1", ProjectID: stringPtr("prj1")}}
+
+	projectRepo.EXPECT().
+		FindByKey(gomock.AssignableToTypeOf(ctxInterface), "website").
+		Times(1).Return(&flaggio.Project{ID: "prj1", Key: "website"}, nil)
+	environmentRepo.EXPECT().
+		FindByKey(gomock.AssignableToTypeOf(ctxInterface), "production").
+		Times(1).Return(&flaggio.Environment{ID: "env1", Key: "production"}, nil)
+	flagRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface), stringPtr("prj1"), nil, nil, nil).
+		Times(1).Return(&flaggio.FlagResults{Flags: flags, Total: len(flags)}, nil)
+	targetRepo.EXPECT().
+		FindAllByFlagIDs(gomock.AssignableToTypeOf(ctxInterface), []string{"1"}).
+		Times(1).Return(targets, nil)
+	segmentRepo.EXPECT().
+		FindAll(gomock.AssignableToTypeOf(ctxInterface), stringPtr("prj1"), nil, nil).
+		Times(1).Return(segments, nil)
+
+	result, err := flagService.Ruleset(ctx, &service.RulesetRequest{Project: "website", Environment: "production"})
+	assert.NoError(t, err)
+	assert.Equal(t, flaggio.RulesetSchemaVersion, result.SchemaVersion)
+	assert.NotEmpty(t, result.Version)
+	assert.Equal(t, segments, result.Segments)
+	assert.Len(t, result.Flags, 1)
+	// flags have the environment settings and their targets
+	assert.Equal(t, variants[2], result.Flags[0].DefaultVariantWhenOn)
+	assert.Nil(t, result.Flags[0].Environments)
+	assert.Equal(t, targets, result.Flags[0].Targets)
+}
+
+func TestFlagService_EvaluateBatch(t *testing.T) {
+	t.Parallel()
+	variants := []*flaggio.Variant{
+		{ID: "1", Value: 10},
+		{ID: "2", Value: 20},
+	}
+	flags := []*flaggio.Flag{
+		{ID: "1", Key: "a", Enabled: true, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]},
+		{ID: "2", Key: "b", Enabled: false, Variants: variants, DefaultVariantWhenOn: variants[0], DefaultVariantWhenOff: variants[1]},
+	}
+	targets := []*flaggio.FlagTarget{{FlagID: "1", VariantID: "2", Users: []string{"user1"}}}
+	requests := make([]*service.EvaluationRequest, 100)
+	for idx := range requests {
+		requests[idx] = &service.EvaluationRequest{
+			UserID:      fmt.Sprintf("user%d", idx),
+			UserContext: flaggio.UserContext{"$userId": fmt.Sprintf("user%d", idx)},
+		}
+	}
+	requests[2].Debug = boolPtr(true)
+
+	newFlagService := func(mockCtrl *gomock.Controller) service.Flag {
+		flagRepo := repository_mock.NewMockFlag(mockCtrl)
+		segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+		targetRepo := repository_mock.NewMockTarget(mockCtrl)
+		environmentRepo := repository_mock.NewMockEnvironment(mockCtrl)
+		projectRepo := repository_mock.NewMockProject(mockCtrl)
+		// flags, targets and segments are loaded only once
+		flagRepo.EXPECT().
+			FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil, nil).
+			Times(1).Return(&flaggio.FlagResults{Flags: flags, Total: len(flags)}, nil)
+		targetRepo.EXPECT().
+			FindAllByFlagIDs(gomock.AssignableToTypeOf(ctxInterface), []string{"1", "2"}).
+			Times(1).Return(targets, nil)
+		segmentRepo.EXPECT().
+			FindAll(gomock.AssignableToTypeOf(ctxInterface), nil, nil, nil).
+			Times(1).Return(nil, nil)
+		return service.NewFlagService(flagRepo, segmentRepo, targetRepo, environmentRepo, projectRepo)
+	}
+
+	t.Run("evaluates all flags for every user", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		flagService := newFlagService(mockCtrl)
+
+		results := make([]*service.BatchEvaluationResult, len(requests))
+		err := flagService.EvaluateBatch(context.Background(), &service.BatchEvaluationRequest{Requests: requests},
+			func(res *service.BatchEvaluationResult) error {
+				assert.Nil(t, results[res.Index])
+				results[res.Index] = res
+				return nil
+			})
+		assert.NoError(t, err)
+		for idx, res := range results {
+			assert.Equal(t, requests[idx].UserID, res.UserID)
+			assert.Len(t, res.Evaluations, 2)
+			assert.Equal(t, &flaggio.Evaluation{FlagKey: "b", Value: 20, VariantID: "2", Reason: flaggio.ReasonDisabled}, res.Evaluations[1])
+		}
+		assert.Equal(t, &flaggio.Evaluation{FlagKey: "a", Value: 20, VariantID: "2", Reason: flaggio.ReasonTargetingMatch}, results[1].Evaluations[0])
+		assert.Equal(t, &flaggio.Evaluation{FlagKey: "a", Value: 10, VariantID: "1", Reason: flaggio.ReasonDefault}, results[0].Evaluations[0])
+		assert.Nil(t, results[0].UserContext)
+		assert.Equal(t, &requests[2].UserContext, results[2].UserContext)
+	})
+
+	t.Run("evaluates the given flags only", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		flagService := newFlagService(mockCtrl)
+
+		err := flagService.EvaluateBatch(context.Background(), &service.BatchEvaluationRequest{
+			Requests: requests[:1],
+			FlagKeys: []string{"b", "c"},
+		}, func(res *service.BatchEvaluationResult) error {
+			assert.Equal(t, flaggio.EvaluationList{
+				{FlagKey: "b", Value: 20, VariantID: "2", Reason: flaggio.ReasonDisabled},
+				{FlagKey: "c", Reason: flaggio.ReasonError, Error: "flag: not found"},
+			}, res.Evaluations)
+			return nil
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("stops when the results can't be handled", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		flagService := newFlagService(mockCtrl)
+
+		calls := 0
+		err := flagService.EvaluateBatch(context.Background(), &service.BatchEvaluationRequest{Requests: requests},
+			func(res *service.BatchEvaluationResult) error {
+				calls++
+				return errors.New("client is gone")
+			})
+		assert.EqualError(t, err, "client is gone")
+		assert.Equal(t, 1, calls)
+	})
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
@@ -6,35 +6,37 @@ package service_mock
 
 import (
 	context "context"
+	reflect "reflect"
+
 	gomock "github.com/golang/mock/gomock"
+	flaggio "github.com/victorkt/flaggio/internal/flaggio"
 	service "github.com/victorkt/flaggio/internal/service"
-	reflect "reflect"
 )
 
-// MockFlag is a mock of Flag interface
+// MockFlag is a mock of Flag interface.
 type MockFlag struct {
 	ctrl     *gomock.Controller
 	recorder *MockFlagMockRecorder
 }
 
-// MockFlagMockRecorder is the mock recorder for MockFlag
+// MockFlagMockRecorder is the mock recorder for MockFlag.
 type MockFlagMockRecorder struct {
 	mock *MockFlag
 }
 
-// NewMockFlag creates a new mock instance
+// NewMockFlag creates a new mock instance.
 func NewMockFlag(ctrl *gomock.Controller) *MockFlag {
 	mock := &MockFlag{ctrl: ctrl}
 	mock.recorder = &MockFlagMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockFlag) EXPECT() *MockFlagMockRecorder {
 	return m.recorder
 }
 
-// Evaluate mocks base method
+// Evaluate mocks base method.
 func (m *MockFlag) Evaluate(arg0 context.Context, arg1 string, arg2 *service.EvaluationRequest) (*service.EvaluationResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Evaluate", arg0, arg1, arg2)
@@ -43,13 +45,13 @@ func (m *MockFlag) Evaluate(arg0 context.Context, arg1 string, arg2 *service.Eva
 	return ret0, ret1
 }
 
-// Evaluate indicates an expected call of Evaluate
+// Evaluate indicates an expected call of Evaluate.
 func (mr *MockFlagMockRecorder) Evaluate(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockFlag)(nil).Evaluate), arg0, arg1, arg2)
 }
 
-// EvaluateAll mocks base method
+// EvaluateAll mocks base method.
 func (m *MockFlag) EvaluateAll(arg0 context.Context, arg1 *service.EvaluationRequest) (*service.EvaluationsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "EvaluateAll", arg0, arg1)
@@ -58,8 +60,37 @@ func (m *MockFlag) EvaluateAll(arg0 context.Context, arg1 *service.EvaluationReq
 	return ret0, ret1
 }
 
-// EvaluateAll indicates an expected call of EvaluateAll
+// EvaluateAll indicates an expected call of EvaluateAll.
 func (mr *MockFlagMockRecorder) EvaluateAll(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateAll", reflect.TypeOf((*MockFlag)(nil).EvaluateAll), arg0, arg1)
 }
+
+// EvaluateBatch mocks base method.
+func (m *MockFlag) EvaluateBatch(arg0 context.Context, arg1 *service.BatchEvaluationRequest, arg2 func(*service.BatchEvaluationResult) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateBatch", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EvaluateBatch indicates an expected call of EvaluateBatch.
+func (mr *MockFlagMockRecorder) EvaluateBatch(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateBatch", reflect.TypeOf((*MockFlag)(nil).EvaluateBatch), arg0, arg1, arg2)
+}
+
+// Ruleset mocks base method.
+func (m *MockFlag) Ruleset(arg0 context.Context, arg1 *service.RulesetRequest) (*flaggio.Ruleset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ruleset", arg0, arg1)
+	ret0, _ := ret[0].(*flaggio.Ruleset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ruleset indicates an expected call of Ruleset.
+func (mr *MockFlagMockRecorder) Ruleset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ruleset", reflect.TypeOf((*MockFlag)(nil).Ruleset), arg0, arg1)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/victorkt/flaggio/internal/service (interfaces: Report)
+
+// Package service_mock is a generated GoMock package.
+package service_mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	flaggio "github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// MockReport is a mock of Report interface.
+type MockReport struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportMockRecorder
+}
+
+// MockReportMockRecorder is the mock recorder for MockReport.
+type MockReportMockRecorder struct {
+	mock *MockReport
+}
+
+// NewMockReport creates a new mock instance.
+func NewMockReport(ctrl *gomock.Controller) *MockReport {
+	mock := &MockReport{ctrl: ctrl}
+	mock.recorder = &MockReportMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReport) EXPECT() *MockReportMockRecorder {
+	return m.recorder
+}
+
+// Stale mocks base method.
+func (m *MockReport) Stale(arg0 context.Context, arg1 *string, arg2 time.Time) (*flaggio.StaleReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stale", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*flaggio.StaleReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stale indicates an expected call of Stale.
+func (mr *MockReportMockRecorder) Stale(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stale", reflect.TypeOf((*MockReport)(nil).Stale), arg0, arg1, arg2)
+}
@@ -1,21 +1,46 @@
 package service
 
 import (
+	"bytes"
 	"crypto/sha1" // nolint // only used for hashing requests
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"sort"
+	"time"
 
 	"github.com/victorkt/clientip"
 	"github.com/victorkt/flaggio/internal/flaggio"
 	"github.com/vmihailenco/msgpack/v4"
 )
 
-// EvaluationRequest is the evaluation request object
+// EvaluationRequest is the evaluation request object.
+// Keys are the keys of the flags to evaluate, when evaluating more than
+// one flag. When empty, all flags are evaluated.
+// Project is the key of the project that owns the flags being evaluated.
+// When empty, only flags that don't belong to a project are evaluated.
+// Environment is the key of the environment in which the flags are
+// evaluated. When empty, the flag settings are used as they are.
 type EvaluationRequest struct {
 	UserID      string              `json:"userId"`
 	UserContext flaggio.UserContext `json:"context"`
+	Keys        []string            `json:"keys,omitempty"`
 	Debug       *bool               `json:"debug,omitempty"`
+	Project     string              `json:"-"`
+	Environment string              `json:"-"`
+}
+
+// UnmarshalJSON unmarshals the bytes into EvaluationRequest. The user
+// context is never nil, even if it's missing from the request.
+func (er *EvaluationRequest) UnmarshalJSON(b []byte) error {
+	type evaluationRequest EvaluationRequest
+	req := evaluationRequest{UserContext: make(flaggio.UserContext)}
+	if err := json.Unmarshal(b, &req); err != nil {
+		return err
+	}
+	*er = EvaluationRequest(req)
+	return nil
 }
 
 // Bind adds additional data to the EvaluationRequest.
@@ -51,22 +76,69 @@ func (er EvaluationRequest) Hash() (string, error) {
 		ordered[idx] = []interface{}{key, er.UserContext[key]}
 	}
 
-	// marshall ordered slice and hash it
-	bytes, err := msgpack.Marshal(ordered)
-	if err != nil {
+	// marshall ordered slice and hash it. keys of nested objects
+	// are sorted by the encoder
+	var buf bytes.Buffer
+	if err := msgpack.NewEncoder(&buf).SortMapKeys(true).Encode(ordered); err != nil {
 		return "", err
 	}
 	h := sha1.New() // nolint // we don't care about security for this
-	if _, err := h.Write(bytes); err != nil {
+	if _, err := h.Write(buf.Bytes()); err != nil {
 		return "", err
 	}
 	return hex.EncodeToString(h.Sum(nil)), nil
 }
 
+// BatchEvaluationRequest is the batch evaluation request object. Flags are
+// evaluated for each one of the requests, which hold the user details.
+// FlagKeys are the keys of the flags to evaluate. When empty, all flags are
+// evaluated. Project and Environment apply to all requests, as in
+// EvaluationRequest.
+type BatchEvaluationRequest struct {
+	Requests    []*EvaluationRequest `json:"requests"`
+	FlagKeys    []string             `json:"flagKeys,omitempty"`
+	Project     string               `json:"-"`
+	Environment string               `json:"-"`
+}
+
+// Bind adds additional data to each one of the requests of the
+// BatchEvaluationRequest, as in EvaluationRequest.
+func (br BatchEvaluationRequest) Bind(r *http.Request) error {
+	for _, er := range br.Requests {
+		if er == nil {
+			return errors.New("requests can't be null")
+		}
+		if err := er.Bind(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BatchEvaluationResult holds the evaluations for one of the requests of a
+// batch. Index is the position of the request in the batch.
+type BatchEvaluationResult struct {
+	Index       int                    `json:"index"`
+	UserID      string                 `json:"userId"`
+	Evaluations flaggio.EvaluationList `json:"evaluations"`
+	UserContext *flaggio.UserContext   `json:"context,omitempty"`
+}
+
+// RulesetRequest is the ruleset request object. Project and Environment
+// are the keys of the project and environment of the flags in the ruleset,
+// as in EvaluationRequest.
+type RulesetRequest struct {
+	Project     string
+	Environment string
+}
+
 // EvaluationResponse is the evaluation response object
 type EvaluationResponse struct {
 	Evaluation  *flaggio.Evaluation  `json:"evaluation"`
 	UserContext *flaggio.UserContext `json:"context,omitempty"`
+	// ExpiresAt is when the evaluation can change only because time passed,
+	// if ever, so that it's not cached for longer.
+	ExpiresAt *time.Time `json:"-" msgpack:"-"`
 }
 
 // Render can enrich the EvaluationResponse object before being returned to the
@@ -80,6 +152,9 @@ func (e *EvaluationResponse) Render(w http.ResponseWriter, r *http.Request) erro
 type EvaluationsResponse struct {
 	Evaluations flaggio.EvaluationList `json:"evaluations"`
 	UserContext *flaggio.UserContext   `json:"context,omitempty"`
+	// ExpiresAt is when any of the evaluations can change only because time
+	// passed, if ever, so that they're not cached for longer.
+	ExpiresAt *time.Time `json:"-" msgpack:"-"`
 }
 
 // Render can enrich the EvaluationsResponse object before being returned to the
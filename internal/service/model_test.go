@@ -1,6 +1,7 @@
 package service_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -56,6 +57,17 @@ func TestEvaluationRequest_Hash(t *testing.T) {
 			},
 			expectedHash: "78c77cefc3d6a062e7c29140c4aef97be2d8e0c4",
 		},
+		{
+			name: "returns same hash for nested objects",
+			req: service.EvaluationRequest{
+				UserID: "123",
+				UserContext: flaggio.UserContext{
+					"user":  map[string]interface{}{"plan": "pro", "os": "ios", "seats": int64(3)},
+					"roles": []interface{}{"a", "b"},
+				},
+			},
+			expectedHash: "4474fe8536aa1d1fd25c813b56e5919849bdc92d",
+		},
 	}
 
 	for _, tt := range tests {
@@ -67,3 +79,14 @@ func TestEvaluationRequest_Hash(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchEvaluationRequest_UnmarshalJSON(t *testing.T) {
+	var br service.BatchEvaluationRequest
+	err := json.Unmarshal([]byte(`{"requests":[{"userId":"1","context":{"age":30}},{"userId":"2"}],"flagKeys":["a"]}`), &br)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, br.FlagKeys)
+	assert.Len(t, br.Requests, 2)
+	assert.Equal(t, flaggio.UserContext{"age": int64(30)}, br.Requests[0].UserContext)
+	// the user context is never nil, so that it can be enriched
+	assert.Equal(t, flaggio.UserContext{}, br.Requests[1].UserContext)
+}
@@ -1,9 +1,63 @@
 package redis
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
 	"github.com/victorkt/flaggio/internal/service"
 )
 
 func shouldCacheEvaluation(req *service.EvaluationRequest) bool {
 	return !req.IsDebug()
 }
+
+// evalCacheKey returns the cache key for an evaluation request. Evaluations
+// in a project or environment are cached separately from evaluations
+// without one.
+func evalCacheKey(req *service.EvaluationRequest, parts ...string) string {
+	return scopedEvalCacheKey(req.Project, req.Environment, parts...)
+}
+
+// evalAllCacheKey returns the cache key for a request to evaluate many flags.
+// Requests for a subset of flags are cached separately, by the set of keys
+// requested, regardless of their order.
+func evalAllCacheKey(req *service.EvaluationRequest, reqHash string) string {
+	if len(req.Keys) == 0 {
+		return evalCacheKey(req, reqHash)
+	}
+	return evalCacheKey(req, "keys", keysHash(req.Keys), reqHash)
+}
+
+// keysHash returns a hash of a set of flag keys.
+func keysHash(keys []string) string {
+	sorted := make([]string, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if !seen[key] {
+			seen[key] = true
+			sorted = append(sorted, key)
+		}
+	}
+	sort.Strings(sorted)
+	h := sha1.Sum([]byte(strings.Join(sorted, "\n"))) // nolint // we don't care about security for this
+	return hex.EncodeToString(h[:])
+}
+
+// rulesetCacheKey returns the cache key for a ruleset request. Rulesets
+// are cached as evaluations, so they are invalidated by the same changes.
+func rulesetCacheKey(req *service.RulesetRequest) string {
+	return scopedEvalCacheKey(req.Project, req.Environment, "ruleset")
+}
+
+func scopedEvalCacheKey(project, environment string, parts ...string) string {
+	if environment != "" {
+		parts = append([]string{"env", environment}, parts...)
+	}
+	if project != "" {
+		parts = append([]string{"project", project}, parts...)
+	}
+	return flaggio.EvalCacheKey(parts...)
+}
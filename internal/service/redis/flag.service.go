@@ -34,7 +34,7 @@ func (s flagService) Evaluate(ctx context.Context, flagKey string, req *service.
 		if err != nil {
 			return nil, err
 		}
-		cacheKey = flaggio.EvalCacheKey(flagKey, reqHash)
+		cacheKey = evalCacheKey(req, flagKey, reqHash)
 
 		// fetch flag results from cache
 		cached, err := s.redis.WithContext(ctx).Get(cacheKey).Result()
@@ -58,13 +58,13 @@ func (s flagService) Evaluate(ctx context.Context, flagKey string, req *service.
 		return nil, err
 	}
 
-	if shouldCache {
+	if ttl := s.cacheTTL(res.ExpiresAt); shouldCache && ttl > 0 {
 		// marshall and save result
 		b, err := msgpack.Marshal(res)
 		if err != nil {
 			return nil, err
 		}
-		if err := s.redis.Set(cacheKey, b, s.ttl).Err(); err != nil {
+		if err := s.redis.Set(cacheKey, b, ttl).Err(); err != nil {
 			return nil, err
 		}
 	}
@@ -85,7 +85,7 @@ func (s flagService) EvaluateAll(ctx context.Context, req *service.EvaluationReq
 		if err != nil {
 			return nil, err
 		}
-		cacheKey = flaggio.EvalCacheKey(reqHash)
+		cacheKey = evalAllCacheKey(req, reqHash)
 
 		// fetch flag results from cache
 		cached, err := s.redis.WithContext(ctx).Get(cacheKey).Result()
@@ -109,13 +109,13 @@ func (s flagService) EvaluateAll(ctx context.Context, req *service.EvaluationReq
 		return nil, err
 	}
 
-	if shouldCache {
+	if ttl := s.cacheTTL(res.ExpiresAt); shouldCache && ttl > 0 {
 		// marshall and save result
 		b, err := msgpack.Marshal(res)
 		if err != nil {
 			return nil, err
 		}
-		if err := s.redis.Set(cacheKey, b, s.ttl).Err(); err != nil {
+		if err := s.redis.Set(cacheKey, b, ttl).Err(); err != nil {
 			return nil, err
 		}
 	}
@@ -123,6 +123,65 @@ func (s flagService) EvaluateAll(ctx context.Context, req *service.EvaluationReq
 	return res, nil
 }
 
+// EvaluateBatch evaluates flags for many users. Results are not cached, as
+// each batch is expected to be different, but the underlying service still
+// uses cached flags and segments.
+func (s flagService) EvaluateBatch(ctx context.Context, req *service.BatchEvaluationRequest, fn func(*service.BatchEvaluationResult) error) error {
+	return s.svc.EvaluateBatch(ctx, req, fn)
+}
+
+// Ruleset returns all flags and segments, so that flags can be evaluated locally.
+func (s flagService) Ruleset(ctx context.Context, req *service.RulesetRequest) (*flaggio.Ruleset, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RedisFlagService.Ruleset")
+	defer span.Finish()
+
+	cacheKey := rulesetCacheKey(req)
+
+	// fetch ruleset from cache
+	cached, err := s.redis.WithContext(ctx).Get(cacheKey).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		// an unexpected error occurred, return it
+		return nil, err
+	}
+	if cached != "" {
+		// cache hit, unmarshall and return result
+		var rs flaggio.Ruleset
+		if err := msgpack.Unmarshal([]byte(cached), &rs); err == nil {
+			// return if no errors, otherwise defer to the store
+			return &rs, nil
+		}
+	}
+
+	// cache miss, call underlying service
+	res, err := s.svc.Ruleset(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// marshall and save result
+	b, err := msgpack.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.redis.Set(cacheKey, b, s.ttl).Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// cacheTTL returns how long an evaluation can be cached, which is never past
+// the time it can change only because time passed.
+func (s flagService) cacheTTL(expiresAt *time.Time) time.Duration {
+	if expiresAt == nil {
+		return s.ttl
+	}
+	if ttl := time.Until(*expiresAt); ttl < s.ttl {
+		return ttl
+	}
+	return s.ttl
+}
+
 func NewFlagService(redisClient *redis.Client, svc service.Flag) service.Flag {
 	return &flagService{
 		redis: redisClient,
@@ -71,6 +71,54 @@ func TestFlagService_Evaluate(t *testing.T) {
 				assert.Equal(t, evalRes, res)
 			},
 		},
+		{
+			name: "caches evaluations only until they can change with the current time",
+			run: func(t *testing.T, flagSvc *service_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				expiresAt := time.Now().Add(time.Hour)
+				evalRes := &service.EvaluationResponse{Evaluation: evalsList[0], ExpiresAt: &expiresAt}
+				flagRedisSvc := redis_svc.NewFlagService(redisClient, flagSvc)
+				evalReq := &service.EvaluationRequest{
+					UserID:      "456",
+					UserContext: flaggio.UserContext{"test": "def"},
+				}
+				flagSvc.EXPECT().Evaluate(gomock.AssignableToTypeOf(ctxInterface), "f1", evalReq).
+					Times(1).Return(evalRes, nil)
+
+				res, err := flagRedisSvc.Evaluate(ctx, "f1", evalReq)
+				assert.NoError(t, err)
+				assert.Equal(t, evalRes, res)
+
+				reqHash, err := evalReq.Hash()
+				assert.NoError(t, err)
+				ttl, err := redisClient.TTL(flaggio.EvalCacheKey("f1", reqHash)).Result()
+				assert.NoError(t, err)
+				assert.True(t, ttl > 0 && ttl <= time.Hour, "unexpected ttl: %s", ttl)
+			},
+		},
+		{
+			name: "doesnt cache evaluations that already changed with the current time",
+			run: func(t *testing.T, flagSvc *service_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				expiresAt := time.Now().Add(-time.Second)
+				evalRes := &service.EvaluationResponse{Evaluation: evalsList[0], ExpiresAt: &expiresAt}
+				flagRedisSvc := redis_svc.NewFlagService(redisClient, flagSvc)
+				evalReq := &service.EvaluationRequest{
+					UserID:      "789",
+					UserContext: flaggio.UserContext{"test": "ghi"},
+				}
+				flagSvc.EXPECT().Evaluate(gomock.AssignableToTypeOf(ctxInterface), "f1", evalReq).
+					Times(2).Return(evalRes, nil)
+
+				for i := 0; i < 2; i++ {
+					res, err := flagRedisSvc.Evaluate(ctx, "f1", evalReq)
+					assert.NoError(t, err)
+					assert.Equal(t, evalRes, res)
+				}
+			},
+		},
 		{
 			name: "always calls underlying service on debug requests",
 			run: func(t *testing.T, flagSvc *service_mock.MockFlag) {
@@ -156,6 +204,32 @@ func TestFlagService_EvaluateAll(t *testing.T) {
 				assert.Equal(t, evalsRes, res)
 			},
 		},
+		{
+			name: "caches evaluations only until they can change with the current time",
+			run: func(t *testing.T, flagSvc *service_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				expiresAt := time.Now().Add(time.Hour)
+				evalsRes := &service.EvaluationsResponse{Evaluations: evalsList, ExpiresAt: &expiresAt}
+				flagRedisSvc := redis_svc.NewFlagService(redisClient, flagSvc)
+				evalReq := &service.EvaluationRequest{
+					UserID:      "456",
+					UserContext: flaggio.UserContext{"test": "def"},
+				}
+				flagSvc.EXPECT().EvaluateAll(gomock.AssignableToTypeOf(ctxInterface), evalReq).
+					Times(1).Return(evalsRes, nil)
+
+				res, err := flagRedisSvc.EvaluateAll(ctx, evalReq)
+				assert.NoError(t, err)
+				assert.Equal(t, evalsRes, res)
+
+				reqHash, err := evalReq.Hash()
+				assert.NoError(t, err)
+				ttl, err := redisClient.TTL(flaggio.EvalCacheKey(reqHash)).Result()
+				assert.NoError(t, err)
+				assert.True(t, ttl > 0 && ttl <= time.Hour, "unexpected ttl: %s", ttl)
+			},
+		},
 		{
 			name: "always calls underlying service on debug requests",
 			run: func(t *testing.T, flagSvc *service_mock.MockFlag) {
@@ -192,6 +266,67 @@ func TestFlagService_EvaluateAll(t *testing.T) {
 	}
 }
 
+func TestFlagService_Ruleset(t *testing.T) {
+	// flush cache first
+	if err := redisClient.FlushAll().Err(); err != nil {
+		t.Fatalf("failed to flush cache: %s", err)
+	}
+
+	ruleset, err := flaggio.NewRuleset([]*flaggio.Flag{{ID: "1", Key: "f1"}}, nil)
+	if err != nil {
+		t.Fatalf("failed to create ruleset: %s", err)
+	}
+	rulesetReq := &service.RulesetRequest{Project: "website"}
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T, repo *service_mock.MockFlag)
+	}{
+		// these tests are meant to be run in order
+		{
+			name: "calls underlying service on cache miss",
+			run: func(t *testing.T, flagSvc *service_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				flagRedisSvc := redis_svc.NewFlagService(redisClient, flagSvc)
+				flagSvc.EXPECT().Ruleset(gomock.AssignableToTypeOf(ctxInterface), rulesetReq).
+					Times(1).Return(ruleset, nil)
+
+				res, err := flagRedisSvc.Ruleset(ctx, rulesetReq)
+				assert.NoError(t, err)
+				assert.Equal(t, ruleset, res)
+			},
+		},
+		{
+			name: "doesnt call underlying repository on cache hit",
+			run: func(t *testing.T, flagSvc *service_mock.MockFlag) {
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				flagRedisSvc := redis_svc.NewFlagService(redisClient, flagSvc)
+				flagSvc.EXPECT().Ruleset(gomock.AssignableToTypeOf(ctxInterface), rulesetReq).
+					Times(0)
+
+				res, err := flagRedisSvc.Ruleset(ctx, rulesetReq)
+				assert.NoError(t, err)
+				assert.Equal(t, ruleset.Version, res.Version)
+				assert.Len(t, res.Flags, 1)
+				assert.Equal(t, "f1", res.Flags[0].Key)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			flagSvc := service_mock.NewMockFlag(mockCtrl)
+
+			tt.run(t, flagSvc)
+		})
+	}
+}
+
 func boolPtr(b bool) *bool {
 	return &b
 }
@@ -0,0 +1,19 @@
+package service
+
+//go:generate mockgen -destination=./mocks/report_mock.go -package=service_mock github.com/victorkt/flaggio/internal/service Report
+
+import (
+	"context"
+	"time"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// Report holds the logic for reporting on flags and segments
+type Report interface {
+	// Stale returns the flags and segments in a project that are likely not needed
+	// anymore, as they haven't changed or been evaluated since the given time.
+	// If no project ID is given, only flags and segments that don't belong to a
+	// project are considered.
+	Stale(ctx context.Context, projectID *string, since time.Time) (*flaggio.StaleReport, error)
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+)
+
+var _ Report = (*reportService)(nil)
+
+// NewReportService returns a new Report
+func NewReportService(
+	flagsRepo repository.Flag,
+	segmentsRepo repository.Segment,
+	projectsRepo repository.Project,
+	evaluationCountsRepo repository.EvaluationCount,
+) Report {
+	return &reportService{
+		flagsRepo:            flagsRepo,
+		segmentsRepo:         segmentsRepo,
+		projectsRepo:         projectsRepo,
+		evaluationCountsRepo: evaluationCountsRepo,
+	}
+}
+
+type reportService struct {
+	flagsRepo            repository.Flag
+	segmentsRepo         repository.Segment
+	projectsRepo         repository.Project
+	evaluationCountsRepo repository.EvaluationCount
+}
+
+// Stale returns the flags and segments in a project that are likely not needed
+// anymore, as they haven't changed or been evaluated since the given time.
+func (s *reportService) Stale(ctx context.Context, projectID *string, since time.Time) (*flaggio.StaleReport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ReportService.Stale")
+	defer span.Finish()
+
+	// evaluations are counted by project key
+	var prjKey *string
+	if projectID != nil {
+		prj, err := s.projectsRepo.FindByID(ctx, *projectID)
+		if err != nil {
+			return nil, err
+		}
+		prjKey = &prj.Key
+	}
+	flgs, err := s.flagsRepo.FindAll(ctx, projectID, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	sgmnts, err := s.segmentsRepo.FindAll(ctx, projectID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	lastEvaluatedAt, err := s.evaluationCountsRepo.FindAllLastEvaluatedAt(ctx, prjKey)
+	if err != nil {
+		return nil, err
+	}
+	return flaggio.NewStaleReport(flgs.Flags, sgmnts, lastEvaluatedAt, since), nil
+}
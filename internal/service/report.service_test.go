@@ -0,0 +1,49 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	repository_mock "github.com/victorkt/flaggio/internal/repository/mocks"
+	"github.com/victorkt/flaggio/internal/service"
+)
+
+func TestReportService_Stale(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.Background()
+	flagRepo := repository_mock.NewMockFlag(mockCtrl)
+	segmentRepo := repository_mock.NewMockSegment(mockCtrl)
+	projectRepo := repository_mock.NewMockProject(mockCtrl)
+	evaluationCountRepo := repository_mock.NewMockEvaluationCount(mockCtrl)
+	reportService := service.NewReportService(flagRepo, segmentRepo, projectRepo, evaluationCountRepo)
+
+	prjID, prjKey := "p1", "website"
+	since := time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := since.Add(-time.Hour)
+	flg1 := &flaggio.Flag{ID: "1", Key: "f1", Enabled: true, CreatedAt: createdAt}
+	flg2 := &flaggio.Flag{ID: "2", Key: "f2", Enabled: false, CreatedAt: createdAt}
+	sgmnt := &flaggio.Segment{ID: "s1"}
+
+	projectRepo.EXPECT().FindByID(gomock.AssignableToTypeOf(ctxInterface), prjID).
+		Times(1).Return(&flaggio.Project{ID: prjID, Key: prjKey}, nil)
+	flagRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), &prjID, nil, nil, nil).
+		Times(1).Return(&flaggio.FlagResults{Flags: []*flaggio.Flag{flg1, flg2}, Total: 2}, nil)
+	segmentRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), &prjID, nil, nil).
+		Times(1).Return([]*flaggio.Segment{sgmnt}, nil)
+	evaluationCountRepo.EXPECT().FindAllLastEvaluatedAt(gomock.AssignableToTypeOf(ctxInterface), &prjKey).
+		Times(1).Return(map[string]time.Time{"f1": since.Add(time.Hour)}, nil)
+
+	report, err := reportService.Stale(ctx, &prjID, since)
+	assert.NoError(t, err)
+	assert.Equal(t, &flaggio.StaleReport{
+		RolledOutFlags:   []*flaggio.Flag{flg1},
+		UnevaluatedFlags: []*flaggio.Flag{flg2},
+		UnusedSegments:   []*flaggio.Segment{sgmnt},
+	}, report)
+}
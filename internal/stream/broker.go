@@ -0,0 +1,126 @@
+// Package stream broadcasts changes to flags and segments to the clients
+// connected to a server.
+package stream
+
+import (
+	"context"
+	"sync"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/repository"
+)
+
+// subscriberBufferSize is the number of changes that can be queued for a
+// subscriber before it's considered too slow.
+const subscriberBufferSize = 32
+
+// Filter selects the changes a subscriber receives.
+type Filter func(evt *flaggio.ChangeEvent) bool
+
+// InProject returns a filter for the changes that can affect the flags in
+// the project with the given ID, or in no project if the ID is nil. Changes
+// in an unknown project are always selected.
+func InProject(projectID *string) Filter {
+	return func(evt *flaggio.ChangeEvent) bool {
+		if evt.ProjectID == nil {
+			return true
+		}
+		return projectID != nil && *evt.ProjectID == *projectID
+	}
+}
+
+// SkipPending discards the changes already queued for a subscriber, for
+// when handling the latest change is enough to handle all of them.
+func SkipPending(changes <-chan *flaggio.ChangeEvent) {
+	for {
+		select {
+		case _, ok := <-changes:
+			if !ok {
+				return
+			}
+		default:
+			return
+		}
+	}
+}
+
+// Broker listens to a change feed and broadcasts every change to all of
+// its subscribers.
+type Broker struct {
+	feed repository.ChangeFeed
+
+	mu     sync.Mutex
+	subs   map[chan *flaggio.ChangeEvent]Filter
+	closed bool
+}
+
+// NewBroker returns a new broker for the given change feed.
+func NewBroker(feed repository.ChangeFeed) *Broker {
+	return &Broker{
+		feed: feed,
+		subs: make(map[chan *flaggio.ChangeEvent]Filter),
+	}
+}
+
+// Run listens to the change feed until the context is cancelled or the
+// feed fails. The returned error is nil only if the context was cancelled.
+func (b *Broker) Run(ctx context.Context) error {
+	return b.feed.Listen(ctx, b.broadcast)
+}
+
+// Close closes the channels of all subscribers, so that the streams using
+// them are ended. Channels returned by Subscribe after the broker is
+// closed are already closed.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for ch := range b.subs {
+		b.remove(ch)
+	}
+}
+
+// Subscribe returns a channel that receives the changes selected by the
+// filter, or all changes if the filter is nil, and a function to unsubscribe
+// from them. The channel of a subscriber that can't keep up with the changes
+// is closed, as it would otherwise miss some of them.
+func (b *Broker) Subscribe(filter Filter) (<-chan *flaggio.ChangeEvent, func()) {
+	ch := make(chan *flaggio.ChangeEvent, subscriberBufferSize)
+	b.mu.Lock()
+	if b.closed {
+		close(ch)
+	} else {
+		b.subs[ch] = filter
+	}
+	b.mu.Unlock()
+	return ch, func() {
+		b.mu.Lock()
+		b.remove(ch)
+		b.mu.Unlock()
+	}
+}
+
+func (b *Broker) broadcast(evt *flaggio.ChangeEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch, filter := range b.subs {
+		if filter != nil && !filter(evt) {
+			continue
+		}
+		select {
+		case ch <- evt:
+		default:
+			// subscriber is too slow
+			b.remove(ch)
+		}
+	}
+}
+
+// remove closes and removes a subscriber channel. Must be called with
+// the lock held.
+func (b *Broker) remove(ch chan *flaggio.ChangeEvent) {
+	if _, ok := b.subs[ch]; ok {
+		delete(b.subs, ch)
+		close(ch)
+	}
+}
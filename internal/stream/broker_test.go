@@ -0,0 +1,166 @@
+package stream_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	repository_mock "github.com/victorkt/flaggio/internal/repository/mocks"
+	"github.com/victorkt/flaggio/internal/stream"
+)
+
+func TestBroker_Run(t *testing.T) {
+	t.Parallel()
+	evts := []*flaggio.ChangeEvent{
+		{Type: flaggio.ChangeTypeFlag, ID: "1", Key: "f1"},
+		{Type: flaggio.ChangeTypeSegment, ID: "2"},
+	}
+
+	t.Run("broadcasts changes to all subscribers", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		feed := repository_mock.NewMockChangeFeed(mockCtrl)
+		broker := stream.NewBroker(feed)
+
+		sub1, unsubscribe1 := broker.Subscribe(nil)
+		defer unsubscribe1()
+		sub2, unsubscribe2 := broker.Subscribe(nil)
+		defer unsubscribe2()
+
+		feed.EXPECT().Listen(gomock.Any(), gomock.Any()).Times(1).
+			DoAndReturn(func(ctx context.Context, fn func(*flaggio.ChangeEvent)) error {
+				for _, evt := range evts {
+					fn(evt)
+				}
+				return nil
+			})
+		assert.NoError(t, broker.Run(context.Background()))
+
+		for _, sub := range []<-chan *flaggio.ChangeEvent{sub1, sub2} {
+			assert.Equal(t, evts[0], <-sub)
+			assert.Equal(t, evts[1], <-sub)
+		}
+	})
+
+	t.Run("stops sending changes after unsubscribing", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		feed := repository_mock.NewMockChangeFeed(mockCtrl)
+		broker := stream.NewBroker(feed)
+
+		sub, unsubscribe := broker.Subscribe(nil)
+		unsubscribe()
+		unsubscribe() // can be called more than once
+
+		feed.EXPECT().Listen(gomock.Any(), gomock.Any()).Times(1).
+			DoAndReturn(func(ctx context.Context, fn func(*flaggio.ChangeEvent)) error {
+				fn(evts[0])
+				return nil
+			})
+		assert.NoError(t, broker.Run(context.Background()))
+
+		_, ok := <-sub
+		assert.False(t, ok)
+	})
+
+	t.Run("closes slow subscribers", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		feed := repository_mock.NewMockChangeFeed(mockCtrl)
+		broker := stream.NewBroker(feed)
+
+		sub, unsubscribe := broker.Subscribe(nil)
+		defer unsubscribe()
+
+		feed.EXPECT().Listen(gomock.Any(), gomock.Any()).Times(1).
+			DoAndReturn(func(ctx context.Context, fn func(*flaggio.ChangeEvent)) error {
+				// never read by the subscriber
+				for i := 0; i < 100; i++ {
+					fn(evts[0])
+				}
+				return nil
+			})
+		assert.NoError(t, broker.Run(context.Background()))
+
+		var received int
+		for range sub {
+			received++
+		}
+		assert.Less(t, received, 100)
+	})
+
+	t.Run("closes all subscribers when closed", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		feed := repository_mock.NewMockChangeFeed(mockCtrl)
+		broker := stream.NewBroker(feed)
+
+		sub, unsubscribe := broker.Subscribe(nil)
+		defer unsubscribe()
+		broker.Close()
+		_, ok := <-sub
+		assert.False(t, ok)
+
+		// new subscribers are closed right away
+		sub2, unsubscribe2 := broker.Subscribe(nil)
+		defer unsubscribe2()
+		_, ok = <-sub2
+		assert.False(t, ok)
+	})
+
+	t.Run("sends only the changes selected by the filter", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		feed := repository_mock.NewMockChangeFeed(mockCtrl)
+		broker := stream.NewBroker(feed)
+
+		prj1, prj2 := "p1", "p2"
+		sub, unsubscribe := broker.Subscribe(stream.InProject(&prj1))
+		defer unsubscribe()
+		subNoPrj, unsubscribeNoPrj := broker.Subscribe(stream.InProject(nil))
+		defer unsubscribeNoPrj()
+
+		prjEvts := []*flaggio.ChangeEvent{
+			{Type: flaggio.ChangeTypeFlag, ID: "1", ProjectID: &prj2},
+			{Type: flaggio.ChangeTypeFlag, ID: "2", ProjectID: &prj1},
+			{Type: flaggio.ChangeTypeSegment, ID: "3"},
+		}
+		feed.EXPECT().Listen(gomock.Any(), gomock.Any()).Times(1).
+			DoAndReturn(func(ctx context.Context, fn func(*flaggio.ChangeEvent)) error {
+				for _, evt := range prjEvts {
+					fn(evt)
+				}
+				return nil
+			})
+		assert.NoError(t, broker.Run(context.Background()))
+
+		assert.Equal(t, prjEvts[1], <-sub)
+		assert.Equal(t, prjEvts[2], <-sub)
+		assert.Equal(t, prjEvts[2], <-subNoPrj)
+		assert.Empty(t, sub)
+		assert.Empty(t, subNoPrj)
+	})
+
+	t.Run("skips pending changes", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		feed := repository_mock.NewMockChangeFeed(mockCtrl)
+		broker := stream.NewBroker(feed)
+
+		sub, unsubscribe := broker.Subscribe(nil)
+		defer unsubscribe()
+		feed.EXPECT().Listen(gomock.Any(), gomock.Any()).Times(1).
+			DoAndReturn(func(ctx context.Context, fn func(*flaggio.ChangeEvent)) error {
+				for _, evt := range evts {
+					fn(evt)
+				}
+				return nil
+			})
+		assert.NoError(t, broker.Run(context.Background()))
+
+		stream.SkipPending(sub)
+		assert.Empty(t, sub)
+	})
+}
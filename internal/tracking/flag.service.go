@@ -0,0 +1,76 @@
+package tracking
+
+import (
+	"context"
+	"time"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/service"
+)
+
+var _ service.Flag = (*flagService)(nil)
+
+// flagService implements service.Flag interface, recording an event for
+// every evaluation. It's meant to wrap all other services, so that cached
+// evaluations are recorded as well.
+type flagService struct {
+	svc      service.Flag
+	recorder *Recorder
+}
+
+// NewFlagService returns a new Flag that records the evaluations of the given service.
+func NewFlagService(svc service.Flag, recorder *Recorder) service.Flag {
+	return &flagService{
+		svc:      svc,
+		recorder: recorder,
+	}
+}
+
+// Evaluate returns the result of an evaluation of a single flag.
+func (s *flagService) Evaluate(ctx context.Context, flagKey string, req *service.EvaluationRequest) (*service.EvaluationResponse, error) {
+	res, err := s.svc.Evaluate(ctx, flagKey, req)
+	if err != nil {
+		return nil, err
+	}
+	s.record(req.Project, req.Environment, req.UserID, time.Now(), res.Evaluation)
+	return res, nil
+}
+
+// EvaluateAll returns the results of the evaluation of all flags, or of the requested ones.
+func (s *flagService) EvaluateAll(ctx context.Context, req *service.EvaluationRequest) (*service.EvaluationsResponse, error) {
+	res, err := s.svc.EvaluateAll(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	s.record(req.Project, req.Environment, req.UserID, time.Now(), res.Evaluations...)
+	return res, nil
+}
+
+// EvaluateBatch evaluates flags for many users, calling fn with the results of each user.
+func (s *flagService) EvaluateBatch(ctx context.Context, req *service.BatchEvaluationRequest, fn func(*service.BatchEvaluationResult) error) error {
+	return s.svc.EvaluateBatch(ctx, req, func(res *service.BatchEvaluationResult) error {
+		s.record(req.Project, req.Environment, res.UserID, time.Now(), res.Evaluations...)
+		return fn(res)
+	})
+}
+
+// Ruleset returns all flags and segments, so that flags can be evaluated locally.
+// Flags evaluated locally are not recorded.
+func (s *flagService) Ruleset(ctx context.Context, req *service.RulesetRequest) (*flaggio.Ruleset, error) {
+	return s.svc.Ruleset(ctx, req)
+}
+
+func (s *flagService) record(project, environment, userID string, now time.Time, evals ...*flaggio.Evaluation) {
+	for _, eval := range evals {
+		s.recorder.Record(&flaggio.EvaluationEvent{
+			FlagKey:     eval.FlagKey,
+			FlagVersion: eval.FlagVersion,
+			VariantID:   eval.VariantID,
+			Reason:      eval.Reason,
+			UserID:      userID,
+			Project:     project,
+			Environment: environment,
+			Timestamp:   now,
+		})
+	}
+}
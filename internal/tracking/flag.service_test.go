@@ -0,0 +1,127 @@
+package tracking_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/errors"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/service"
+	service_mock "github.com/victorkt/flaggio/internal/service/mocks"
+	"github.com/victorkt/flaggio/internal/tracking"
+	tracking_mock "github.com/victorkt/flaggio/internal/tracking/mocks"
+)
+
+// recordedEvents stops the recorder and returns the events it recorded,
+// without their timestamps.
+func recordedEvents(t *testing.T, mockCtrl *gomock.Controller, run func(*tracking.Recorder)) []*flaggio.EvaluationEvent {
+	sink := tracking_mock.NewMockSink(mockCtrl)
+	recorder := tracking.NewRecorder(sink, 10, 10, time.Hour)
+	run(recorder)
+
+	var evts []*flaggio.EvaluationEvent
+	sink.EXPECT().Write(gomock.AssignableToTypeOf(ctxInterface), gomock.Any()).AnyTimes().
+		DoAndReturn(func(_ context.Context, batch []*flaggio.EvaluationEvent) error {
+			for _, evt := range batch {
+				assert.False(t, evt.Timestamp.IsZero())
+				evt.Timestamp = time.Time{}
+				evts = append(evts, evt)
+			}
+			return nil
+		})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	recorder.Run(ctx, func(err error, _ int) { t.Errorf("unexpected error: %s", err) })
+	return evts
+}
+
+func TestFlagService_Evaluate(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagSvc := service_mock.NewMockFlag(mockCtrl)
+	req := &service.EvaluationRequest{UserID: "u1", Project: "website", Environment: "production"}
+
+	flagSvc.EXPECT().Evaluate(gomock.AssignableToTypeOf(ctxInterface), "f1", req).Times(1).
+		Return(&service.EvaluationResponse{
+			Evaluation: &flaggio.Evaluation{FlagKey: "f1", FlagVersion: 3, Value: true, VariantID: "v1", Reason: flaggio.ReasonSplit},
+		}, nil)
+	flagSvc.EXPECT().Evaluate(gomock.AssignableToTypeOf(ctxInterface), "f2", req).Times(1).
+		Return(nil, errors.NotFound("flag"))
+
+	evts := recordedEvents(t, mockCtrl, func(recorder *tracking.Recorder) {
+		svc := tracking.NewFlagService(flagSvc, recorder)
+		_, err := svc.Evaluate(context.Background(), "f1", req)
+		assert.NoError(t, err)
+		_, err = svc.Evaluate(context.Background(), "f2", req)
+		assert.Equal(t, errors.NotFound("flag"), err)
+	})
+	assert.Equal(t, []*flaggio.EvaluationEvent{
+		{FlagKey: "f1", FlagVersion: 3, VariantID: "v1", Reason: flaggio.ReasonSplit, UserID: "u1", Project: "website", Environment: "production"},
+	}, evts)
+}
+
+func TestFlagService_EvaluateAll(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagSvc := service_mock.NewMockFlag(mockCtrl)
+	req := &service.EvaluationRequest{UserID: "u1"}
+
+	flagSvc.EXPECT().EvaluateAll(gomock.AssignableToTypeOf(ctxInterface), req).Times(1).
+		Return(&service.EvaluationsResponse{
+			Evaluations: flaggio.EvaluationList{
+				{FlagKey: "f1", FlagVersion: 1, Value: true, VariantID: "v1", Reason: flaggio.ReasonDefault},
+				{FlagKey: "f2", FlagVersion: 2, Error: "no default variant defined for flag", Reason: flaggio.ReasonError},
+			},
+		}, nil)
+
+	evts := recordedEvents(t, mockCtrl, func(recorder *tracking.Recorder) {
+		svc := tracking.NewFlagService(flagSvc, recorder)
+		_, err := svc.EvaluateAll(context.Background(), req)
+		assert.NoError(t, err)
+	})
+	assert.Equal(t, []*flaggio.EvaluationEvent{
+		{FlagKey: "f1", FlagVersion: 1, VariantID: "v1", Reason: flaggio.ReasonDefault, UserID: "u1"},
+		{FlagKey: "f2", FlagVersion: 2, Reason: flaggio.ReasonError, UserID: "u1"},
+	}, evts)
+}
+
+func TestFlagService_EvaluateBatch(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	flagSvc := service_mock.NewMockFlag(mockCtrl)
+	req := &service.BatchEvaluationRequest{Project: "website"}
+
+	flagSvc.EXPECT().EvaluateBatch(gomock.AssignableToTypeOf(ctxInterface), req, gomock.Any()).Times(1).
+		DoAndReturn(func(_ context.Context, _ *service.BatchEvaluationRequest, fn func(*service.BatchEvaluationResult) error) error {
+			for _, userID := range []string{"u1", "u2"} {
+				if err := fn(&service.BatchEvaluationResult{
+					UserID:      userID,
+					Evaluations: flaggio.EvaluationList{{FlagKey: "f1", VariantID: "v1", Reason: flaggio.ReasonDefault}},
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+	evts := recordedEvents(t, mockCtrl, func(recorder *tracking.Recorder) {
+		svc := tracking.NewFlagService(flagSvc, recorder)
+		var users []string
+		err := svc.EvaluateBatch(context.Background(), req, func(res *service.BatchEvaluationResult) error {
+			users = append(users, res.UserID)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"u1", "u2"}, users)
+	})
+	assert.Equal(t, []*flaggio.EvaluationEvent{
+		{FlagKey: "f1", VariantID: "v1", Reason: flaggio.ReasonDefault, UserID: "u1", Project: "website"},
+		{FlagKey: "f1", VariantID: "v1", Reason: flaggio.ReasonDefault, UserID: "u2", Project: "website"},
+	}, evts)
+}
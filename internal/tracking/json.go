@@ -0,0 +1,34 @@
+package tracking
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// JSONSink writes evaluation events to a writer, such as the standard
+// output, as JSON objects separated by new lines.
+type JSONSink struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewJSONSink returns a new sink that writes events to w.
+func NewJSONSink(w io.Writer) *JSONSink {
+	return &JSONSink{enc: json.NewEncoder(w)}
+}
+
+// Write writes a batch of evaluation events.
+func (s *JSONSink) Write(_ context.Context, evts []*flaggio.EvaluationEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, evt := range evts {
+		if err := s.enc.Encode(evt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
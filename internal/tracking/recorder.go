@@ -0,0 +1,100 @@
+package tracking
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// flushTimeout is how long to wait for the sink to write the remaining
+// events once the recorder stops.
+const flushTimeout = 5 * time.Second
+
+// Recorder buffers evaluation events and writes them to a sink in batches.
+// The buffer is bounded, so events are dropped instead of slowing down
+// evaluations when the sink can't keep up.
+type Recorder struct {
+	sink          Sink
+	events        chan *flaggio.EvaluationEvent
+	batchSize     int
+	flushInterval time.Duration
+	dropped       uint64
+}
+
+// NewRecorder returns a new recorder for the given sink. Up to bufferSize
+// events are kept in memory while waiting to be written. Events are written
+// once batchSize events are buffered, or every flushInterval, whichever
+// comes first.
+func NewRecorder(sink Sink, bufferSize, batchSize int, flushInterval time.Duration) *Recorder {
+	return &Recorder{
+		sink:          sink,
+		events:        make(chan *flaggio.EvaluationEvent, bufferSize),
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+	}
+}
+
+// Record adds an event to the buffer, without blocking. It returns false
+// if the buffer is full and the event was dropped.
+func (r *Recorder) Record(evt *flaggio.EvaluationEvent) bool {
+	select {
+	case r.events <- evt:
+		return true
+	default:
+		atomic.AddUint64(&r.dropped, 1)
+		return false
+	}
+}
+
+// Dropped returns the number of events dropped so far.
+func (r *Recorder) Dropped() uint64 {
+	return atomic.LoadUint64(&r.dropped)
+}
+
+// Run writes the buffered events to the sink until the context is
+// cancelled. Events still in the buffer by then are written before
+// returning. Batches that fail to be written are discarded, and onError
+// is called with the error and the number of events lost.
+func (r *Recorder) Run(ctx context.Context, onError func(err error, lost int)) {
+	ticker := time.NewTicker(r.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*flaggio.EvaluationEvent, 0, r.batchSize)
+	flush := func(ctx context.Context) {
+		if len(batch) == 0 {
+			return
+		}
+		if err := r.sink.Write(ctx, batch); err != nil {
+			onError(err, len(batch))
+		}
+		// the sink may hold on to the events, so a new slice is needed
+		batch = make([]*flaggio.EvaluationEvent, 0, r.batchSize)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			// write whatever is left with a fresh context,
+			// as the one given is already cancelled
+			flushCtx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+			defer cancel()
+			for len(r.events) > 0 {
+				batch = append(batch, <-r.events)
+				if len(batch) >= r.batchSize {
+					flush(flushCtx)
+				}
+			}
+			flush(flushCtx)
+			return
+		case evt := <-r.events:
+			batch = append(batch, evt)
+			if len(batch) >= r.batchSize {
+				flush(ctx)
+			}
+		case <-ticker.C:
+			flush(ctx)
+		}
+	}
+}
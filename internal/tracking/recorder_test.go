@@ -0,0 +1,114 @@
+package tracking_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/tracking"
+	tracking_mock "github.com/victorkt/flaggio/internal/tracking/mocks"
+)
+
+var (
+	ctxInterface = reflect.TypeOf((*context.Context)(nil)).Elem()
+)
+
+func TestRecorder_Record(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	sink := tracking_mock.NewMockSink(mockCtrl)
+	recorder := tracking.NewRecorder(sink, 2, 10, time.Hour)
+
+	// nothing is reading the buffer, so it fills up
+	assert.True(t, recorder.Record(&flaggio.EvaluationEvent{FlagKey: "f1"}))
+	assert.True(t, recorder.Record(&flaggio.EvaluationEvent{FlagKey: "f2"}))
+	assert.False(t, recorder.Record(&flaggio.EvaluationEvent{FlagKey: "f3"}))
+	assert.Equal(t, uint64(1), recorder.Dropped())
+}
+
+func TestRecorder_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("writes events in batches", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		sink := tracking_mock.NewMockSink(mockCtrl)
+		recorder := tracking.NewRecorder(sink, 10, 2, time.Hour)
+
+		written := make(chan []*flaggio.EvaluationEvent, 1)
+		sink.EXPECT().Write(gomock.AssignableToTypeOf(ctxInterface), gomock.Any()).Times(1).
+			DoAndReturn(func(_ context.Context, evts []*flaggio.EvaluationEvent) error {
+				written <- evts
+				return nil
+			})
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			recorder.Run(ctx, func(err error, _ int) { t.Errorf("unexpected error: %s", err) })
+			close(done)
+		}()
+
+		recorder.Record(&flaggio.EvaluationEvent{FlagKey: "f1"})
+		recorder.Record(&flaggio.EvaluationEvent{FlagKey: "f2"})
+		assert.Equal(t, []*flaggio.EvaluationEvent{{FlagKey: "f1"}, {FlagKey: "f2"}}, <-written)
+		cancel()
+		<-done
+	})
+
+	t.Run("writes events periodically", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		sink := tracking_mock.NewMockSink(mockCtrl)
+		recorder := tracking.NewRecorder(sink, 10, 10, 10*time.Millisecond)
+
+		written := make(chan []*flaggio.EvaluationEvent, 1)
+		sink.EXPECT().Write(gomock.AssignableToTypeOf(ctxInterface), gomock.Any()).Times(1).
+			DoAndReturn(func(_ context.Context, evts []*flaggio.EvaluationEvent) error {
+				written <- evts
+				return nil
+			})
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			recorder.Run(ctx, func(err error, _ int) { t.Errorf("unexpected error: %s", err) })
+			close(done)
+		}()
+
+		recorder.Record(&flaggio.EvaluationEvent{FlagKey: "f1"})
+		assert.Equal(t, []*flaggio.EvaluationEvent{{FlagKey: "f1"}}, <-written)
+		cancel()
+		<-done
+	})
+
+	t.Run("writes remaining events when stopping", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		sink := tracking_mock.NewMockSink(mockCtrl)
+		recorder := tracking.NewRecorder(sink, 10, 2, time.Hour)
+
+		recorder.Record(&flaggio.EvaluationEvent{FlagKey: "f1"})
+		recorder.Record(&flaggio.EvaluationEvent{FlagKey: "f2"})
+		recorder.Record(&flaggio.EvaluationEvent{FlagKey: "f3"})
+		gomock.InOrder(
+			sink.EXPECT().Write(gomock.AssignableToTypeOf(ctxInterface), []*flaggio.EvaluationEvent{{FlagKey: "f1"}, {FlagKey: "f2"}}).
+				Times(1).Return(nil),
+			sink.EXPECT().Write(gomock.AssignableToTypeOf(ctxInterface), []*flaggio.EvaluationEvent{{FlagKey: "f3"}}).
+				Times(1).Return(errors.New("sink failed")),
+		)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var lost int
+		recorder.Run(ctx, func(err error, n int) {
+			assert.EqualError(t, err, "sink failed")
+			lost += n
+		})
+		assert.Equal(t, 1, lost)
+	})
+}
@@ -0,0 +1,37 @@
+// Package tracking records an event for every flag evaluation, so that it's
+// possible to know which variants are actually served. Events are buffered
+// and written to a sink in batches, away from the evaluation path.
+package tracking
+
+//go:generate mockgen -destination=./mocks/sink_mock.go -package=tracking_mock github.com/victorkt/flaggio/internal/tracking Sink
+
+import (
+	"context"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// Sink is where evaluation events end up.
+type Sink interface {
+	// Write writes a batch of evaluation events.
+	Write(ctx context.Context, evts []*flaggio.EvaluationEvent) error
+}
+
+// MultiSink returns a sink that writes events to all the given sinks.
+// Events are written to every sink even if some of them fail, and the
+// first error is returned.
+func MultiSink(sinks ...Sink) Sink {
+	return multiSink(sinks)
+}
+
+type multiSink []Sink
+
+func (s multiSink) Write(ctx context.Context, evts []*flaggio.EvaluationEvent) error {
+	var firstErr error
+	for _, sink := range s {
+		if err := sink.Write(ctx, evts); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
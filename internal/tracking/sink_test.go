@@ -0,0 +1,81 @@
+package tracking_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/internal/tracking"
+	tracking_mock "github.com/victorkt/flaggio/internal/tracking/mocks"
+)
+
+var evts = []*flaggio.EvaluationEvent{
+	{FlagKey: "f1", FlagVersion: 2, VariantID: "v1", Reason: flaggio.ReasonDefault, UserID: "u1",
+		Timestamp: time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)},
+	{FlagKey: "f2", FlagVersion: 1, Reason: flaggio.ReasonError, UserID: "u1", Project: "website",
+		Timestamp: time.Date(2020, 5, 1, 10, 0, 1, 0, time.UTC)},
+}
+
+func TestJSONSink_Write(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	sink := tracking.NewJSONSink(&buf)
+
+	assert.NoError(t, sink.Write(context.Background(), evts))
+	assert.Equal(t, `{"flagKey":"f1","flagVersion":2,"variantId":"v1","reason":"DEFAULT","userId":"u1","timestamp":"2020-05-01T10:00:00Z"}
+{"flagKey":"f2","flagVersion":1,"reason":"ERROR","userId":"u1","project":"website","timestamp":"2020-05-01T10:00:01Z"}
+`, buf.String())
+}
+
+func TestWebhookSink_Write(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sends events in a single request", func(t *testing.T) {
+		var received struct {
+			Events []*flaggio.EvaluationEvent `json:"events"`
+		}
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer srv.Close()
+		sink := tracking.NewWebhookSink(srv.URL, srv.Client())
+
+		assert.NoError(t, sink.Write(context.Background(), evts))
+		assert.Equal(t, evts, received.Events)
+	})
+
+	t.Run("fails if the endpoint fails", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+		sink := tracking.NewWebhookSink(srv.URL, srv.Client())
+
+		assert.EqualError(t, sink.Write(context.Background(), evts), "webhook responded with status 503")
+	})
+}
+
+func TestMultiSink_Write(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	sink1 := tracking_mock.NewMockSink(mockCtrl)
+	sink2 := tracking_mock.NewMockSink(mockCtrl)
+	sink := tracking.MultiSink(sink1, sink2)
+
+	sink1.EXPECT().Write(gomock.AssignableToTypeOf(ctxInterface), evts).Times(1).Return(errors.New("sink failed"))
+	sink2.EXPECT().Write(gomock.AssignableToTypeOf(ctxInterface), evts).Times(1).Return(nil)
+
+	assert.EqualError(t, sink.Write(context.Background(), evts), "sink failed")
+}
@@ -0,0 +1,50 @@
+package tracking
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// WebhookSink sends evaluation events to an HTTP endpoint. Each batch is
+// sent in a single POST request, as a JSON object with the events in the
+// "events" property. Any response other than 2xx is considered a failure.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink returns a new sink that sends events to the given URL.
+func NewWebhookSink(url string, client *http.Client) *WebhookSink {
+	return &WebhookSink{url: url, client: client}
+}
+
+type webhookPayload struct {
+	Events []*flaggio.EvaluationEvent `json:"events"`
+}
+
+// Write writes a batch of evaluation events.
+func (s *WebhookSink) Write(ctx context.Context, evts []*flaggio.EvaluationEvent) error {
+	b, err := json.Marshal(webhookPayload{Events: evts})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,330 @@
+// Package client is a flaggio SDK that evaluates flags locally. The
+// ruleset is fetched from the API server and kept up to date, either by
+// polling or by streaming changes, or loaded from a file in offline mode.
+//
+//	c, err := client.New(ctx, client.Config{
+//		URL:     "http://localhost:8080",
+//		APIKey:  "srv-...",
+//		Project: "website",
+//		Stream:  true,
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	defer c.Close()
+//
+//	if c.BoolVariation("new-checkout", client.User{ID: "123"}, false) {
+//		// ...
+//	}
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+const (
+	// DefaultPollInterval is how often the ruleset is fetched when no other
+	// interval is configured.
+	DefaultPollInterval = 30 * time.Second
+	// DefaultRequestTimeout is how long to wait for the ruleset to be fetched
+	// when no other timeout is configured.
+	DefaultRequestTimeout = 10 * time.Second
+)
+
+var (
+	// ErrFlagNotFound is returned when evaluating a flag that is not in the ruleset.
+	ErrFlagNotFound = errors.New("flag not found")
+	// ErrWrongType is returned when the value of a flag is not of the requested type.
+	ErrWrongType = errors.New("flag value has the wrong type")
+)
+
+// Reason explains why an evaluation returned its value.
+type Reason = flaggio.Reason
+
+// Evaluation reasons, as in the OpenFeature specification.
+const (
+	ReasonDisabled       = flaggio.ReasonDisabled
+	ReasonTargetingMatch = flaggio.ReasonTargetingMatch
+	ReasonSplit          = flaggio.ReasonSplit
+	ReasonDefault        = flaggio.ReasonDefault
+	ReasonError          = flaggio.ReasonError
+)
+
+// EvaluationDetail is the result of a flag evaluation. VariantID is the
+// ID of the variant the value comes from.
+type EvaluationDetail struct {
+	Value     interface{}
+	VariantID string
+	Reason    Reason
+}
+
+// Config holds the client settings.
+type Config struct {
+	// URL is the address of the API server, e.g. http://localhost:8080.
+	URL string
+	// APIKey is the server API key used to fetch the ruleset. Client keys
+	// can't be used, as the ruleset exposes the flag configuration.
+	APIKey string
+	// Project is the key of the project that owns the flags. When empty,
+	// only flags that don't belong to a project are evaluated.
+	Project string
+	// Environment is the key of the environment in which flags are evaluated.
+	Environment string
+	// PollInterval is how often the ruleset is fetched. When streaming, it's
+	// how long to wait before reconnecting to the stream. Defaults to
+	// DefaultPollInterval.
+	PollInterval time.Duration
+	// Stream makes the client fetch the ruleset as soon as flags change,
+	// instead of periodically.
+	Stream bool
+	// OfflineFile is the path to a ruleset file, as returned by the API
+	// server. When set, the ruleset is loaded from the file and the API
+	// server is never called.
+	OfflineFile string
+	// RequestTimeout is how long to wait for the ruleset to be fetched, so
+	// that a request that hangs doesn't stop the updates. Defaults to
+	// DefaultRequestTimeout.
+	RequestTimeout time.Duration
+	// HTTPClient is used to call the API server. Defaults to a client
+	// with no timeout, as streams are long lived. Ruleset requests are
+	// limited by RequestTimeout instead.
+	HTTPClient *http.Client
+	// OnError is called with the errors that happen while updating the
+	// ruleset in the background. Evaluations keep using the last ruleset.
+	OnError func(error)
+}
+
+// User is the user flags are evaluated for. Context holds the user
+// properties used by the flag rules.
+type User struct {
+	ID      string
+	Context map[string]interface{}
+}
+
+// Client evaluates flags locally using the latest ruleset.
+type Client struct {
+	cfg    Config
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu      sync.RWMutex
+	ruleset *flaggio.Ruleset
+	flags   map[string]*flaggio.Flag
+}
+
+// New returns a new client. The ruleset is loaded before returning, and
+// an error is returned if that fails. Unless in offline mode, the ruleset
+// is updated in the background until the client is closed.
+func New(ctx context.Context, cfg Config) (*Client, error) {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	if cfg.RequestTimeout <= 0 {
+		cfg.RequestTimeout = DefaultRequestTimeout
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{}
+	}
+	cfg.URL = strings.TrimSuffix(cfg.URL, "/")
+	c := &Client{cfg: cfg, done: make(chan struct{})}
+
+	if cfg.OfflineFile != "" {
+		close(c.done)
+		if err := c.loadFile(cfg.OfflineFile); err != nil {
+			return nil, err
+		}
+		return c, nil
+	}
+
+	if err := c.refresh(ctx); err != nil {
+		return nil, err
+	}
+	runCtx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	go c.run(runCtx)
+	return c, nil
+}
+
+// Close stops updating the ruleset. Flags can still be evaluated
+// with the last ruleset.
+func (c *Client) Close() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	<-c.done
+}
+
+// Version returns the version of the ruleset in use.
+func (c *Client) Version() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ruleset.Version
+}
+
+// Evaluate returns the value of a flag for the given user.
+func (c *Client) Evaluate(flagKey string, user User) (interface{}, error) {
+	detail, err := c.EvaluateDetail(flagKey, user)
+	if err != nil {
+		return nil, err
+	}
+	return detail.Value, nil
+}
+
+// EvaluateDetail returns the value of a flag for the given user, along with
+// the variant it comes from and the reason it was chosen. If the flag can't
+// be evaluated, the reason is ReasonError.
+func (c *Client) EvaluateDetail(flagKey string, user User) (EvaluationDetail, error) {
+	c.mu.RLock()
+	flg, ok := c.flags[flagKey]
+	c.mu.RUnlock()
+	if !ok {
+		return EvaluationDetail{Reason: ReasonError}, fmt.Errorf("%w: %s", ErrFlagNotFound, flagKey)
+	}
+
+	usrContext := make(map[string]interface{}, len(user.Context)+1)
+	for k, v := range user.Context {
+		usrContext[k] = v
+	}
+	usrContext["$userId"] = user.ID
+
+	res, err := flaggio.Evaluate(usrContext, flg)
+	if err != nil {
+		return EvaluationDetail{Reason: ReasonError}, err
+	}
+	return EvaluationDetail{
+		Value:     res.Answer,
+		VariantID: res.VariantID,
+		Reason:    res.Reason(),
+	}, nil
+}
+
+// BoolVariation returns the value of a boolean flag for the given user, or
+// the default value if the flag can't be evaluated or is not a boolean.
+func (c *Client) BoolVariation(flagKey string, user User, defaultValue bool) bool {
+	v, err := c.Evaluate(flagKey, user)
+	if err != nil {
+		return defaultValue
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return defaultValue
+	}
+	return b
+}
+
+// StringVariation returns the value of a string flag for the given user, or
+// the default value if the flag can't be evaluated or is not a string.
+func (c *Client) StringVariation(flagKey string, user User, defaultValue string) string {
+	v, err := c.Evaluate(flagKey, user)
+	if err != nil {
+		return defaultValue
+	}
+	s, ok := v.(string)
+	if !ok {
+		return defaultValue
+	}
+	return s
+}
+
+// FloatVariation returns the value of a number flag for the given user, or
+// the default value if the flag can't be evaluated or is not a number.
+func (c *Client) FloatVariation(flagKey string, user User, defaultValue float64) float64 {
+	v, err := c.Evaluate(flagKey, user)
+	if err != nil {
+		return defaultValue
+	}
+	f, ok := toFloat(v)
+	if !ok {
+		return defaultValue
+	}
+	return f
+}
+
+// IntVariation returns the value of a number flag for the given user, or the
+// default value if the flag can't be evaluated or is not a whole number.
+func (c *Client) IntVariation(flagKey string, user User, defaultValue int) int {
+	v, err := c.Evaluate(flagKey, user)
+	if err != nil {
+		return defaultValue
+	}
+	f, ok := toFloat(v)
+	if !ok || f != math.Trunc(f) {
+		return defaultValue
+	}
+	return int(f)
+}
+
+// JSONVariation decodes the value of a flag for the given user into out,
+// which must be a pointer, as with json.Unmarshal. If the flag can't be
+// evaluated or its value can't be decoded into out, out is left untouched
+// and an error is returned.
+func (c *Client) JSONVariation(flagKey string, user User, out interface{}) error {
+	v, err := c.Evaluate(flagKey, user)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("%w: %s", ErrWrongType, err)
+	}
+	return nil
+}
+
+// setRuleset replaces the ruleset used to evaluate flags.
+func (c *Client) setRuleset(rs *flaggio.Ruleset) error {
+	if rs.SchemaVersion > flaggio.RulesetSchemaVersion {
+		return fmt.Errorf("unsupported ruleset schema version %d", rs.SchemaVersion)
+	}
+	rs.Populate()
+	flags := make(map[string]*flaggio.Flag, len(rs.Flags))
+	for _, flg := range rs.Flags {
+		flags[flg.Key] = flg
+	}
+	c.mu.Lock()
+	c.ruleset = rs
+	c.flags = flags
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *Client) loadFile(path string) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var rs flaggio.Ruleset
+	if err := json.Unmarshal(b, &rs); err != nil {
+		return fmt.Errorf("decoding ruleset file: %w", err)
+	}
+	return c.setRuleset(&rs)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	}
+	return 0, false
+}
@@ -0,0 +1,255 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	"github.com/victorkt/flaggio/pkg/client"
+)
+
+func newFlag(key string, value interface{}) *flaggio.Flag {
+	vrnt := &flaggio.Variant{ID: key + "-v1", Value: value}
+	return &flaggio.Flag{
+		ID:                    key,
+		Key:                   key,
+		Enabled:               true,
+		Variants:              []*flaggio.Variant{vrnt},
+		DefaultVariantWhenOn:  vrnt,
+		DefaultVariantWhenOff: vrnt,
+	}
+}
+
+func newRuleset(t *testing.T, flgs ...*flaggio.Flag) *flaggio.Ruleset {
+	rs, err := flaggio.NewRuleset(flgs, nil)
+	if err != nil {
+		t.Fatalf("failed to create ruleset: %s", err)
+	}
+	return rs
+}
+
+// rulesetServer is a test API server that serves a ruleset.
+type rulesetServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	ruleset  *flaggio.Ruleset
+	requests int
+	changes  chan struct{}
+}
+
+func newRulesetServer(t *testing.T, rs *flaggio.Ruleset) *rulesetServer {
+	srv := &rulesetServer{ruleset: rs, changes: make(chan struct{})}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/projects/website/ruleset", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer srv-key", r.Header.Get("Authorization"))
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+		srv.requests++
+		etag := fmt.Sprintf("%q", srv.ruleset.Version)
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(srv.ruleset)
+	})
+	mux.HandleFunc("/v1/projects/website/stream", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-srv.changes:
+				fmt.Fprint(w, "event: change\ndata: {\"type\":\"flag\",\"id\":\"1\"}\n\n")
+				w.(http.Flusher).Flush()
+			}
+		}
+	})
+	srv.Server = httptest.NewServer(mux)
+	return srv
+}
+
+func (s *rulesetServer) setRuleset(rs *flaggio.Ruleset) {
+	s.mu.Lock()
+	s.ruleset = rs
+	s.mu.Unlock()
+}
+
+func (s *rulesetServer) requestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func TestClient_Variations(t *testing.T) {
+	t.Parallel()
+	srv := newRulesetServer(t, newRuleset(t,
+		newFlag("bool", true),
+		newFlag("string", "abc"),
+		newFlag("number", float64(5)),
+		newFlag("object", map[string]interface{}{"a": "b"}),
+	))
+	defer srv.Close()
+
+	c, err := client.New(context.Background(), client.Config{
+		URL:     srv.URL,
+		APIKey:  "srv-key",
+		Project: "website",
+	})
+	assert.NoError(t, err)
+	defer c.Close()
+	user := client.User{ID: "123"}
+
+	assert.True(t, c.BoolVariation("bool", user, false))
+	assert.Equal(t, "abc", c.StringVariation("string", user, "def"))
+	assert.Equal(t, float64(5), c.FloatVariation("number", user, 1))
+	assert.Equal(t, 5, c.IntVariation("number", user, 1))
+	var obj struct{ A string }
+	assert.NoError(t, c.JSONVariation("object", user, &obj))
+	assert.Equal(t, "b", obj.A)
+
+	// default values are returned for unknown flags or wrong types
+	assert.True(t, c.BoolVariation("unknown", user, true))
+	assert.Equal(t, "def", c.StringVariation("bool", user, "def"))
+	assert.Equal(t, float64(1), c.FloatVariation("string", user, 1))
+	var n int
+	assert.Error(t, c.JSONVariation("object", user, &n))
+	_, err = c.Evaluate("unknown", user)
+	assert.True(t, errors.Is(err, client.ErrFlagNotFound))
+}
+
+func TestClient_Polling(t *testing.T) {
+	t.Parallel()
+	srv := newRulesetServer(t, newRuleset(t, newFlag("f1", "a")))
+	defer srv.Close()
+
+	c, err := client.New(context.Background(), client.Config{
+		URL:          srv.URL,
+		APIKey:       "srv-key",
+		Project:      "website",
+		PollInterval: 10 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+	defer c.Close()
+	user := client.User{ID: "123"}
+	assert.Equal(t, "a", c.StringVariation("f1", user, ""))
+
+	srv.setRuleset(newRuleset(t, newFlag("f1", "b")))
+	assert.Eventually(t, func() bool {
+		return c.StringVariation("f1", user, "") == "b"
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestClient_PollingTimeout(t *testing.T) {
+	t.Parallel()
+	rs := newRuleset(t, newFlag("f1", "a"))
+	var mu sync.Mutex
+	hang := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		h, current := hang, rs
+		mu.Unlock()
+		if h {
+			// never answers, until the client gives up
+			<-r.Context().Done()
+			return
+		}
+		_ = json.NewEncoder(w).Encode(current)
+	}))
+	defer srv.Close()
+
+	errs := make(chan error, 10)
+	c, err := client.New(context.Background(), client.Config{
+		URL:            srv.URL,
+		PollInterval:   10 * time.Millisecond,
+		RequestTimeout: 20 * time.Millisecond,
+		OnError: func(err error) {
+			select {
+			case errs <- err:
+			default:
+			}
+		},
+	})
+	assert.NoError(t, err)
+	defer c.Close()
+
+	mu.Lock()
+	hang = true
+	mu.Unlock()
+	select {
+	case err := <-errs:
+		assert.True(t, errors.Is(err, context.DeadlineExceeded), err)
+	case <-time.After(time.Second):
+		t.Fatal("timed out requests were not reported")
+	}
+
+	// updates continue once the server answers again
+	mu.Lock()
+	hang = false
+	rs = newRuleset(t, newFlag("f1", "b"))
+	mu.Unlock()
+	assert.Eventually(t, func() bool {
+		return c.StringVariation("f1", client.User{ID: "123"}, "") == "b"
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestClient_Streaming(t *testing.T) {
+	t.Parallel()
+	srv := newRulesetServer(t, newRuleset(t, newFlag("f1", "a")))
+	defer srv.Close()
+
+	c, err := client.New(context.Background(), client.Config{
+		URL:          srv.URL,
+		APIKey:       "srv-key",
+		Project:      "website",
+		PollInterval: time.Hour,
+		Stream:       true,
+	})
+	assert.NoError(t, err)
+	defer c.Close()
+	user := client.User{ID: "123"}
+
+	// wait for the ruleset to be fetched after connecting to the stream
+	assert.Eventually(t, func() bool {
+		return srv.requestCount() == 2
+	}, time.Second, 10*time.Millisecond)
+
+	srv.setRuleset(newRuleset(t, newFlag("f1", "b")))
+	srv.changes <- struct{}{}
+	assert.Eventually(t, func() bool {
+		return c.StringVariation("f1", user, "") == "b"
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestClient_Offline(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "flaggio")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	b, err := json.Marshal(newRuleset(t, newFlag("f1", "a")))
+	assert.NoError(t, err)
+	path := filepath.Join(dir, "ruleset.json")
+	assert.NoError(t, ioutil.WriteFile(path, b, 0600))
+
+	c, err := client.New(context.Background(), client.Config{OfflineFile: path})
+	assert.NoError(t, err)
+	defer c.Close()
+	assert.Equal(t, "a", c.StringVariation("f1", client.User{ID: "123"}, ""))
+
+	_, err = client.New(context.Background(), client.Config{OfflineFile: filepath.Join(dir, "missing.json")})
+	assert.Error(t, err)
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/victorkt/flaggio/internal/flaggio"
+)
+
+// run updates the ruleset until the context is cancelled.
+func (c *Client) run(ctx context.Context) {
+	defer close(c.done)
+	for {
+		if c.cfg.Stream {
+			// fetch the ruleset every time flags change
+			if err := c.stream(ctx); err != nil && ctx.Err() == nil {
+				c.reportError(fmt.Errorf("streaming changes: %w", err))
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.cfg.PollInterval):
+		}
+		if err := c.refresh(ctx); err != nil && ctx.Err() == nil {
+			c.reportError(fmt.Errorf("fetching ruleset: %w", err))
+		}
+	}
+}
+
+// refresh fetches the ruleset, unless it didn't change since it was last
+// fetched. The request is cancelled if it takes longer than the configured
+// timeout.
+func (c *Client) refresh(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.RequestTimeout)
+	defer cancel()
+	req, err := c.newRequest(ctx, "ruleset")
+	if err != nil {
+		return err
+	}
+	c.mu.RLock()
+	if c.ruleset != nil {
+		req.Header.Set("If-None-Match", fmt.Sprintf("%q", c.ruleset.Version))
+	}
+	c.mu.RUnlock()
+
+	res, err := c.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified:
+		return nil
+	default:
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	var rs flaggio.Ruleset
+	if err := json.NewDecoder(res.Body).Decode(&rs); err != nil {
+		return fmt.Errorf("decoding ruleset: %w", err)
+	}
+	return c.setRuleset(&rs)
+}
+
+// stream fetches the ruleset whenever a change event is received, until the
+// context is cancelled or the stream is interrupted.
+func (c *Client) stream(ctx context.Context) error {
+	req, err := c.newRequest(ctx, "stream")
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	res, err := c.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	// changes may have been missed while disconnected
+	if err := c.refresh(ctx); err != nil {
+		c.reportError(fmt.Errorf("fetching ruleset: %w", err))
+	}
+
+	var event string
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case line == "":
+			// end of the event
+			if event == "change" {
+				if err := c.refresh(ctx); err != nil && ctx.Err() == nil {
+					c.reportError(fmt.Errorf("fetching ruleset: %w", err))
+				}
+			}
+			event = ""
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return fmt.Errorf("stream closed by the server")
+}
+
+// newRequest returns a GET request to an API endpoint, in the configured
+// project and environment.
+func (c *Client) newRequest(ctx context.Context, endpoint string) (*http.Request, error) {
+	path := c.cfg.URL + "/v1"
+	if c.cfg.Project != "" {
+		path += "/projects/" + url.PathEscape(c.cfg.Project)
+	}
+	if c.cfg.Environment != "" {
+		path += "/environments/" + url.PathEscape(c.cfg.Environment)
+	}
+	req, err := http.NewRequest(http.MethodGet, path+"/"+endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.cfg.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.cfg.APIKey)
+	}
+	return req.WithContext(ctx), nil
+}
+
+func (c *Client) reportError(err error) {
+	if c.cfg.OnError != nil {
+		c.cfg.OnError(err)
+	}
+}
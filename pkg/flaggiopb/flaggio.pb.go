@@ -0,0 +1,903 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: flaggio.proto
+
+package flaggiopb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type User struct {
+	Id                   string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Context              *_struct.Struct `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *User) Reset()         { *m = User{} }
+func (m *User) String() string { return proto.CompactTextString(m) }
+func (*User) ProtoMessage()    {}
+func (*User) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7174cf91de820c9, []int{0}
+}
+
+func (m *User) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_User.Unmarshal(m, b)
+}
+func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_User.Marshal(b, m, deterministic)
+}
+func (m *User) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User.Merge(m, src)
+}
+func (m *User) XXX_Size() int {
+	return xxx_messageInfo_User.Size(m)
+}
+func (m *User) XXX_DiscardUnknown() {
+	xxx_messageInfo_User.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_User proto.InternalMessageInfo
+
+func (m *User) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *User) GetContext() *_struct.Struct {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+type EvaluateRequest struct {
+	FlagKey              string   `protobuf:"bytes,1,opt,name=flag_key,json=flagKey,proto3" json:"flag_key,omitempty"`
+	Project              string   `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Environment          string   `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	User                 *User    `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	Debug                bool     `protobuf:"varint,5,opt,name=debug,proto3" json:"debug,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvaluateRequest) Reset()         { *m = EvaluateRequest{} }
+func (m *EvaluateRequest) String() string { return proto.CompactTextString(m) }
+func (*EvaluateRequest) ProtoMessage()    {}
+func (*EvaluateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7174cf91de820c9, []int{1}
+}
+
+func (m *EvaluateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvaluateRequest.Unmarshal(m, b)
+}
+func (m *EvaluateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvaluateRequest.Marshal(b, m, deterministic)
+}
+func (m *EvaluateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateRequest.Merge(m, src)
+}
+func (m *EvaluateRequest) XXX_Size() int {
+	return xxx_messageInfo_EvaluateRequest.Size(m)
+}
+func (m *EvaluateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluateRequest proto.InternalMessageInfo
+
+func (m *EvaluateRequest) GetFlagKey() string {
+	if m != nil {
+		return m.FlagKey
+	}
+	return ""
+}
+
+func (m *EvaluateRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *EvaluateRequest) GetEnvironment() string {
+	if m != nil {
+		return m.Environment
+	}
+	return ""
+}
+
+func (m *EvaluateRequest) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *EvaluateRequest) GetDebug() bool {
+	if m != nil {
+		return m.Debug
+	}
+	return false
+}
+
+type EvaluateResponse struct {
+	Evaluation           *Evaluation     `protobuf:"bytes,1,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
+	Context              *_struct.Struct `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *EvaluateResponse) Reset()         { *m = EvaluateResponse{} }
+func (m *EvaluateResponse) String() string { return proto.CompactTextString(m) }
+func (*EvaluateResponse) ProtoMessage()    {}
+func (*EvaluateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7174cf91de820c9, []int{2}
+}
+
+func (m *EvaluateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvaluateResponse.Unmarshal(m, b)
+}
+func (m *EvaluateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvaluateResponse.Marshal(b, m, deterministic)
+}
+func (m *EvaluateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateResponse.Merge(m, src)
+}
+func (m *EvaluateResponse) XXX_Size() int {
+	return xxx_messageInfo_EvaluateResponse.Size(m)
+}
+func (m *EvaluateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluateResponse proto.InternalMessageInfo
+
+func (m *EvaluateResponse) GetEvaluation() *Evaluation {
+	if m != nil {
+		return m.Evaluation
+	}
+	return nil
+}
+
+func (m *EvaluateResponse) GetContext() *_struct.Struct {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+type EvaluateAllRequest struct {
+	Project              string   `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Environment          string   `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	User                 *User    `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Debug                bool     `protobuf:"varint,4,opt,name=debug,proto3" json:"debug,omitempty"`
+	Keys                 []string `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvaluateAllRequest) Reset()         { *m = EvaluateAllRequest{} }
+func (m *EvaluateAllRequest) String() string { return proto.CompactTextString(m) }
+func (*EvaluateAllRequest) ProtoMessage()    {}
+func (*EvaluateAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7174cf91de820c9, []int{3}
+}
+
+func (m *EvaluateAllRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvaluateAllRequest.Unmarshal(m, b)
+}
+func (m *EvaluateAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvaluateAllRequest.Marshal(b, m, deterministic)
+}
+func (m *EvaluateAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateAllRequest.Merge(m, src)
+}
+func (m *EvaluateAllRequest) XXX_Size() int {
+	return xxx_messageInfo_EvaluateAllRequest.Size(m)
+}
+func (m *EvaluateAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateAllRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluateAllRequest proto.InternalMessageInfo
+
+func (m *EvaluateAllRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *EvaluateAllRequest) GetEnvironment() string {
+	if m != nil {
+		return m.Environment
+	}
+	return ""
+}
+
+func (m *EvaluateAllRequest) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *EvaluateAllRequest) GetDebug() bool {
+	if m != nil {
+		return m.Debug
+	}
+	return false
+}
+
+func (m *EvaluateAllRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type EvaluateAllResponse struct {
+	Evaluations          []*Evaluation   `protobuf:"bytes,1,rep,name=evaluations,proto3" json:"evaluations,omitempty"`
+	Context              *_struct.Struct `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *EvaluateAllResponse) Reset()         { *m = EvaluateAllResponse{} }
+func (m *EvaluateAllResponse) String() string { return proto.CompactTextString(m) }
+func (*EvaluateAllResponse) ProtoMessage()    {}
+func (*EvaluateAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7174cf91de820c9, []int{4}
+}
+
+func (m *EvaluateAllResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvaluateAllResponse.Unmarshal(m, b)
+}
+func (m *EvaluateAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvaluateAllResponse.Marshal(b, m, deterministic)
+}
+func (m *EvaluateAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateAllResponse.Merge(m, src)
+}
+func (m *EvaluateAllResponse) XXX_Size() int {
+	return xxx_messageInfo_EvaluateAllResponse.Size(m)
+}
+func (m *EvaluateAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluateAllResponse proto.InternalMessageInfo
+
+func (m *EvaluateAllResponse) GetEvaluations() []*Evaluation {
+	if m != nil {
+		return m.Evaluations
+	}
+	return nil
+}
+
+func (m *EvaluateAllResponse) GetContext() *_struct.Struct {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+type Evaluation struct {
+	FlagKey              string         `protobuf:"bytes,1,opt,name=flag_key,json=flagKey,proto3" json:"flag_key,omitempty"`
+	Value                *_struct.Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	VariantId            string         `protobuf:"bytes,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Reason               string         `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Error                string         `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	StackTrace           []*StackTrace  `protobuf:"bytes,6,rep,name=stack_trace,json=stackTrace,proto3" json:"stack_trace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Evaluation) Reset()         { *m = Evaluation{} }
+func (m *Evaluation) String() string { return proto.CompactTextString(m) }
+func (*Evaluation) ProtoMessage()    {}
+func (*Evaluation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7174cf91de820c9, []int{5}
+}
+
+func (m *Evaluation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Evaluation.Unmarshal(m, b)
+}
+func (m *Evaluation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Evaluation.Marshal(b, m, deterministic)
+}
+func (m *Evaluation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Evaluation.Merge(m, src)
+}
+func (m *Evaluation) XXX_Size() int {
+	return xxx_messageInfo_Evaluation.Size(m)
+}
+func (m *Evaluation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Evaluation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Evaluation proto.InternalMessageInfo
+
+func (m *Evaluation) GetFlagKey() string {
+	if m != nil {
+		return m.FlagKey
+	}
+	return ""
+}
+
+func (m *Evaluation) GetValue() *_struct.Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Evaluation) GetVariantId() string {
+	if m != nil {
+		return m.VariantId
+	}
+	return ""
+}
+
+func (m *Evaluation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Evaluation) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *Evaluation) GetStackTrace() []*StackTrace {
+	if m != nil {
+		return m.StackTrace
+	}
+	return nil
+}
+
+type StackTrace struct {
+	Type                 string         `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id                   string         `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Answer               *_struct.Value `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	Error                string         `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StackTrace) Reset()         { *m = StackTrace{} }
+func (m *StackTrace) String() string { return proto.CompactTextString(m) }
+func (*StackTrace) ProtoMessage()    {}
+func (*StackTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7174cf91de820c9, []int{6}
+}
+
+func (m *StackTrace) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StackTrace.Unmarshal(m, b)
+}
+func (m *StackTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StackTrace.Marshal(b, m, deterministic)
+}
+func (m *StackTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StackTrace.Merge(m, src)
+}
+func (m *StackTrace) XXX_Size() int {
+	return xxx_messageInfo_StackTrace.Size(m)
+}
+func (m *StackTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_StackTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StackTrace proto.InternalMessageInfo
+
+func (m *StackTrace) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *StackTrace) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StackTrace) GetAnswer() *_struct.Value {
+	if m != nil {
+		return m.Answer
+	}
+	return nil
+}
+
+func (m *StackTrace) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type WatchRequest struct {
+	Project              string   `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Environment          string   `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	User                 *User    `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
+func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()    {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7174cf91de820c9, []int{7}
+}
+
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchRequest.Unmarshal(m, b)
+}
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(m, src)
+}
+func (m *WatchRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchRequest.Size(m)
+}
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+
+func (m *WatchRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *WatchRequest) GetEnvironment() string {
+	if m != nil {
+		return m.Environment
+	}
+	return ""
+}
+
+func (m *WatchRequest) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type WatchResponse struct {
+	// Types that are valid to be assigned to Event:
+	//	*WatchResponse_Change
+	//	*WatchResponse_Evaluations
+	Event                isWatchResponse_Event `protobuf_oneof:"event"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *WatchResponse) Reset()         { *m = WatchResponse{} }
+func (m *WatchResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchResponse) ProtoMessage()    {}
+func (*WatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7174cf91de820c9, []int{8}
+}
+
+func (m *WatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchResponse.Unmarshal(m, b)
+}
+func (m *WatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchResponse.Marshal(b, m, deterministic)
+}
+func (m *WatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchResponse.Merge(m, src)
+}
+func (m *WatchResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchResponse.Size(m)
+}
+func (m *WatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchResponse proto.InternalMessageInfo
+
+type isWatchResponse_Event interface {
+	isWatchResponse_Event()
+}
+
+type WatchResponse_Change struct {
+	Change *ChangeEvent `protobuf:"bytes,1,opt,name=change,proto3,oneof"`
+}
+
+type WatchResponse_Evaluations struct {
+	Evaluations *EvaluateAllResponse `protobuf:"bytes,2,opt,name=evaluations,proto3,oneof"`
+}
+
+func (*WatchResponse_Change) isWatchResponse_Event() {}
+
+func (*WatchResponse_Evaluations) isWatchResponse_Event() {}
+
+func (m *WatchResponse) GetEvent() isWatchResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *WatchResponse) GetChange() *ChangeEvent {
+	if x, ok := m.GetEvent().(*WatchResponse_Change); ok {
+		return x.Change
+	}
+	return nil
+}
+
+func (m *WatchResponse) GetEvaluations() *EvaluateAllResponse {
+	if x, ok := m.GetEvent().(*WatchResponse_Evaluations); ok {
+		return x.Evaluations
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*WatchResponse) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*WatchResponse_Change)(nil),
+		(*WatchResponse_Evaluations)(nil),
+	}
+}
+
+type ChangeEvent struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Key                  string   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	ProjectId            string   `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangeEvent) Reset()         { *m = ChangeEvent{} }
+func (m *ChangeEvent) String() string { return proto.CompactTextString(m) }
+func (*ChangeEvent) ProtoMessage()    {}
+func (*ChangeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7174cf91de820c9, []int{9}
+}
+
+func (m *ChangeEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeEvent.Unmarshal(m, b)
+}
+func (m *ChangeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeEvent.Marshal(b, m, deterministic)
+}
+func (m *ChangeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeEvent.Merge(m, src)
+}
+func (m *ChangeEvent) XXX_Size() int {
+	return xxx_messageInfo_ChangeEvent.Size(m)
+}
+func (m *ChangeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeEvent proto.InternalMessageInfo
+
+func (m *ChangeEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ChangeEvent) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ChangeEvent) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ChangeEvent) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*User)(nil), "flaggio.User")
+	proto.RegisterType((*EvaluateRequest)(nil), "flaggio.EvaluateRequest")
+	proto.RegisterType((*EvaluateResponse)(nil), "flaggio.EvaluateResponse")
+	proto.RegisterType((*EvaluateAllRequest)(nil), "flaggio.EvaluateAllRequest")
+	proto.RegisterType((*EvaluateAllResponse)(nil), "flaggio.EvaluateAllResponse")
+	proto.RegisterType((*Evaluation)(nil), "flaggio.Evaluation")
+	proto.RegisterType((*StackTrace)(nil), "flaggio.StackTrace")
+	proto.RegisterType((*WatchRequest)(nil), "flaggio.WatchRequest")
+	proto.RegisterType((*WatchResponse)(nil), "flaggio.WatchResponse")
+	proto.RegisterType((*ChangeEvent)(nil), "flaggio.ChangeEvent")
+}
+
+func init() {
+	proto.RegisterFile("flaggio.proto", fileDescriptor_d7174cf91de820c9)
+}
+
+var fileDescriptor_d7174cf91de820c9 = []byte{
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x4b, 0x6f, 0xd3, 0x4c,
+	0x14, 0xad, 0xf3, 0xac, 0xaf, 0xbf, 0x7e, 0x54, 0xd3, 0x52, 0xdc, 0x52, 0xa4, 0xe0, 0x55, 0x10,
+	0xc8, 0x81, 0x16, 0x24, 0x76, 0x40, 0x51, 0xa5, 0x56, 0xec, 0xa6, 0x3c, 0x24, 0x36, 0x95, 0xe3,
+	0xdc, 0xba, 0x26, 0xa9, 0x27, 0xcc, 0x8c, 0x0d, 0x61, 0xc3, 0x9e, 0xff, 0xc0, 0x82, 0x7f, 0xc4,
+	0x92, 0x9f, 0x83, 0xe6, 0x61, 0xc7, 0x7d, 0xaa, 0xea, 0x82, 0xdd, 0xdc, 0x7b, 0x8f, 0xcf, 0x9c,
+	0x7b, 0xe6, 0x18, 0x96, 0x8e, 0x26, 0x51, 0x92, 0xa4, 0x2c, 0x9c, 0x72, 0x26, 0x19, 0xe9, 0xda,
+	0x72, 0x63, 0x33, 0x61, 0x2c, 0x99, 0xe0, 0x40, 0xb7, 0x87, 0xf9, 0xd1, 0x40, 0x48, 0x9e, 0xc7,
+	0xd2, 0xc0, 0x82, 0x7d, 0x68, 0xbd, 0x13, 0xc8, 0xc9, 0xff, 0xd0, 0x48, 0x47, 0xbe, 0xd3, 0x73,
+	0xfa, 0x2e, 0x6d, 0xa4, 0x23, 0xf2, 0x04, 0xba, 0x31, 0xcb, 0x24, 0x7e, 0x95, 0x7e, 0xa3, 0xe7,
+	0xf4, 0xbd, 0xad, 0x3b, 0xa1, 0xe1, 0x09, 0x4b, 0x9e, 0xf0, 0x40, 0xf3, 0xd0, 0x12, 0x17, 0xfc,
+	0x72, 0xe0, 0xd6, 0x6e, 0x11, 0x4d, 0xf2, 0x48, 0x22, 0xc5, 0xcf, 0x39, 0x0a, 0x49, 0xd6, 0x61,
+	0x51, 0xe9, 0x38, 0x1c, 0xe3, 0xcc, 0x92, 0x6b, 0x5d, 0x6f, 0x70, 0x46, 0x7c, 0xe8, 0x4e, 0x39,
+	0xfb, 0x84, 0xb1, 0xb9, 0xc1, 0xa5, 0x65, 0x49, 0x7a, 0xe0, 0x61, 0x56, 0xa4, 0x9c, 0x65, 0x27,
+	0x98, 0x49, 0xbf, 0xa9, 0xa7, 0xf5, 0x16, 0xb9, 0x0f, 0xad, 0x5c, 0x20, 0xf7, 0x5b, 0x5a, 0xda,
+	0x52, 0x58, 0xae, 0xae, 0x56, 0xa1, 0x7a, 0x44, 0x56, 0xa1, 0x3d, 0xc2, 0x61, 0x9e, 0xf8, 0xed,
+	0x9e, 0xd3, 0x5f, 0xa4, 0xa6, 0x08, 0xbe, 0xc1, 0xf2, 0x5c, 0xa2, 0x98, 0xb2, 0x4c, 0x20, 0xd9,
+	0x06, 0x40, 0xd3, 0x4b, 0x59, 0xa6, 0x55, 0x7a, 0x5b, 0x2b, 0x15, 0xe5, 0x6e, 0x35, 0xa2, 0x35,
+	0xd8, 0x4d, 0xfc, 0xf9, 0xe9, 0x00, 0x29, 0x2f, 0x7f, 0x35, 0x99, 0x94, 0x16, 0xd5, 0x7c, 0x70,
+	0xae, 0xf4, 0xa1, 0x71, 0xb9, 0x0f, 0xcd, 0x6b, 0xf8, 0xd0, 0xaa, 0xf9, 0x40, 0x08, 0xb4, 0xc6,
+	0x38, 0x13, 0x7e, 0xbb, 0xd7, 0xec, 0xbb, 0x54, 0x9f, 0x83, 0xef, 0xb0, 0x72, 0x4a, 0x9e, 0xb5,
+	0xe7, 0x19, 0x78, 0xf3, 0xbd, 0x85, 0xef, 0xf4, 0x9a, 0x97, 0xf9, 0x53, 0xc7, 0xdd, 0xc4, 0xa0,
+	0x3f, 0x0e, 0xc0, 0x9c, 0xee, 0xaa, 0xec, 0x3c, 0x82, 0xb6, 0xc2, 0xa1, 0xa5, 0x5e, 0x3b, 0x47,
+	0xfd, 0x5e, 0x4d, 0xa9, 0x01, 0x91, 0x7b, 0x00, 0x45, 0xc4, 0xd3, 0x28, 0x93, 0x87, 0xe9, 0xc8,
+	0xc6, 0xc9, 0xb5, 0x9d, 0xfd, 0x11, 0x59, 0x83, 0x0e, 0xc7, 0x48, 0xb0, 0x4c, 0x5b, 0xe4, 0x52,
+	0x5b, 0x29, 0xe7, 0x90, 0x73, 0xc6, 0x75, 0x82, 0x5c, 0x6a, 0x0a, 0xf2, 0x14, 0x3c, 0x21, 0xa3,
+	0x78, 0x7c, 0x28, 0x79, 0x14, 0xa3, 0xdf, 0x39, 0x63, 0xc7, 0x81, 0x9a, 0xbd, 0x55, 0x23, 0x0a,
+	0xa2, 0x3a, 0x07, 0x05, 0xc0, 0x7c, 0xa2, 0xdc, 0x97, 0xb3, 0x29, 0xda, 0xad, 0xf4, 0xd9, 0xfe,
+	0x80, 0x8d, 0xea, 0x07, 0x0c, 0xa1, 0x13, 0x65, 0xe2, 0x4b, 0xf5, 0xb8, 0x97, 0xed, 0x68, 0x51,
+	0x73, 0xb5, 0xad, 0x9a, 0xda, 0xe0, 0x04, 0xfe, 0xfb, 0x10, 0xc9, 0xf8, 0xf8, 0xdf, 0x84, 0x2d,
+	0xf8, 0xe1, 0xc0, 0x92, 0xbd, 0xcf, 0xa6, 0x27, 0x84, 0x4e, 0x7c, 0x1c, 0x65, 0x09, 0xda, 0x1f,
+	0x6b, 0xb5, 0xfa, 0xec, 0xb5, 0x6e, 0xef, 0x16, 0x98, 0xc9, 0xbd, 0x05, 0x6a, 0x51, 0xe4, 0xe5,
+	0xe9, 0xb4, 0x99, 0xf7, 0xdd, 0x3c, 0x9b, 0xb6, 0x7a, 0x40, 0xf7, 0x16, 0x4e, 0x05, 0x6f, 0xa7,
+	0x0b, 0x6d, 0x54, 0xa4, 0xc1, 0x10, 0xbc, 0xda, 0x1d, 0xd7, 0x32, 0x7d, 0x19, 0x9a, 0x2a, 0x6d,
+	0x26, 0x22, 0xea, 0xa8, 0xb2, 0x63, 0x1d, 0x52, 0xd9, 0x31, 0xde, 0xba, 0xb6, 0xb3, 0x3f, 0xda,
+	0xfa, 0xed, 0x80, 0x6b, 0x35, 0x31, 0x4e, 0x5e, 0xc0, 0xa2, 0x2d, 0x90, 0xf8, 0xe7, 0x34, 0xdb,
+	0x37, 0xd8, 0x58, 0xbf, 0x60, 0x62, 0xdd, 0xda, 0x03, 0xaf, 0xb6, 0x21, 0xb9, 0x7b, 0xf1, 0xde,
+	0x86, 0xe6, 0x4a, 0x53, 0xc8, 0x73, 0x68, 0xeb, 0x87, 0x20, 0xb7, 0x2b, 0x58, 0x3d, 0x08, 0x1b,
+	0x6b, 0x67, 0xdb, 0xe6, 0xbb, 0xc7, 0xce, 0xce, 0xc3, 0x8f, 0x0f, 0x92, 0x54, 0x1e, 0xe7, 0xc3,
+	0x30, 0x66, 0x27, 0x83, 0x22, 0x8d, 0x25, 0xe3, 0x63, 0x39, 0xb0, 0xf0, 0xc1, 0x74, 0x9c, 0x94,
+	0xe7, 0xe9, 0x70, 0xd8, 0xd1, 0x69, 0xdc, 0xfe, 0x3b, 0x00, 0xee, 0x2e, 0xe5, 0xd5, 0x7d, 0x06,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// EvaluatorClient is the client API for Evaluator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EvaluatorClient interface {
+	// Evaluate evaluates a single flag for the user.
+	Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error)
+	// EvaluateAll evaluates all flags for the user, or only the flags with
+	// the given keys.
+	EvaluateAll(ctx context.Context, in *EvaluateAllRequest, opts ...grpc.CallOption) (*EvaluateAllResponse, error)
+	// Watch streams changes to flags and segments. If a user is given, all
+	// flags are evaluated for the user when connecting and again after
+	// every change, and the evaluations are streamed instead.
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Evaluator_WatchClient, error)
+}
+
+type evaluatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEvaluatorClient(cc grpc.ClientConnInterface) EvaluatorClient {
+	return &evaluatorClient{cc}
+}
+
+func (c *evaluatorClient) Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error) {
+	out := new(EvaluateResponse)
+	err := c.cc.Invoke(ctx, "/flaggio.Evaluator/Evaluate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluatorClient) EvaluateAll(ctx context.Context, in *EvaluateAllRequest, opts ...grpc.CallOption) (*EvaluateAllResponse, error) {
+	out := new(EvaluateAllResponse)
+	err := c.cc.Invoke(ctx, "/flaggio.Evaluator/EvaluateAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evaluatorClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Evaluator_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Evaluator_serviceDesc.Streams[0], "/flaggio.Evaluator/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &evaluatorWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Evaluator_WatchClient interface {
+	Recv() (*WatchResponse, error)
+	grpc.ClientStream
+}
+
+type evaluatorWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *evaluatorWatchClient) Recv() (*WatchResponse, error) {
+	m := new(WatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EvaluatorServer is the server API for Evaluator service.
+type EvaluatorServer interface {
+	// Evaluate evaluates a single flag for the user.
+	Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error)
+	// EvaluateAll evaluates all flags for the user, or only the flags with
+	// the given keys.
+	EvaluateAll(context.Context, *EvaluateAllRequest) (*EvaluateAllResponse, error)
+	// Watch streams changes to flags and segments. If a user is given, all
+	// flags are evaluated for the user when connecting and again after
+	// every change, and the evaluations are streamed instead.
+	Watch(*WatchRequest, Evaluator_WatchServer) error
+}
+
+// UnimplementedEvaluatorServer can be embedded to have forward compatible implementations.
+type UnimplementedEvaluatorServer struct {
+}
+
+func (*UnimplementedEvaluatorServer) Evaluate(ctx context.Context, req *EvaluateRequest) (*EvaluateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evaluate not implemented")
+}
+func (*UnimplementedEvaluatorServer) EvaluateAll(ctx context.Context, req *EvaluateAllRequest) (*EvaluateAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateAll not implemented")
+}
+func (*UnimplementedEvaluatorServer) Watch(req *WatchRequest, srv Evaluator_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+
+func RegisterEvaluatorServer(s *grpc.Server, srv EvaluatorServer) {
+	s.RegisterService(&_Evaluator_serviceDesc, srv)
+}
+
+func _Evaluator_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluatorServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flaggio.Evaluator/Evaluate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluatorServer).Evaluate(ctx, req.(*EvaluateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Evaluator_EvaluateAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvaluatorServer).EvaluateAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flaggio.Evaluator/EvaluateAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvaluatorServer).EvaluateAll(ctx, req.(*EvaluateAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Evaluator_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EvaluatorServer).Watch(m, &evaluatorWatchServer{stream})
+}
+
+type Evaluator_WatchServer interface {
+	Send(*WatchResponse) error
+	grpc.ServerStream
+}
+
+type evaluatorWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *evaluatorWatchServer) Send(m *WatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Evaluator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "flaggio.Evaluator",
+	HandlerType: (*EvaluatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Evaluate",
+			Handler:    _Evaluator_Evaluate_Handler,
+		},
+		{
+			MethodName: "EvaluateAll",
+			Handler:    _Evaluator_EvaluateAll_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Evaluator_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "flaggio.proto",
+}
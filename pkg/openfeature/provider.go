@@ -0,0 +1,146 @@
+// Package openfeature is an OpenFeature provider for flaggio, built on top
+// of the flaggio SDK. It implements the FeatureProvider interface of the
+// OpenFeature Go SDK, and flags are evaluated locally by the SDK client.
+//
+//	c, err := client.New(ctx, client.Config{URL: "http://localhost:8080", APIKey: "srv-..."})
+//	if err != nil {
+//		return err
+//	}
+//	if err := of.SetProviderAndWait(openfeature.NewProvider(c)); err != nil {
+//		return err
+//	}
+//	enabled, err := of.NewClient("checkout").BooleanValue(ctx, "new-checkout", false,
+//		of.NewEvaluationContext("123", map[string]interface{}{"country": "NZ"}))
+//
+// Where of is github.com/open-feature/go-sdk/openfeature.
+package openfeature
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+	"github.com/victorkt/flaggio/pkg/client"
+)
+
+var _ of.FeatureProvider = (*Provider)(nil)
+
+// Provider evaluates flags with a flaggio SDK client. When a flag can't be
+// evaluated, the default value is returned along with the error. The
+// targeting key of the evaluation context is the user ID, and the other
+// attributes are the user context.
+type Provider struct {
+	client *client.Client
+}
+
+// NewProvider returns a new provider that evaluates flags with the given client.
+func NewProvider(c *client.Client) *Provider {
+	return &Provider{client: c}
+}
+
+// Metadata returns the provider metadata.
+func (p *Provider) Metadata() of.Metadata {
+	return of.Metadata{Name: "flaggio"}
+}
+
+// Hooks returns the hooks of the provider. The provider has none.
+func (p *Provider) Hooks() []of.Hook {
+	return nil
+}
+
+// BooleanEvaluation evaluates a boolean flag.
+func (p *Provider) BooleanEvaluation(ctx context.Context, flag string, defaultValue bool, evalCtx of.FlattenedContext) of.BoolResolutionDetail {
+	v, detail := p.evaluate(flag, evalCtx)
+	if detail.Error() != nil {
+		return of.BoolResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return of.BoolResolutionDetail{Value: defaultValue, ProviderResolutionDetail: typeMismatch("a boolean")}
+	}
+	return of.BoolResolutionDetail{Value: b, ProviderResolutionDetail: detail}
+}
+
+// StringEvaluation evaluates a string flag.
+func (p *Provider) StringEvaluation(ctx context.Context, flag string, defaultValue string, evalCtx of.FlattenedContext) of.StringResolutionDetail {
+	v, detail := p.evaluate(flag, evalCtx)
+	if detail.Error() != nil {
+		return of.StringResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+	}
+	s, ok := v.(string)
+	if !ok {
+		return of.StringResolutionDetail{Value: defaultValue, ProviderResolutionDetail: typeMismatch("a string")}
+	}
+	return of.StringResolutionDetail{Value: s, ProviderResolutionDetail: detail}
+}
+
+// FloatEvaluation evaluates a number flag.
+func (p *Provider) FloatEvaluation(ctx context.Context, flag string, defaultValue float64, evalCtx of.FlattenedContext) of.FloatResolutionDetail {
+	v, detail := p.evaluate(flag, evalCtx)
+	if detail.Error() != nil {
+		return of.FloatResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+	}
+	f, ok := v.(float64)
+	if !ok {
+		return of.FloatResolutionDetail{Value: defaultValue, ProviderResolutionDetail: typeMismatch("a number")}
+	}
+	return of.FloatResolutionDetail{Value: f, ProviderResolutionDetail: detail}
+}
+
+// IntEvaluation evaluates a number flag whose value is a whole number.
+func (p *Provider) IntEvaluation(ctx context.Context, flag string, defaultValue int64, evalCtx of.FlattenedContext) of.IntResolutionDetail {
+	v, detail := p.evaluate(flag, evalCtx)
+	if detail.Error() != nil {
+		return of.IntResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+	}
+	f, ok := v.(float64)
+	if !ok || f != math.Trunc(f) {
+		return of.IntResolutionDetail{Value: defaultValue, ProviderResolutionDetail: typeMismatch("an integer")}
+	}
+	return of.IntResolutionDetail{Value: int64(f), ProviderResolutionDetail: detail}
+}
+
+// ObjectEvaluation evaluates a flag of any type.
+func (p *Provider) ObjectEvaluation(ctx context.Context, flag string, defaultValue interface{}, evalCtx of.FlattenedContext) of.InterfaceResolutionDetail {
+	v, detail := p.evaluate(flag, evalCtx)
+	if detail.Error() != nil {
+		return of.InterfaceResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+	}
+	return of.InterfaceResolutionDetail{Value: v, ProviderResolutionDetail: detail}
+}
+
+func (p *Provider) evaluate(flag string, evalCtx of.FlattenedContext) (interface{}, of.ProviderResolutionDetail) {
+	user := client.User{Context: make(map[string]interface{}, len(evalCtx))}
+	for k, v := range evalCtx {
+		if k == of.TargetingKey {
+			user.ID, _ = v.(string)
+			continue
+		}
+		user.Context[k] = v
+	}
+
+	res, err := p.client.EvaluateDetail(flag, user)
+	if err != nil {
+		resErr := of.NewGeneralResolutionError(err.Error())
+		if errors.Is(err, client.ErrFlagNotFound) {
+			resErr = of.NewFlagNotFoundResolutionError(err.Error())
+		}
+		return nil, of.ProviderResolutionDetail{
+			ResolutionError: resErr,
+			Reason:          of.ErrorReason,
+		}
+	}
+	return res.Value, of.ProviderResolutionDetail{
+		// flaggio reasons follow the OpenFeature specification
+		Reason:  of.Reason(res.Reason),
+		Variant: res.VariantID,
+	}
+}
+
+func typeMismatch(expected string) of.ProviderResolutionDetail {
+	return of.ProviderResolutionDetail{
+		ResolutionError: of.NewTypeMismatchResolutionError("flag value is not " + expected),
+		Reason:          of.ErrorReason,
+	}
+}
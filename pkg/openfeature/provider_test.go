@@ -0,0 +1,162 @@
+package openfeature_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	of "github.com/open-feature/go-sdk/openfeature"
+	"github.com/stretchr/testify/assert"
+	"github.com/victorkt/flaggio/internal/flaggio"
+	repository_mock "github.com/victorkt/flaggio/internal/repository/mocks"
+	"github.com/victorkt/flaggio/internal/server/api"
+	"github.com/victorkt/flaggio/internal/service"
+	"github.com/victorkt/flaggio/pkg/client"
+	"github.com/victorkt/flaggio/pkg/openfeature"
+)
+
+var (
+	ctxInterface = reflect.TypeOf((*context.Context)(nil)).Elem()
+)
+
+func newFlag(id, key string, enabled bool, values ...interface{}) *flaggio.Flag {
+	flg := &flaggio.Flag{ID: id, Key: key, Enabled: enabled}
+	for i, v := range values {
+		flg.Variants = append(flg.Variants, &flaggio.Variant{ID: key + "-" + string(rune('a'+i)), Value: v})
+	}
+	flg.DefaultVariantWhenOn = flg.Variants[0]
+	flg.DefaultVariantWhenOff = flg.Variants[len(flg.Variants)-1]
+	return flg
+}
+
+// newProvider starts an in-process API server that serves the given flags,
+// and returns a provider backed by a client of that server, along with a
+// function that stops both.
+func newProvider(t *testing.T, flgs []*flaggio.Flag, tgts map[string][]*flaggio.FlagTarget) (*openfeature.Provider, func()) {
+	mockCtrl := gomock.NewController(t)
+	prjRepo := repository_mock.NewMockProject(mockCtrl)
+	prjRepo.EXPECT().FindByKey(gomock.AssignableToTypeOf(ctxInterface), "website").
+		Return(&flaggio.Project{ID: "p1", Key: "website"}, nil).AnyTimes()
+	flgsRepo := repository_mock.NewMockFlag(mockCtrl)
+	flgsRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), gomock.Any(), nil, nil, nil).
+		Return(&flaggio.FlagResults{Flags: flgs, Total: len(flgs)}, nil).AnyTimes()
+	tgtsRepo := repository_mock.NewMockTarget(mockCtrl)
+	var allTgts []*flaggio.FlagTarget
+	for _, flg := range flgs {
+		allTgts = append(allTgts, tgts[flg.ID]...)
+	}
+	tgtsRepo.EXPECT().FindAllByFlagIDs(gomock.AssignableToTypeOf(ctxInterface), gomock.Any()).
+		Return(allTgts, nil).AnyTimes()
+	sgmntsRepo := repository_mock.NewMockSegment(mockCtrl)
+	sgmntsRepo.EXPECT().FindAll(gomock.AssignableToTypeOf(ctxInterface), gomock.Any(), nil, nil).
+		Return(nil, nil).AnyTimes()
+	envsRepo := repository_mock.NewMockEnvironment(mockCtrl)
+
+	flagSrv := service.NewFlagService(flgsRepo, sgmntsRepo, tgtsRepo, envsRepo, prjRepo)
+	apiSrv := api.NewServer(chi.NewRouter(), flagSrv, nil, prjRepo, envsRepo, nil)
+	srv := httptest.NewServer(apiSrv)
+
+	c, err := client.New(context.Background(), client.Config{URL: srv.URL, Project: "website"})
+	if err != nil {
+		srv.Close()
+		t.Fatalf("failed to create client: %s", err)
+	}
+	return openfeature.NewProvider(c), func() {
+		c.Close()
+		srv.Close()
+		mockCtrl.Finish()
+	}
+}
+
+func TestProvider(t *testing.T) {
+	t.Parallel()
+	flgs := []*flaggio.Flag{
+		newFlag("1", "bool", true, true, false),
+		newFlag("2", "string", true, "abc", "def"),
+		newFlag("3", "number", true, float64(2.5), float64(3)),
+		newFlag("4", "object", true, map[string]interface{}{"a": "b"}, nil),
+		newFlag("5", "disabled", false, "on", "off"),
+	}
+	tgts := map[string][]*flaggio.FlagTarget{
+		"2": {{FlagID: "2", VariantID: "string-b", Users: []string{"vip"}}},
+	}
+	provider, stop := newProvider(t, flgs, tgts)
+	defer stop()
+	ctx := context.Background()
+	evalCtx := of.FlattenedContext{of.TargetingKey: "123"}
+
+	assert.Equal(t, of.Metadata{Name: "flaggio"}, provider.Metadata())
+	assert.Empty(t, provider.Hooks())
+
+	boolRes := provider.BooleanEvaluation(ctx, "bool", false, evalCtx)
+	assert.NoError(t, boolRes.Error())
+	assert.Equal(t, true, boolRes.Value)
+	assert.Equal(t, of.DefaultReason, boolRes.Reason)
+	assert.Equal(t, "bool-a", boolRes.Variant)
+
+	strRes := provider.StringEvaluation(ctx, "string", "", evalCtx)
+	assert.Equal(t, "abc", strRes.Value)
+	assert.Equal(t, of.DefaultReason, strRes.Reason)
+
+	strRes = provider.StringEvaluation(ctx, "string", "", of.FlattenedContext{of.TargetingKey: "vip"})
+	assert.Equal(t, "def", strRes.Value)
+	assert.Equal(t, of.TargetingMatchReason, strRes.Reason)
+	assert.Equal(t, "string-b", strRes.Variant)
+
+	floatRes := provider.FloatEvaluation(ctx, "number", 0, evalCtx)
+	assert.Equal(t, 2.5, floatRes.Value)
+
+	intRes := provider.IntEvaluation(ctx, "number", 7, evalCtx)
+	assert.Equal(t, int64(7), intRes.Value)
+	assert.Equal(t, of.TypeMismatchCode, intRes.ResolutionDetail().ErrorCode)
+	assert.Equal(t, of.ErrorReason, intRes.Reason)
+
+	objRes := provider.ObjectEvaluation(ctx, "object", nil, evalCtx)
+	assert.Equal(t, map[string]interface{}{"a": "b"}, objRes.Value)
+
+	disabledRes := provider.StringEvaluation(ctx, "disabled", "", evalCtx)
+	assert.Equal(t, "off", disabledRes.Value)
+	assert.Equal(t, of.DisabledReason, disabledRes.Reason)
+	assert.Equal(t, "disabled-b", disabledRes.Variant)
+
+	mismatchRes := provider.BooleanEvaluation(ctx, "string", true, evalCtx)
+	assert.Equal(t, true, mismatchRes.Value)
+	assert.Equal(t, of.TypeMismatchCode, mismatchRes.ResolutionDetail().ErrorCode)
+
+	notFoundRes := provider.BooleanEvaluation(ctx, "unknown", true, evalCtx)
+	assert.Equal(t, true, notFoundRes.Value)
+	assert.Equal(t, of.FlagNotFoundCode, notFoundRes.ResolutionDetail().ErrorCode)
+	assert.Equal(t, of.ErrorReason, notFoundRes.Reason)
+	assert.Error(t, notFoundRes.Error())
+}
+
+func TestProvider_WithSDK(t *testing.T) {
+	t.Parallel()
+	flgs := []*flaggio.Flag{
+		newFlag("1", "string", true, "abc", "def"),
+	}
+	tgts := map[string][]*flaggio.FlagTarget{
+		"1": {{FlagID: "1", VariantID: "string-b", Users: []string{"vip"}}},
+	}
+	provider, stop := newProvider(t, flgs, tgts)
+	defer stop()
+
+	// a named provider doesn't affect other tests
+	assert.NoError(t, of.SetNamedProviderAndWait("flaggio-test", provider))
+	ofClient := of.NewClient("flaggio-test")
+	ctx := context.Background()
+
+	res, err := ofClient.StringValueDetails(ctx, "string", "", of.NewEvaluationContext("vip", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, "def", res.Value)
+	assert.Equal(t, of.TargetingMatchReason, res.Reason)
+	assert.Equal(t, "string-b", res.Variant)
+
+	res, err = ofClient.StringValueDetails(ctx, "unknown", "xyz", of.NewEvaluationContext("vip", nil))
+	assert.Error(t, err)
+	assert.Equal(t, "xyz", res.Value)
+	assert.Equal(t, of.FlagNotFoundCode, res.ErrorCode)
+}
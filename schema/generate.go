@@ -1,3 +1,4 @@
 package schema
 
 //go:generate go run github.com/99designs/gqlgen --verbose --config gqlgen.admin.yml
+//go:generate protoc --go_out=plugins=grpc,paths=source_relative:../pkg/flaggiopb flaggio.proto
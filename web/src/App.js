@@ -10,14 +10,27 @@ import 'react-perfect-scrollbar/dist/css/styles.css';
 import './assets/scss/index.scss';
 import validators from './common/validators';
 import Routes from './Routes';
+import { clearToken, getToken } from './helpers';
 
 const browserHistory = createBrowserHistory();
 
 const client = new ApolloClient({
   uri: '/query',
+  request: operation => {
+    const token = getToken();
+    if (token) {
+      operation.setContext({ headers: { authorization: `Bearer ${token}` } });
+    }
+  },
+  onError: ({ graphQLErrors = [] }) => {
+    const unauthenticated = graphQLErrors.some(err => err.extensions && err.extensions.code === 'Unauthenticated');
+    if (unauthenticated && browserHistory.location.pathname !== '/sign-in') {
+      clearToken();
+      browserHistory.push('/sign-in');
+    }
+  },
 });
 
-
 validate.validators = {
   ...validate.validators,
   ...validators,
@@ -8,6 +8,7 @@ import {
   NotFound as NotFoundView,
   SegmentList as SegmentListView,
   SegmentForm as SegmentFormView,
+  SignIn as SignInView,
 } from './views';
 
 const Routes = () => {
@@ -54,6 +55,12 @@ const Routes = () => {
         layout={MainLayout}
         path="/segments/:id"
       />
+      <RouteWithLayout
+        component={SignInView}
+        exact
+        layout={MinimalLayout}
+        path="/sign-in"
+      />
       <RouteWithLayout
         component={NotFoundView}
         exact
@@ -48,6 +48,18 @@ const useStyles = makeStyles(theme => ({
   },
 }));
 
+// converts an RFC3339 time into the local time format used by datetime inputs
+const toDateTimeInput = value => {
+  if (!value) return '';
+  const date = new Date(value);
+  if (isNaN(date.getTime())) return '';
+  const local = new Date(date.getTime() - date.getTimezoneOffset() * 60000);
+  return local.toISOString().slice(0, 16);
+};
+
+// converts the local time from a datetime input into an RFC3339 time
+const fromDateTimeInput = value => value ? new Date(value).toISOString() : '';
+
 const ConstraintFields = props => {
   const classes = useStyles();
   const {
@@ -73,13 +85,34 @@ const ConstraintFields = props => {
     GREATER_OR_EQUAL,
     LOWER,
     LOWER_OR_EQUAL,
+    BEFORE,
+    AFTER,
+    BETWEEN,
   } = operationTypes;
   const disabledPropertyField = includes([IS_IN_SEGMENT, ISNT_IN_SEGMENT], constraint.operation);
+  // between compares the current time when there's no property
+  const optionalPropertyField = constraint.operation === BETWEEN;
   const showTypeField = includes([ONE_OF, NOT_ONE_OF], constraint.operation);
   const showSegmentInput = includes([IS_IN_SEGMENT, ISNT_IN_SEGMENT], constraint.operation);
   const showNumberInput = constraint.type === VariantTypes.NUMBER;
   const showBooleanInput = constraint.type === VariantTypes.BOOLEAN;
   const showNoInput = includes([EXISTS, DOESNT_EXIST], constraint.operation);
+  const showDateInput = includes([BEFORE, AFTER, BETWEEN], constraint.operation);
+  const dateInput = (idx, label) => (
+    <TextField
+      label={label}
+      value={toDateTimeInput(constraint.values[idx])}
+      name={`values[${idx}]`}
+      type="datetime-local"
+      onChange={e => onUpdateConstraint({
+        target: { name: `values[${idx}]`, value: fromDateTimeInput(e.target.value) },
+      })}
+      fullWidth
+      required={true}
+      variant="outlined"
+      InputLabelProps={{ shrink: true }}
+    />
+  );
 
   return (
     <Grid container spacing={2}>
@@ -98,7 +131,8 @@ const ConstraintFields = props => {
           onChange={onUpdateConstraint}
           fullWidth
           disabled={disabledPropertyField}
-          required={!disabledPropertyField}
+          required={!disabledPropertyField && !optionalPropertyField}
+          helperText={optionalPropertyField ? 'Leave empty to use the current time' : undefined}
           variant="outlined"
           InputProps={{ labelWidth: 65 }}
         />
@@ -182,6 +216,13 @@ const ConstraintFields = props => {
               ))}
             </Select>
           </FormControl>
+        ) : showDateInput ? (
+          constraint.operation === BETWEEN ? (
+            <Grid container spacing={1}>
+              <Grid item xs={6}>{dateInput(0, 'Start')}</Grid>
+              <Grid item xs={6}>{dateInput(1, 'End')}</Grid>
+            </Grid>
+          ) : dateInput(0, 'Value')
         ) : showNumberInput ? (
           <TextField
             label="Value"
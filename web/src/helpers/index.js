@@ -1,4 +1,5 @@
 export { cast } from './cast';
+export { getToken, setToken, clearToken } from './auth';
 export {
   newFlag,
   newVariant,
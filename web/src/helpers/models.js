@@ -13,6 +13,8 @@ const Operations = [
   'MATCHES_REGEX', 'DOESNT_MATCH_REGEX',
   'IS_IN_SEGMENT', 'ISNT_IN_SEGMENT',
   'IS_IN_NETWORK',
+  'BEFORE', 'AFTER', 'BETWEEN',
+  'SEMVER_EQUAL', 'SEMVER_GREATER', 'SEMVER_LOWER', 'SEMVER_IN_RANGE',
 ];
 export const OperationTypes = Operations.reduce((ops, op) => (
   { ...ops, [op]: op }
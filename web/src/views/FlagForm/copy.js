@@ -18,6 +18,13 @@ export const Operations = {
   IS_IN_SEGMENT: "Is in segment",
   ISNT_IN_SEGMENT: "Isn't in segment",
   IS_IN_NETWORK: "Is in network",
+  BEFORE: "Before",
+  AFTER: "After",
+  BETWEEN: "Between",
+  SEMVER_EQUAL: "Version is equal to",
+  SEMVER_GREATER: "Version is greater than",
+  SEMVER_LOWER: "Version is lower than",
+  SEMVER_IN_RANGE: "Version is in range",
 };
 
 export const VariantType = {
@@ -16,6 +16,13 @@ export const Operations = {
   MATCHES_REGEX: "Matches regex",
   DOESNT_MATCH_REGEX: "Doesn't match regex",
   IS_IN_NETWORK: "Is in network",
+  BEFORE: "Before",
+  AFTER: "After",
+  BETWEEN: "Between",
+  SEMVER_EQUAL: "Version is equal to",
+  SEMVER_GREATER: "Version is greater than",
+  SEMVER_LOWER: "Version is lower than",
+  SEMVER_IN_RANGE: "Version is in range",
 };
 
 export const BooleanType = {
@@ -0,0 +1,98 @@
+import React from 'react';
+import { makeStyles } from '@material-ui/styles';
+import { Redirect } from 'react-router-dom';
+import { Button, Card, CardContent, Grid, TextField, Typography } from '@material-ui/core';
+import { useMutation } from '@apollo/react-hooks';
+import { LOGIN_QUERY } from './queries';
+import { setToken } from '../../helpers';
+
+const useStyles = makeStyles(theme => ({
+  root: {
+    padding: theme.spacing(4),
+  },
+  title: {
+    marginBottom: theme.spacing(2),
+  },
+  field: {
+    marginBottom: theme.spacing(2),
+  },
+}));
+
+const SignIn = () => {
+  const classes = useStyles();
+  const [email, setEmail] = React.useState('');
+  const [password, setPassword] = React.useState('');
+  const [signedIn, setSignedIn] = React.useState(false);
+  const [login, { loading, error }] = useMutation(LOGIN_QUERY);
+  const handleSubmit = async event => {
+    event.preventDefault();
+    try {
+      const { data } = await login({ variables: { email, password } });
+      setToken(data.login.token);
+      setSignedIn(true);
+    } catch (e) {
+      // the error is displayed below
+    }
+  };
+  if (signedIn) return <Redirect to="/flags"/>;
+
+  return (
+    <div className={classes.root}>
+      <Grid
+        container
+        justify="center"
+      >
+        <Grid
+          item
+          lg={4}
+          md={6}
+          xs={12}
+        >
+          <Card>
+            <CardContent>
+              <form onSubmit={handleSubmit}>
+                <Typography
+                  className={classes.title}
+                  variant="h2"
+                >
+                  Sign in
+                </Typography>
+                <TextField
+                  className={classes.field}
+                  fullWidth
+                  label="Email"
+                  onChange={e => setEmail(e.target.value)}
+                  type="email"
+                  value={email}
+                  variant="outlined"
+                />
+                <TextField
+                  className={classes.field}
+                  error={!!error}
+                  fullWidth
+                  helperText={error && 'Invalid email or password'}
+                  label="Password"
+                  onChange={e => setPassword(e.target.value)}
+                  type="password"
+                  value={password}
+                  variant="outlined"
+                />
+                <Button
+                  color="primary"
+                  disabled={loading || !email || !password}
+                  fullWidth
+                  type="submit"
+                  variant="contained"
+                >
+                  Sign in
+                </Button>
+              </form>
+            </CardContent>
+          </Card>
+        </Grid>
+      </Grid>
+    </div>
+  );
+};
+
+export default SignIn;
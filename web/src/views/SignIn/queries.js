@@ -0,0 +1,10 @@
+import { gql } from 'apollo-boost';
+
+export const LOGIN_QUERY = gql`
+    mutation login($email: String!, $password: String!) {
+        login(email: $email, password: $password) {
+            token
+            expiresAt
+        }
+    }
+`;
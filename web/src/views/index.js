@@ -3,3 +3,4 @@ export { default as FlagForm } from './FlagForm';
 export { default as SegmentList } from './SegmentList';
 export { default as SegmentForm } from './SegmentForm';
 export { default as NotFound } from './NotFound';
+export { default as SignIn } from './SignIn';